@@ -0,0 +1,124 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	clienterrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/util"
+)
+
+// KnCoreClient to access Kubernetes core resources. All methods are relative to the
+// namespace specified during construction
+type KnCoreClient interface {
+	// Namespace in which this client is operating for
+	Namespace() string
+
+	// ListPods lists the pods matching the given label selector
+	ListPods(labelSelector string) (*corev1.PodList, error)
+
+	// GetPodLogs opens a stream to the logs of a pod's container. The caller is
+	// responsible for closing the returned stream.
+	GetPodLogs(name string, options *corev1.PodLogOptions) (io.ReadCloser, error)
+
+	// GetConfigMap returns the config map with the given name
+	GetConfigMap(name string) (*corev1.ConfigMap, error)
+
+	// UpdateConfigMap updates the given config map. If update options are given (e.g. for
+	// a server side dry-run), the given config map is updated with the one returned by the server.
+	UpdateConfigMap(configMap *corev1.ConfigMap, opts ...metav1.UpdateOptions) error
+
+	// ListEvents lists the events matching the given field selector, e.g. involvedObject.name=foo.
+	// All events of the namespace are returned for an empty selector.
+	ListEvents(fieldSelector string) (*corev1.EventList, error)
+}
+
+// knCoreClient is a combination of the client-go core client interface and namespace
+type knCoreClient struct {
+	client    clientcorev1.CoreV1Interface
+	namespace string
+}
+
+// NewKnCoreClient creates a new client for accessing core resources in the given namespace
+func NewKnCoreClient(client clientcorev1.CoreV1Interface, namespace string) KnCoreClient {
+	return &knCoreClient{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// Namespace in which this client is operating for
+func (c *knCoreClient) Namespace() string {
+	return c.namespace
+}
+
+// ListPods lists the pods matching the given label selector
+func (c *knCoreClient) ListPods(labelSelector string) (*corev1.PodList, error) {
+	podList, err := c.client.Pods(c.namespace).List(metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, clienterrors.GetError(err)
+	}
+	return podList, nil
+}
+
+// GetPodLogs opens a stream to the logs of a pod's container
+func (c *knCoreClient) GetPodLogs(name string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	stream, err := c.client.Pods(c.namespace).GetLogs(name, options).Stream()
+	if err != nil {
+		return nil, clienterrors.GetError(err)
+	}
+	return stream, nil
+}
+
+// GetConfigMap returns the config map with the given name
+func (c *knCoreClient) GetConfigMap(name string) (*corev1.ConfigMap, error) {
+	configMap, err := c.client.ConfigMaps(c.namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, clienterrors.GetError(err)
+	}
+	return configMap, nil
+}
+
+// UpdateConfigMap updates the given config map
+func (c *knCoreClient) UpdateConfigMap(configMap *corev1.ConfigMap, opts ...metav1.UpdateOptions) error {
+	if len(opts) > 0 {
+		result := &corev1.ConfigMap{}
+		err := util.UpdateWithOptions(c.client.RESTClient(), c.namespace, "configmaps", configMap.Name, configMap, opts[0], result)
+		if err != nil {
+			return clienterrors.GetError(err)
+		}
+		*configMap = *result
+		return nil
+	}
+	_, err := c.client.ConfigMaps(c.namespace).Update(configMap)
+	if err != nil {
+		return clienterrors.GetError(err)
+	}
+	return nil
+}
+
+// ListEvents lists the events matching the given field selector
+func (c *knCoreClient) ListEvents(fieldSelector string) (*corev1.EventList, error) {
+	eventList, err := c.client.Events(c.namespace).List(metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return nil, clienterrors.GetError(err)
+	}
+	return eventList, nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"io"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/client/pkg/util/mock"
+)
+
+// MockKnCoreClient is a combine of test object and recorder
+type MockKnCoreClient struct {
+	t        *testing.T
+	recorder *CoreRecorder
+}
+
+// NewMockKnCoreClient returns a new mock instance which you need to record for
+func NewMockKnCoreClient(t *testing.T, ns ...string) *MockKnCoreClient {
+	namespace := "default"
+	if len(ns) > 0 {
+		namespace = ns[0]
+	}
+	return &MockKnCoreClient{
+		t:        t,
+		recorder: &CoreRecorder{mock.NewRecorder(t, namespace)},
+	}
+}
+
+// Ensure that the interface is implemented
+var _ KnCoreClient = &MockKnCoreClient{}
+
+// CoreRecorder is recorder for core objects
+type CoreRecorder struct {
+	r *mock.Recorder
+}
+
+// Recorder returns the recorder for registering API calls
+func (c *MockKnCoreClient) Recorder() *CoreRecorder {
+	return c.recorder
+}
+
+// Namespace of this client
+func (c *MockKnCoreClient) Namespace() string {
+	return c.recorder.r.Namespace()
+}
+
+// ListPods records a call for ListPods with the expected result and error (nil if none)
+func (cr *CoreRecorder) ListPods(labelSelector interface{}, podList *corev1.PodList, err error) {
+	cr.r.Add("ListPods", []interface{}{labelSelector}, []interface{}{podList, err})
+}
+
+// ListPods lists the pods matching the given label selector
+func (c *MockKnCoreClient) ListPods(labelSelector string) (*corev1.PodList, error) {
+	call := c.recorder.r.VerifyCall("ListPods", labelSelector)
+	return call.Result[0].(*corev1.PodList), mock.ErrorOrNil(call.Result[1])
+}
+
+// GetPodLogs records a call for GetPodLogs with the expected result and error (nil if none)
+func (cr *CoreRecorder) GetPodLogs(name interface{}, options interface{}, stream io.ReadCloser, err error) {
+	cr.r.Add("GetPodLogs", []interface{}{name, options}, []interface{}{stream, err})
+}
+
+// GetPodLogs opens a stream to the logs of a pod's container
+func (c *MockKnCoreClient) GetPodLogs(name string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	call := c.recorder.r.VerifyCall("GetPodLogs", name, options)
+	stream, _ := call.Result[0].(io.ReadCloser)
+	return stream, mock.ErrorOrNil(call.Result[1])
+}
+
+// GetConfigMap records a call for GetConfigMap with the expected result and error (nil if none)
+func (cr *CoreRecorder) GetConfigMap(name interface{}, configMap *corev1.ConfigMap, err error) {
+	cr.r.Add("GetConfigMap", []interface{}{name}, []interface{}{configMap, err})
+}
+
+// GetConfigMap returns the config map with the given name
+func (c *MockKnCoreClient) GetConfigMap(name string) (*corev1.ConfigMap, error) {
+	call := c.recorder.r.VerifyCall("GetConfigMap", name)
+	return call.Result[0].(*corev1.ConfigMap), mock.ErrorOrNil(call.Result[1])
+}
+
+// UpdateConfigMap records a call for UpdateConfigMap with the expected error (nil if none)
+func (cr *CoreRecorder) UpdateConfigMap(configMap interface{}, err error) {
+	cr.r.Add("UpdateConfigMap", []interface{}{configMap}, []interface{}{err})
+}
+
+// UpdateConfigMap updates the given config map. Options are not verified
+func (c *MockKnCoreClient) UpdateConfigMap(configMap *corev1.ConfigMap, opts ...metav1.UpdateOptions) error {
+	call := c.recorder.r.VerifyCall("UpdateConfigMap", configMap)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// ListEvents records a call for ListEvents with the expected result and error (nil if none)
+func (cr *CoreRecorder) ListEvents(fieldSelector interface{}, eventList *corev1.EventList, err error) {
+	cr.r.Add("ListEvents", []interface{}{fieldSelector}, []interface{}{eventList, err})
+}
+
+// ListEvents lists the events matching the given field selector
+func (c *MockKnCoreClient) ListEvents(fieldSelector string) (*corev1.EventList, error) {
+	call := c.recorder.r.VerifyCall("ListEvents", fieldSelector)
+	return call.Result[0].(*corev1.EventList), mock.ErrorOrNil(call.Result[1])
+}
+
+// Validate validates whether every recorded action has been called
+func (cr *CoreRecorder) Validate() {
+	cr.r.CheckThatAllRecordedMethodsHaveBeenCalled()
+}
@@ -0,0 +1,47 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestMockKnCoreClient(t *testing.T) {
+
+	client := NewMockKnCoreClient(t)
+
+	recorder := client.Recorder()
+
+	// Record all services
+	recorder.ListPods(mock.Any(), nil, nil)
+	recorder.GetPodLogs("foo", mock.Any(), nil, nil)
+	recorder.GetConfigMap("config-domain", nil, nil)
+	recorder.UpdateConfigMap(mock.Any(), nil)
+	recorder.ListEvents("", nil, nil)
+
+	// Call all services
+	client.ListPods("serving.knative.dev/revision=foo")
+	client.GetPodLogs("foo", &corev1.PodLogOptions{})
+	client.GetConfigMap("config-domain")
+	client.UpdateConfigMap(&corev1.ConfigMap{})
+	client.ListEvents("")
+
+	// Validate
+	recorder.Validate()
+}
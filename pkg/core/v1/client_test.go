@@ -0,0 +1,145 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+)
+
+func setup(t *testing.T, handler http.HandlerFunc) (KnCoreClient, func()) {
+	server := httptest.NewServer(handler)
+	client, err := clientcorev1.NewForConfig(&rest.Config{Host: server.URL})
+	assert.NilError(t, err)
+	return NewKnCoreClient(client, "default"), server.Close
+}
+
+func TestNamespace(t *testing.T) {
+	client, done := setup(t, func(w http.ResponseWriter, r *http.Request) {})
+	defer done()
+	assert.Equal(t, client.Namespace(), "default")
+}
+
+func TestListPods(t *testing.T) {
+	client, done := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/api/v1/namespaces/default/pods")
+		assert.Equal(t, r.URL.Query().Get("labelSelector"), "serving.knative.dev/revision=foo-abcde-1")
+		podList := corev1.PodList{
+			TypeMeta: metav1.TypeMeta{Kind: "PodList", APIVersion: "v1"},
+			Items:    []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: "foo-abcde-1-deployment-xyz"}}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(podList)
+	})
+	defer done()
+
+	podList, err := client.ListPods("serving.knative.dev/revision=foo-abcde-1")
+	assert.NilError(t, err)
+	assert.Equal(t, len(podList.Items), 1)
+	assert.Equal(t, podList.Items[0].Name, "foo-abcde-1-deployment-xyz")
+}
+
+func TestGetPodLogs(t *testing.T) {
+	client, done := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/namespaces/default/pods/foo/log" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, r.URL.Query().Get("container"), "user-container")
+		assert.Equal(t, r.URL.Query().Get("tailLines"), "10")
+		w.Write([]byte("hello\nworld\n"))
+	})
+	defer done()
+
+	tail := int64(10)
+	stream, err := client.GetPodLogs("foo", &corev1.PodLogOptions{Container: "user-container", TailLines: &tail})
+	assert.NilError(t, err)
+	defer stream.Close()
+	content, err := ioutil.ReadAll(stream)
+	assert.NilError(t, err)
+	assert.Equal(t, string(content), "hello\nworld\n")
+
+	_, err = client.GetPodLogs("bar", &corev1.PodLogOptions{})
+	assert.Assert(t, err != nil)
+}
+
+func TestGetConfigMap(t *testing.T) {
+	client, done := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/api/v1/namespaces/default/configmaps/config-domain")
+		configMap := corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "config-domain"},
+			Data:       map[string]string{"example.com": ""},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(configMap)
+	})
+	defer done()
+
+	configMap, err := client.GetConfigMap("config-domain")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, configMap.Data, map[string]string{"example.com": ""})
+}
+
+func TestUpdateConfigMap(t *testing.T) {
+	client, done := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/api/v1/namespaces/default/configmaps/config-domain")
+		var configMap corev1.ConfigMap
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&configMap))
+		if r.URL.Query().Get("dryRun") == "All" {
+			configMap.Annotations = map[string]string{"dry-run": "true"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(configMap)
+	})
+	defer done()
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config-domain"}}
+	err := client.UpdateConfigMap(configMap)
+	assert.NilError(t, err)
+
+	err = client.UpdateConfigMap(configMap, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+	assert.NilError(t, err)
+	assert.Equal(t, configMap.Annotations["dry-run"], "true")
+}
+
+func TestListEvents(t *testing.T) {
+	client, done := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/api/v1/namespaces/default/events")
+		assert.Equal(t, r.URL.Query().Get("fieldSelector"), "involvedObject.name=foo")
+		eventList := corev1.EventList{
+			TypeMeta: metav1.TypeMeta{Kind: "EventList", APIVersion: "v1"},
+			Items:    []corev1.Event{{ObjectMeta: metav1.ObjectMeta{Name: "foo.123"}, Reason: "Created"}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(eventList)
+	})
+	defer done()
+
+	eventList, err := client.ListEvents("involvedObject.name=foo")
+	assert.NilError(t, err)
+	assert.Equal(t, len(eventList.Items), 1)
+	assert.Equal(t, eventList.Items[0].Reason, "Created")
+}
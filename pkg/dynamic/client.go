@@ -21,10 +21,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 
 	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
 )
 
 const (
@@ -54,6 +57,12 @@ type KnDynamicClient interface {
 	// ListSourcesUsingGVKs returns list of available source objects using given list of GVKs
 	ListSourcesUsingGVKs(*[]schema.GroupVersionKind, ...WithType) (*unstructured.UnstructuredList, error)
 
+	// WatchSources returns a watch for changes to all available source objects
+	WatchSources(types ...WithType) (watch.Interface, error)
+
+	// WatchSourcesUsingGVKs returns a watch for changes to source objects using given list of GVKs
+	WatchSourcesUsingGVKs(*[]schema.GroupVersionKind, ...WithType) (watch.Interface, error)
+
 	// RawClient returns the raw dynamic client interface
 	RawClient() dynamic.Interface
 }
@@ -112,7 +121,6 @@ func (c knDynamicClient) RawClient() dynamic.Interface {
 func (c *knDynamicClient) ListSources(types ...WithType) (*unstructured.UnstructuredList, error) {
 	var (
 		sourceList               unstructured.UnstructuredList
-		options                  metav1.ListOptions
 		numberOfSourceTypesFound int
 	)
 	sourceTypes, err := c.ListSourcesTypes()
@@ -124,7 +132,6 @@ func (c *knDynamicClient) ListSources(types ...WithType) (*unstructured.Unstruct
 		return nil, errors.New("no sources found on the backend, please verify the installation")
 	}
 
-	namespace := c.Namespace()
 	filters := WithTypes(types).List()
 	// For each source type available, find out each source types objects
 	for _, source := range sourceTypes.Items {
@@ -145,7 +152,7 @@ func (c *knDynamicClient) ListSources(types ...WithType) (*unstructured.Unstruct
 		}
 
 		// list objects of source type with this GVR
-		sList, err := c.client.Resource(gvr).Namespace(namespace).List(options)
+		sList, err := c.listSourceObjects(gvr, types)
 		if err != nil {
 			return nil, err
 		}
@@ -173,10 +180,8 @@ func (c *knDynamicClient) ListSourcesUsingGVKs(gvks *[]schema.GroupVersionKind,
 
 	var (
 		sourceList               unstructured.UnstructuredList
-		options                  metav1.ListOptions
 		numberOfSourceTypesFound int
 	)
-	namespace := c.Namespace()
 	filters := WithTypes(types).List()
 
 	for _, gvk := range *gvks {
@@ -187,7 +192,7 @@ func (c *knDynamicClient) ListSourcesUsingGVKs(gvks *[]schema.GroupVersionKind,
 		gvr := gvk.GroupVersion().WithResource(strings.ToLower(gvk.Kind) + "s")
 
 		// list objects of source type with this GVR
-		sList, err := c.client.Resource(gvr).Namespace(namespace).List(options)
+		sList, err := c.listSourceObjects(gvr, types)
 		if err != nil {
 			return nil, err
 		}
@@ -206,3 +211,81 @@ func (c *knDynamicClient) ListSourcesUsingGVKs(gvks *[]schema.GroupVersionKind,
 	}
 	return &sourceList, nil
 }
+
+// listSourceObjects lists all objects of the source type with the given GVR, page by page if a chunk size is given
+func (c *knDynamicClient) listSourceObjects(gvr schema.GroupVersionResource, types WithTypes) (*unstructured.UnstructuredList, error) {
+	sList, err := types.ListConfigs().ListAll(func(opts metav1.ListOptions) (runtime.Object, error) {
+		return c.client.Resource(gvr).Namespace(c.Namespace()).List(opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sList.(*unstructured.UnstructuredList), nil
+}
+
+// WatchSources returns a watch for changes to all available source objects
+func (c *knDynamicClient) WatchSources(types ...WithType) (watch.Interface, error) {
+	sourceTypes, err := c.ListSourcesTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceTypes == nil || len(sourceTypes.Items) == 0 {
+		return nil, errors.New("no sources found on the backend, please verify the installation")
+	}
+
+	var gvrs []schema.GroupVersionResource
+	filters := WithTypes(types).List()
+	for _, source := range sourceTypes.Items {
+		sourceKind, err := kindFromUnstructured(&source)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(filters) > 0 && !util.SliceContainsIgnoreCase(filters, sourceKind) {
+			continue
+		}
+
+		gvr, err := gvrFromUnstructured(&source)
+		if err != nil {
+			return nil, err
+		}
+		gvrs = append(gvrs, gvr)
+	}
+	return c.watchResources(gvrs, WithTypes(types).ListConfigs().ListOptions())
+}
+
+// WatchSourcesUsingGVKs returns a watch for changes to source objects using given list of GVKs
+func (c *knDynamicClient) WatchSourcesUsingGVKs(gvks *[]schema.GroupVersionKind, types ...WithType) (watch.Interface, error) {
+	var gvrs []schema.GroupVersionResource
+	if gvks != nil {
+		filters := WithTypes(types).List()
+		for _, gvk := range *gvks {
+			if len(filters) > 0 && !util.SliceContainsIgnoreCase(filters, gvk.Kind) {
+				continue
+			}
+			gvrs = append(gvrs, gvk.GroupVersion().WithResource(strings.ToLower(gvk.Kind)+"s"))
+		}
+	}
+	return c.watchResources(gvrs, WithTypes(types).ListConfigs().ListOptions())
+}
+
+// watchResources merges the watches of all objects of the given resources into a single watch
+func (c *knDynamicClient) watchResources(gvrs []schema.GroupVersionResource, options metav1.ListOptions) (watch.Interface, error) {
+	var watchers []watch.Interface
+	for _, gvr := range gvrs {
+		resource := c.client.Resource(gvr).Namespace(c.Namespace())
+		watcher, err := wait.NewListWatcher(resource.Watch,
+			func(opts metav1.ListOptions) (runtime.Object, error) {
+				return resource.List(opts)
+			}, options)
+		if err != nil {
+			for _, w := range watchers {
+				w.Stop()
+			}
+			return nil, err
+		}
+		watchers = append(watchers, watcher)
+	}
+	return wait.NewMultiWatcher(watchers...), nil
+}
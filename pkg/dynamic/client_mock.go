@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"knative.dev/client/pkg/util/mock"
 )
@@ -116,6 +117,30 @@ func (c *MockKnDynamicClient) ListSourcesUsingGVKs(gvks *[]schema.GroupVersionKi
 	return call.Result[0].(*unstructured.UnstructuredList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchSources returns a watch for changes to all available source objects
+func (dr *ClientRecorder) WatchSources(types interface{}, watcher watch.Interface, err error) {
+	dr.r.Add("WatchSources", []interface{}{types}, []interface{}{watcher, err})
+}
+
+// WatchSources returns a watch for changes to all available source objects
+func (c *MockKnDynamicClient) WatchSources(types ...WithType) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchSources", types)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
+// WatchSourcesUsingGVKs returns a watch for changes to source objects using given list of GVKs
+func (dr *ClientRecorder) WatchSourcesUsingGVKs(gvks interface{}, types interface{}, watcher watch.Interface, err error) {
+	dr.r.Add("WatchSourcesUsingGVKs", []interface{}{gvks, types}, []interface{}{watcher, err})
+}
+
+// WatchSourcesUsingGVKs returns a watch for changes to source objects using given list of GVKs
+func (c *MockKnDynamicClient) WatchSourcesUsingGVKs(gvks *[]schema.GroupVersionKind, types ...WithType) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchSourcesUsingGVKs", gvks, types)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Validate validates whether every recorded action has been called
 func (dr *ClientRecorder) Validate() {
 	dr.r.CheckThatAllRecordedMethodsHaveBeenCalled()
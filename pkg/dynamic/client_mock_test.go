@@ -34,12 +34,16 @@ func TestMockKnDynamicClient(t *testing.T) {
 	recorder.ListSources(mock.Any(), nil, nil)
 	recorder.RawClient(&fake.FakeDynamicClient{})
 	recorder.ListSourcesUsingGVKs(mock.Any(), mock.Any(), nil, nil)
+	recorder.WatchSources(mock.Any(), nil, nil)
+	recorder.WatchSourcesUsingGVKs(mock.Any(), mock.Any(), nil, nil)
 
 	client.ListCRDs(metav1.ListOptions{})
 	client.ListSourcesTypes()
 	client.ListSources(WithTypeFilter("blub"))
 	client.RawClient()
 	client.ListSourcesUsingGVKs(&[]schema.GroupVersionKind{}, WithTypeFilter("blub"))
+	client.WatchSources(WithTypeFilter("blub"))
+	client.WatchSourcesUsingGVKs(&[]schema.GroupVersionKind{}, WithTypeFilter("blub"))
 	// Validate
 	recorder.Validate()
 }
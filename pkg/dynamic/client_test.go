@@ -24,11 +24,14 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
 	eventingv1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
 )
 
 const testNamespace = "current"
@@ -157,6 +160,94 @@ func TestListSourcesUsingGVKs(t *testing.T) {
 		assert.Equal(t, len(s.Items), 1)
 	})
 
+	t.Run("source list with label selector", func(t *testing.T) {
+		labeled := newSourceUnstructuredObj("p2", "sources.knative.dev/v1alpha1", "PingSource")
+		labeled.SetLabels(map[string]string{"team": "payments"})
+		client := createFakeKnDynamicClient(testNamespace,
+			newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+			newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource"),
+			labeled,
+		)
+		gvks := []schema.GroupVersionKind{schema.GroupVersion{"sources.knative.dev", "v1alpha1"}.WithKind("PingSource")}
+
+		s, err := client.ListSourcesUsingGVKs(&gvks, WithListConfig(util.WithLabelSelector("team=payments")))
+		assert.NilError(t, err)
+		assert.Equal(t, len(s.Items), 1)
+		assert.Equal(t, s.Items[0].GetName(), "p2")
+	})
+
+	t.Run("source list with chunk size and page handler", func(t *testing.T) {
+		client := createFakeKnDynamicClient(testNamespace,
+			newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+			newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource"),
+			newSourceUnstructuredObj("p2", "sources.knative.dev/v1alpha1", "PingSource"),
+		)
+		gvks := []schema.GroupVersionKind{schema.GroupVersion{"sources.knative.dev", "v1alpha1"}.WithKind("PingSource")}
+
+		var nrPageItems int
+		s, err := client.ListSourcesUsingGVKs(&gvks, WithListConfig(util.WithChunkSize(1), util.WithPageHandler(func(page runtime.Object) error {
+			nrPageItems += len(page.(*unstructured.UnstructuredList).Items)
+			return nil
+		})))
+		assert.NilError(t, err)
+		assert.Equal(t, len(s.Items), 2)
+		assert.Equal(t, nrPageItems, 2)
+	})
+
+}
+
+func TestWatchSources(t *testing.T) {
+	t.Run("sources not installed", func(t *testing.T) {
+		client := createFakeKnDynamicClient(testNamespace)
+		_, err := client.WatchSources()
+		assert.Check(t, util.ContainsAll(err.Error(), "no sources", "found", "backend"))
+	})
+
+	t.Run("watch filtered source types", func(t *testing.T) {
+		client := createFakeKnDynamicClient(testNamespace,
+			newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+			newSourceCRDObjWithSpec("apiserversources", "sources.knative.dev", "v1alpha1", "ApiServerSource"),
+		)
+		var watched []string
+		client.RawClient().(*dynamicfake.FakeDynamicClient).PrependWatchReactor("*",
+			func(a clienttesting.Action) (bool, watch.Interface, error) {
+				watched = append(watched, a.GetResource().Resource)
+				assert.Equal(t, a.GetNamespace(), testNamespace)
+				w := wait.NewFakeWatch([]watch.Event{
+					{Type: watch.Added, Object: newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource")},
+				})
+				w.Start()
+				return true, w, nil
+			})
+		w, err := client.WatchSources(WithTypeFilter("pingsource"))
+		assert.NilError(t, err)
+		event := <-w.ResultChan()
+		assert.Equal(t, event.Type, watch.Added)
+		assert.Equal(t, event.Object.(*unstructured.Unstructured).GetName(), "p1")
+		w.Stop()
+		assert.DeepEqual(t, watched, []string{"pingsources"})
+	})
+}
+
+func TestWatchSourcesUsingGVKs(t *testing.T) {
+	client := createFakeKnDynamicClient(testNamespace)
+	w, err := client.WatchSourcesUsingGVKs(nil)
+	assert.NilError(t, err)
+	_, ok := <-w.ResultChan()
+	assert.Assert(t, !ok)
+
+	var watched []string
+	client.RawClient().(*dynamicfake.FakeDynamicClient).PrependWatchReactor("*",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			watched = append(watched, a.GetResource().Resource)
+			return true, wait.NewFakeWatch(nil), nil
+		})
+	gv := schema.GroupVersion{Group: "sources.knative.dev", Version: "v1alpha2"}
+	gvks := []schema.GroupVersionKind{gv.WithKind("ApiServerSource"), gv.WithKind("PingSource")}
+	w, err = client.WatchSourcesUsingGVKs(&gvks, WithTypeFilter("ApiServerSource"))
+	assert.NilError(t, err)
+	w.Stop()
+	assert.DeepEqual(t, watched, []string{"apiserversources"})
 }
 
 // createFakeKnDynamicClient gives you a dynamic client for testing containing the given objects.
@@ -20,6 +20,8 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/client/pkg/util"
 )
 
 // gvrFromUnstructured takes a unstructured object of CRD type and finds GVR from its spec
@@ -100,8 +102,13 @@ func kindFromUnstructured(u *unstructured.Unstructured) (string, error) {
 	return kind, nil
 }
 
-// TypesFilter for keeping list of sources types to filter upo
-type TypesFilter []string
+// TypesFilter for keeping list of sources types and the list configs for listing the source objects
+type TypesFilter struct {
+	// Types are the source type names to include
+	Types []string
+	// ListConfigs are applied when listing the source objects of each type
+	ListConfigs util.ListConfigs
+}
 
 // WithType function for easy filtering on source types
 type WithType func(filters *TypesFilter)
@@ -112,17 +119,33 @@ type WithTypes []WithType
 // WithTypeFilter can be used to filter based on source type name
 func WithTypeFilter(name string) WithType {
 	return func(filters *TypesFilter) {
-		*filters = append(*filters, name)
+		filters.Types = append(filters.Types, name)
+	}
+}
+
+// WithListConfig can be used to filter or page the source objects with list configs like util.WithLabelSelector
+func WithListConfig(configs ...util.ListConfig) WithType {
+	return func(filters *TypesFilter) {
+		filters.ListConfigs = append(filters.ListConfigs, configs...)
 	}
 }
 
 // List returns the source type name list recorded via WithTypeFilter
 func (types WithTypes) List() []string {
-	var stypes TypesFilter
+	return types.filter().Types
+}
+
+// ListConfigs returns the list configs for listing source objects as recorded via WithListConfig
+func (types WithTypes) ListConfigs() util.ListConfigs {
+	return types.filter().ListConfigs
+}
+
+func (types WithTypes) filter() TypesFilter {
+	var filters TypesFilter
 	for _, f := range types {
-		f(&stypes)
+		f(&filters)
 	}
-	return stypes
+	return filters
 }
 
 // UnstructuredCRDFromGVK constructs an unstructured object using the given GVK
@@ -146,3 +169,13 @@ func UnstructuredCRDFromGVK(gvk schema.GroupVersionKind) *unstructured.Unstructu
 
 	return u
 }
+
+// GVRAndKindFromSourceCRD returns the GVR and the kind of the source type described by the given CRD
+func GVRAndKindFromSourceCRD(crd *unstructured.Unstructured) (schema.GroupVersionResource, string, error) {
+	gvr, err := gvrFromUnstructured(crd)
+	if err != nil {
+		return gvr, "", err
+	}
+	kind, err := kindFromUnstructured(crd)
+	return gvr, kind, err
+}
@@ -79,6 +79,18 @@ func TestGVRFromUnstructured(t *testing.T) {
 	assert.Check(t, util.ContainsAll(err.Error(), "can't", "find", "version"))
 }
 
+func TestGVRAndKindFromSourceCRD(t *testing.T) {
+	gvr, kind, err := GVRAndKindFromSourceCRD(
+		newSourceCRDObjWithSpec("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"),
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, kind, "KafkaSource")
+	assert.Equal(t, gvr, schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha1", Resource: "kafkasources"})
+
+	_, _, err = GVRAndKindFromSourceCRD(newSourceCRDObj("foo"))
+	assert.Check(t, err != nil)
+}
+
 func TestUnstructuredCRDFromGVK(t *testing.T) {
 	u := UnstructuredCRDFromGVK(schema.GroupVersionKind{"sources.knative.dev", "v1alpha2", "ApiServerSource"})
 	g, err := groupFromUnstructured(u)
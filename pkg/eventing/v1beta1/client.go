@@ -36,24 +36,34 @@ import (
 type KnEventingClient interface {
 	// Namespace in which this client is operating for
 	Namespace() string
-	// CreateTrigger is used to create an instance of trigger
-	CreateTrigger(trigger *v1beta1.Trigger) error
+	// CreateTrigger is used to create an instance of trigger. If create options are given (e.g. for a
+	// server side dry-run), the given trigger is updated with the trigger returned by the server.
+	CreateTrigger(trigger *v1beta1.Trigger, opts ...apis_v1.CreateOptions) error
 	// DeleteTrigger is used to delete an instance of trigger
-	DeleteTrigger(name string) error
+	DeleteTrigger(name string, opts ...apis_v1.DeleteOptions) error
 	// GetTrigger is used to get an instance of trigger
 	GetTrigger(name string) (*v1beta1.Trigger, error)
 	// ListTrigger returns list of trigger CRDs
-	ListTriggers() (*v1beta1.TriggerList, error)
-	// UpdateTrigger is used to update an instance of trigger
-	UpdateTrigger(trigger *v1beta1.Trigger) error
-	// CreateBroker is used to create an instance of broker
-	CreateBroker(broker *v1beta1.Broker) error
+	ListTriggers(opts ...util.ListConfig) (*v1beta1.TriggerList, error)
+	// WatchTriggers returns a watch for changes to all triggers
+	WatchTriggers(opts ...util.ListConfig) (watch.Interface, error)
+	// UpdateTrigger is used to update an instance of trigger. If update options are given,
+	// the given trigger is updated with the trigger returned by the server.
+	UpdateTrigger(trigger *v1beta1.Trigger, opts ...apis_v1.UpdateOptions) error
+	// CreateBroker is used to create an instance of broker. If create options are given,
+	// the given broker is updated with the broker returned by the server.
+	CreateBroker(broker *v1beta1.Broker, opts ...apis_v1.CreateOptions) error
 	// GetBroker is used to get an instance of broker
 	GetBroker(name string) (*v1beta1.Broker, error)
-	// DeleteBroker is used to delete an instance of broker
-	DeleteBroker(name string, timeout time.Duration) error
+	// UpdateBroker is used to update an instance of broker. If update options are given,
+	// the given broker is updated with the broker returned by the server.
+	UpdateBroker(broker *v1beta1.Broker, opts ...apis_v1.UpdateOptions) error
+	// DeleteBroker is used to delete an instance of broker. The timeout is ignored for a server side dry-run.
+	DeleteBroker(name string, timeout time.Duration, opts ...apis_v1.DeleteOptions) error
 	// ListBroker returns list of broker CRDs
-	ListBrokers() (*v1beta1.BrokerList, error)
+	ListBrokers(opts ...util.ListConfig) (*v1beta1.BrokerList, error)
+	// WatchBrokers returns a watch for changes to all brokers
+	WatchBrokers(opts ...util.ListConfig) (watch.Interface, error)
 }
 
 // KnEventingClient is a combination of Sources client interface and namespace
@@ -73,7 +83,16 @@ func NewKnEventingClient(client client_v1beta1.EventingV1beta1Interface, namespa
 }
 
 //CreateTrigger is used to create an instance of trigger
-func (c *knEventingClient) CreateTrigger(trigger *v1beta1.Trigger) error {
+func (c *knEventingClient) CreateTrigger(trigger *v1beta1.Trigger, opts ...apis_v1.CreateOptions) error {
+	if len(opts) > 0 {
+		result := &v1beta1.Trigger{}
+		err := util.CreateWithOptions(c.client.RESTClient(), c.namespace, "triggers", trigger, opts[0], result)
+		if err != nil {
+			return kn_errors.GetError(err)
+		}
+		*trigger = *result
+		return updateEventingGVK(trigger)
+	}
 	_, err := c.client.Triggers(c.namespace).Create(trigger)
 	if err != nil {
 		return kn_errors.GetError(err)
@@ -82,8 +101,12 @@ func (c *knEventingClient) CreateTrigger(trigger *v1beta1.Trigger) error {
 }
 
 //DeleteTrigger is used to delete an instance of trigger
-func (c *knEventingClient) DeleteTrigger(name string) error {
-	err := c.client.Triggers(c.namespace).Delete(name, &apis_v1.DeleteOptions{})
+func (c *knEventingClient) DeleteTrigger(name string, opts ...apis_v1.DeleteOptions) error {
+	deleteOptions := apis_v1.DeleteOptions{}
+	if len(opts) > 0 {
+		deleteOptions = opts[0]
+	}
+	err := c.client.Triggers(c.namespace).Delete(name, &deleteOptions)
 	if err != nil {
 		return kn_errors.GetError(err)
 	}
@@ -99,13 +122,32 @@ func (c *knEventingClient) GetTrigger(name string) (*v1beta1.Trigger, error) {
 	return trigger, nil
 }
 
-func (c *knEventingClient) ListTriggers() (*v1beta1.TriggerList, error) {
-	triggerList, err := c.client.Triggers(c.namespace).List(apis_v1.ListOptions{})
+// WatchTriggers is used to watch all trigger instances for changes
+func (c *knEventingClient) WatchTriggers(config ...util.ListConfig) (watch.Interface, error) {
+	return wait.NewListWatcher(c.client.Triggers(c.namespace).Watch,
+		func(opts apis_v1.ListOptions) (runtime.Object, error) {
+			return c.client.Triggers(c.namespace).List(opts)
+		}, util.ListConfigs(config).ListOptions())
+}
+
+func (c *knEventingClient) ListTriggers(config ...util.ListConfig) (*v1beta1.TriggerList, error) {
+	triggerList, err := util.ListConfigs(config).ListAll(func(opts apis_v1.ListOptions) (runtime.Object, error) {
+		triggerList, err := c.client.Triggers(c.namespace).List(opts)
+		if err != nil {
+			return nil, kn_errors.GetError(err)
+		}
+		return updateEventingGVKForTriggerList(triggerList)
+	})
 	if err != nil {
-		return nil, kn_errors.GetError(err)
+		return nil, err
 	}
+	return triggerList.(*v1beta1.TriggerList), nil
+}
+
+// update the list and all items contained in the list with the eventing GroupVersionKind
+func updateEventingGVKForTriggerList(triggerList *v1beta1.TriggerList) (*v1beta1.TriggerList, error) {
 	triggerListNew := triggerList.DeepCopy()
-	err = updateEventingGVK(triggerListNew)
+	err := updateEventingGVK(triggerListNew)
 	if err != nil {
 		return nil, err
 	}
@@ -122,8 +164,17 @@ func (c *knEventingClient) ListTriggers() (*v1beta1.TriggerList, error) {
 	return triggerListNew, nil
 }
 
-//CreateTrigger is used to create an instance of trigger
-func (c *knEventingClient) UpdateTrigger(trigger *v1beta1.Trigger) error {
+//UpdateTrigger is used to update an instance of trigger
+func (c *knEventingClient) UpdateTrigger(trigger *v1beta1.Trigger, opts ...apis_v1.UpdateOptions) error {
+	if len(opts) > 0 {
+		result := &v1beta1.Trigger{}
+		err := util.UpdateWithOptions(c.client.RESTClient(), c.namespace, "triggers", trigger.Name, trigger, opts[0], result)
+		if err != nil {
+			return kn_errors.GetError(err)
+		}
+		*trigger = *result
+		return updateEventingGVK(trigger)
+	}
 	_, err := c.client.Triggers(c.namespace).Update(trigger)
 	if err != nil {
 		return kn_errors.GetError(err)
@@ -214,7 +265,16 @@ func (b *TriggerBuilder) Build() *v1beta1.Trigger {
 }
 
 // CreateBroker is used to create an instance of broker
-func (c *knEventingClient) CreateBroker(broker *v1beta1.Broker) error {
+func (c *knEventingClient) CreateBroker(broker *v1beta1.Broker, opts ...apis_v1.CreateOptions) error {
+	if len(opts) > 0 {
+		result := &v1beta1.Broker{}
+		err := util.CreateWithOptions(c.client.RESTClient(), c.namespace, "brokers", broker, opts[0], result)
+		if err != nil {
+			return kn_errors.GetError(err)
+		}
+		*broker = *result
+		return updateEventingGVK(broker)
+	}
 	_, err := c.client.Brokers(c.namespace).Create(broker)
 	if err != nil {
 		return kn_errors.GetError(err)
@@ -231,17 +291,47 @@ func (c *knEventingClient) GetBroker(name string) (*v1beta1.Broker, error) {
 	return trigger, nil
 }
 
+// UpdateBroker is used to update an instance of broker
+func (c *knEventingClient) UpdateBroker(broker *v1beta1.Broker, opts ...apis_v1.UpdateOptions) error {
+	if len(opts) > 0 {
+		result := &v1beta1.Broker{}
+		err := util.UpdateWithOptions(c.client.RESTClient(), c.namespace, "brokers", broker.Name, broker, opts[0], result)
+		if err != nil {
+			return kn_errors.GetError(err)
+		}
+		*broker = *result
+		return updateEventingGVK(broker)
+	}
+	_, err := c.client.Brokers(c.namespace).Update(broker)
+	if err != nil {
+		return kn_errors.GetError(err)
+	}
+	return nil
+}
+
 // WatchBroker is used to create watcher object
 func (c *knEventingClient) WatchBroker(name string, timeout time.Duration) (watch.Interface, error) {
 	return wait.NewWatcher(c.client.Brokers(c.namespace).Watch,
 		c.client.RESTClient(), c.namespace, "brokers", name, timeout)
 }
 
+// WatchBrokers is used to watch all broker instances for changes
+func (c *knEventingClient) WatchBrokers(config ...util.ListConfig) (watch.Interface, error) {
+	return wait.NewListWatcher(c.client.Brokers(c.namespace).Watch,
+		func(opts apis_v1.ListOptions) (runtime.Object, error) {
+			return c.client.Brokers(c.namespace).List(opts)
+		}, util.ListConfigs(config).ListOptions())
+}
+
 // DeleteBroker is used to delete an instance of broker and wait for completion until given timeout
-// For `timeout == 0` delete is performed async without any wait
-func (c *knEventingClient) DeleteBroker(name string, timeout time.Duration) error {
-	if timeout == 0 {
-		return c.deleteBroker(name, apis_v1.DeletePropagationBackground)
+// For `timeout == 0` or a server side dry-run, delete is performed async without any wait
+func (c *knEventingClient) DeleteBroker(name string, timeout time.Duration, opts ...apis_v1.DeleteOptions) error {
+	deleteOptions := apis_v1.DeleteOptions{}
+	if len(opts) > 0 {
+		deleteOptions = opts[0]
+	}
+	if timeout == 0 || len(deleteOptions.DryRun) > 0 {
+		return c.deleteBroker(name, deleteOptions, apis_v1.DeletePropagationBackground)
 	}
 	waitC := make(chan error)
 	go func() {
@@ -249,7 +339,7 @@ func (c *knEventingClient) DeleteBroker(name string, timeout time.Duration) erro
 		err, _ := waitForEvent.Wait(name, wait.Options{Timeout: &timeout}, wait.NoopMessageCallback())
 		waitC <- err
 	}()
-	err := c.deleteBroker(name, apis_v1.DeletePropagationForeground)
+	err := c.deleteBroker(name, deleteOptions, apis_v1.DeletePropagationForeground)
 	if err != nil {
 		return err
 	}
@@ -257,8 +347,9 @@ func (c *knEventingClient) DeleteBroker(name string, timeout time.Duration) erro
 }
 
 // deleteBroker is used to delete an instance of broker
-func (c *knEventingClient) deleteBroker(name string, propagationPolicy apis_v1.DeletionPropagation) error {
-	err := c.client.Brokers(c.namespace).Delete(name, &apis_v1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+func (c *knEventingClient) deleteBroker(name string, options apis_v1.DeleteOptions, propagationPolicy apis_v1.DeletionPropagation) error {
+	options.PropagationPolicy = &propagationPolicy
+	err := c.client.Brokers(c.namespace).Delete(name, &options)
 	if err != nil {
 		return kn_errors.GetError(err)
 	}
@@ -266,13 +357,24 @@ func (c *knEventingClient) deleteBroker(name string, propagationPolicy apis_v1.D
 }
 
 // ListBrokers is used to retrieve the list of broker instances
-func (c *knEventingClient) ListBrokers() (*v1beta1.BrokerList, error) {
-	brokerList, err := c.client.Brokers(c.namespace).List(apis_v1.ListOptions{})
+func (c *knEventingClient) ListBrokers(config ...util.ListConfig) (*v1beta1.BrokerList, error) {
+	brokerList, err := util.ListConfigs(config).ListAll(func(opts apis_v1.ListOptions) (runtime.Object, error) {
+		brokerList, err := c.client.Brokers(c.namespace).List(opts)
+		if err != nil {
+			return nil, kn_errors.GetError(err)
+		}
+		return updateEventingGVKForBrokerList(brokerList)
+	})
 	if err != nil {
-		return nil, kn_errors.GetError(err)
+		return nil, err
 	}
+	return brokerList.(*v1beta1.BrokerList), nil
+}
+
+// update the list and all items contained in the list with the eventing GroupVersionKind
+func updateEventingGVKForBrokerList(brokerList *v1beta1.BrokerList) (*v1beta1.BrokerList, error) {
 	brokerListNew := brokerList.DeepCopy()
-	err = updateEventingGVK(brokerListNew)
+	err := updateEventingGVK(brokerListNew)
 	if err != nil {
 		return nil, err
 	}
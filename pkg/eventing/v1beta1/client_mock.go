@@ -18,8 +18,11 @@ import (
 	"testing"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
 
+	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
 )
 
@@ -66,7 +69,7 @@ func (sr *EventingRecorder) CreateTrigger(trigger interface{}, err error) {
 }
 
 // CreateTrigger performs a previously recorded action
-func (c *MockKnEventingClient) CreateTrigger(trigger *v1beta1.Trigger) error {
+func (c *MockKnEventingClient) CreateTrigger(trigger *v1beta1.Trigger, opts ...metav1.CreateOptions) error {
 	call := c.recorder.r.VerifyCall("CreateTrigger", trigger)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -88,7 +91,7 @@ func (sr *EventingRecorder) DeleteTrigger(name interface{}, err error) {
 }
 
 // DeleteTrigger performs a previously recorded action, failing if non has been registered
-func (c *MockKnEventingClient) DeleteTrigger(name string) error {
+func (c *MockKnEventingClient) DeleteTrigger(name string, opts ...metav1.DeleteOptions) error {
 	call := c.recorder.r.VerifyCall("DeleteTrigger", name)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -99,18 +102,30 @@ func (sr *EventingRecorder) ListTriggers(triggerList *v1beta1.TriggerList, err e
 }
 
 // ListTriggers performs a previously recorded action
-func (c *MockKnEventingClient) ListTriggers() (*v1beta1.TriggerList, error) {
+func (c *MockKnEventingClient) ListTriggers(opts ...util.ListConfig) (*v1beta1.TriggerList, error) {
 	call := c.recorder.r.VerifyCall("ListTriggers")
 	return call.Result[0].(*v1beta1.TriggerList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchTriggers records a call for WatchTriggers with the expected result and error (nil if none)
+func (sr *EventingRecorder) WatchTriggers(watcher watch.Interface, err error) {
+	sr.r.Add("WatchTriggers", nil, []interface{}{watcher, err})
+}
+
+// WatchTriggers performs a previously recorded action
+func (c *MockKnEventingClient) WatchTriggers(opts ...util.ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchTriggers")
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // UpdateTrigger records a call for ListTriggers with the expected result and error (nil if none)
 func (sr *EventingRecorder) UpdateTrigger(trigger interface{}, err error) {
 	sr.r.Add("UpdateTrigger", []interface{}{trigger}, []interface{}{err})
 }
 
 // UpdateTrigger performs a previously recorded action
-func (c *MockKnEventingClient) UpdateTrigger(trigger *v1beta1.Trigger) error {
+func (c *MockKnEventingClient) UpdateTrigger(trigger *v1beta1.Trigger, opts ...metav1.UpdateOptions) error {
 	call := c.recorder.r.VerifyCall("UpdateTrigger")
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -121,11 +136,22 @@ func (sr *EventingRecorder) CreateBroker(broker interface{}, err error) {
 }
 
 // CreateBroker performs a previously recorded action
-func (c *MockKnEventingClient) CreateBroker(broker *v1beta1.Broker) error {
+func (c *MockKnEventingClient) CreateBroker(broker *v1beta1.Broker, opts ...metav1.CreateOptions) error {
 	call := c.recorder.r.VerifyCall("CreateBroker", broker)
 	return mock.ErrorOrNil(call.Result[0])
 }
 
+// UpdateBroker records a call for UpdateBroker with the expected error
+func (sr *EventingRecorder) UpdateBroker(broker interface{}, err error) {
+	sr.r.Add("UpdateBroker", []interface{}{broker}, []interface{}{err})
+}
+
+// UpdateBroker performs a previously recorded action
+func (c *MockKnEventingClient) UpdateBroker(broker *v1beta1.Broker, opts ...metav1.UpdateOptions) error {
+	call := c.recorder.r.VerifyCall("UpdateBroker", broker)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
 // GetBroker records a call for GetBroker with the expected object or error. Either trigger or err should be nil
 func (sr *EventingRecorder) GetBroker(name interface{}, broker *v1beta1.Broker, err error) {
 	sr.r.Add("GetBroker", []interface{}{name}, []interface{}{broker, err})
@@ -143,7 +169,7 @@ func (sr *EventingRecorder) DeleteBroker(name, timeout interface{}, err error) {
 }
 
 // DeleteBroker performs a previously recorded action, failing if non has been registered
-func (c *MockKnEventingClient) DeleteBroker(name string, timeout time.Duration) error {
+func (c *MockKnEventingClient) DeleteBroker(name string, timeout time.Duration, opts ...metav1.DeleteOptions) error {
 	call := c.recorder.r.VerifyCall("DeleteBroker", name, timeout)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -154,11 +180,23 @@ func (sr *EventingRecorder) ListBrokers(brokerList *v1beta1.BrokerList, err erro
 }
 
 // ListBrokers performs a previously recorded action
-func (c *MockKnEventingClient) ListBrokers() (*v1beta1.BrokerList, error) {
+func (c *MockKnEventingClient) ListBrokers(opts ...util.ListConfig) (*v1beta1.BrokerList, error) {
 	call := c.recorder.r.VerifyCall("ListBrokers")
 	return call.Result[0].(*v1beta1.BrokerList), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchBrokers records a call for WatchBrokers with the expected result and error (nil if none)
+func (sr *EventingRecorder) WatchBrokers(watcher watch.Interface, err error) {
+	sr.r.Add("WatchBrokers", nil, []interface{}{watcher, err})
+}
+
+// WatchBrokers performs a previously recorded action
+func (c *MockKnEventingClient) WatchBrokers(opts ...util.ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchBrokers")
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Validate validates whether every recorded action has been called
 func (sr *EventingRecorder) Validate() {
 	sr.r.CheckThatAllRecordedMethodsHaveBeenCalled()
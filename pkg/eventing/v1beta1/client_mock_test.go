@@ -32,24 +32,30 @@ func TestMockKnClient(t *testing.T) {
 	recorder.CreateTrigger(&v1beta1.Trigger{}, nil)
 	recorder.DeleteTrigger("hello", nil)
 	recorder.ListTriggers(nil, nil)
+	recorder.WatchTriggers(nil, nil)
 	recorder.UpdateTrigger(&v1beta1.Trigger{}, nil)
 
 	recorder.CreateBroker(&v1beta1.Broker{}, nil)
 	recorder.GetBroker("foo", nil, nil)
+	recorder.UpdateBroker(&v1beta1.Broker{}, nil)
 	recorder.DeleteBroker("foo", time.Duration(10)*time.Second, nil)
 	recorder.ListBrokers(nil, nil)
+	recorder.WatchBrokers(nil, nil)
 
 	// Call all service
 	client.GetTrigger("hello")
 	client.CreateTrigger(&v1beta1.Trigger{})
 	client.DeleteTrigger("hello")
 	client.ListTriggers()
+	client.WatchTriggers()
 	client.UpdateTrigger(&v1beta1.Trigger{})
 
 	client.CreateBroker(&v1beta1.Broker{})
 	client.GetBroker("foo")
+	client.UpdateBroker(&v1beta1.Broker{})
 	client.DeleteBroker("foo", time.Duration(10)*time.Second)
 	client.ListBrokers()
+	client.WatchBrokers()
 
 	// Validate
 	recorder.Validate()
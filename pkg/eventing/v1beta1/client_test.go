@@ -15,7 +15,11 @@
 package v1beta1
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,9 +30,12 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
 	client_testing "k8s.io/client-go/testing"
+	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/wait"
 	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
+	client_v1beta1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/eventing/v1beta1"
 	"knative.dev/eventing/pkg/client/clientset/versioned/typed/eventing/v1beta1/fake"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -133,6 +140,43 @@ func TestListTrigger(t *testing.T) {
 	})
 }
 
+func TestListTriggerWithLabelSelector(t *testing.T) {
+	server, client := setup()
+
+	server.AddReactor("list", "triggers",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			restrictions := a.(client_testing.ListAction).GetListRestrictions()
+			assert.Equal(t, restrictions.Labels.String(), "team=payments")
+			trigger := newTrigger("trigger-1")
+			trigger.Labels = map[string]string{"team": "payments"}
+			return true, &v1beta1.TriggerList{Items: []v1beta1.Trigger{*trigger, *newTrigger("trigger-2")}}, nil
+		})
+
+	triggers, err := client.ListTriggers(util.WithLabelSelector("team=payments"))
+	assert.NilError(t, err)
+	assert.Equal(t, len(triggers.Items), 1)
+	assert.Equal(t, triggers.Items[0].Name, "trigger-1")
+}
+
+func TestWatchTriggers(t *testing.T) {
+	server, client := setup()
+
+	server.AddWatchReactor("triggers",
+		func(a client_testing.Action) (bool, watch.Interface, error) {
+			assert.Equal(t, testNamespace, a.GetNamespace())
+			w := wait.NewFakeWatch([]watch.Event{{Type: watch.Added, Object: newTrigger("trigger-1")}})
+			w.Start()
+			return true, w, nil
+		})
+
+	w, err := client.WatchTriggers()
+	assert.NilError(t, err)
+	event := <-w.ResultChan()
+	assert.Equal(t, event.Type, watch.Added)
+	assert.Equal(t, event.Object.(*v1beta1.Trigger).Name, "trigger-1")
+	w.Stop()
+}
+
 func TestTriggerBuilder(t *testing.T) {
 	a := NewTriggerBuilder("testtrigger")
 	a.Filters(map[string]string{"type": "foo"})
@@ -240,6 +284,31 @@ func TestBrokerGet(t *testing.T) {
 	assert.ErrorContains(t, err, "errorBroker")
 }
 
+func TestBrokerUpdate(t *testing.T) {
+	var name = "broker"
+	server, client := setup()
+
+	server.AddReactor("update", "brokers",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, testNamespace, a.GetNamespace())
+			broker := a.(client_testing.UpdateAction).GetObject().(*v1beta1.Broker)
+			if broker.Name == name {
+				return true, broker, nil
+			}
+			return true, nil, fmt.Errorf("error while updating broker %s", broker.Name)
+		})
+
+	t.Run("update broker without error", func(t *testing.T) {
+		err := client.UpdateBroker(newBroker(name))
+		assert.NilError(t, err)
+	})
+
+	t.Run("update broker with an error returns an error object", func(t *testing.T) {
+		err := client.UpdateBroker(newBroker("unknown"))
+		assert.ErrorContains(t, err, "unknown")
+	})
+}
+
 func TestBrokerDelete(t *testing.T) {
 	var name = "fooBroker"
 	server, client := setup()
@@ -313,6 +382,121 @@ func TestBrokerList(t *testing.T) {
 	})
 }
 
+func TestBrokerListWithChunkSize(t *testing.T) {
+	server, client := setup()
+
+	pages := []*v1beta1.BrokerList{
+		{ListMeta: metav1.ListMeta{Continue: "page-2"}, Items: []v1beta1.Broker{*newBroker("foo1"), *newBroker("foo2")}},
+		{Items: []v1beta1.Broker{*newBroker("foo3")}},
+	}
+	nrCalls := 0
+	server.AddReactor("list", "brokers",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			page := pages[nrCalls]
+			nrCalls++
+			return true, page, nil
+		})
+
+	var pageSizes []int
+	brokerList, err := client.ListBrokers(util.WithChunkSize(2), util.WithPageHandler(func(page runtime.Object) error {
+		brokerPage := page.(*v1beta1.BrokerList)
+		assert.Equal(t, brokerPage.Kind, "BrokerList")
+		pageSizes = append(pageSizes, len(brokerPage.Items))
+		return nil
+	}))
+	assert.NilError(t, err)
+	assert.Equal(t, nrCalls, 2)
+	assert.DeepEqual(t, pageSizes, []int{2, 1})
+	assert.Assert(t, len(brokerList.Items) == 3)
+	assert.Equal(t, brokerList.Items[2].Name, "foo3")
+	assert.Equal(t, brokerList.Continue, "")
+}
+
+func TestWatchBrokers(t *testing.T) {
+	server, client := setup()
+
+	server.AddWatchReactor("brokers",
+		func(a client_testing.Action) (bool, watch.Interface, error) {
+			assert.Equal(t, testNamespace, a.GetNamespace())
+			w := wait.NewFakeWatch([]watch.Event{{Type: watch.Deleted, Object: newBroker("foo")}})
+			w.Start()
+			return true, w, nil
+		})
+
+	w, err := client.WatchBrokers()
+	assert.NilError(t, err)
+	event := <-w.ResultChan()
+	assert.Equal(t, event.Type, watch.Deleted)
+	assert.Equal(t, event.Object.(*v1beta1.Broker).Name, "foo")
+	w.Stop()
+}
+
+func TestDryRun(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		var obj runtime.Object
+		if strings.HasPrefix(r.URL.Path, "/apis/eventing.knative.dev/v1beta1/namespaces/test-ns/brokers") {
+			obj = newBroker("foo")
+		} else {
+			trigger := newTrigger("foo")
+			trigger.Spec.Filter = &v1beta1.TriggerFilter{}
+			obj = trigger
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(obj)
+	}))
+	defer server.Close()
+
+	eventing, err := client_v1beta1.NewForConfig(&rest.Config{Host: server.URL})
+	assert.NilError(t, err)
+	client := NewKnEventingClient(eventing, testNamespace)
+
+	t.Run("create trigger with dry-run returns the defaulted trigger", func(t *testing.T) {
+		trigger := newTrigger("foo")
+		err := client.CreateTrigger(trigger, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		assert.NilError(t, err)
+		request := requests[len(requests)-1]
+		assert.Equal(t, request.Method, "POST")
+		assert.Equal(t, request.URL.Path, "/apis/eventing.knative.dev/v1beta1/namespaces/test-ns/triggers")
+		assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+		assert.Assert(t, trigger.Spec.Filter != nil)
+		assert.Equal(t, trigger.Kind, "Trigger")
+	})
+
+	t.Run("update trigger with dry-run returns the defaulted trigger", func(t *testing.T) {
+		trigger := newTrigger("foo")
+		err := client.UpdateTrigger(trigger, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+		assert.NilError(t, err)
+		request := requests[len(requests)-1]
+		assert.Equal(t, request.Method, "PUT")
+		assert.Equal(t, request.URL.Path, "/apis/eventing.knative.dev/v1beta1/namespaces/test-ns/triggers/foo")
+		assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+		assert.Assert(t, trigger.Spec.Filter != nil)
+	})
+
+	t.Run("create broker with dry-run", func(t *testing.T) {
+		broker := newBroker("foo")
+		err := client.CreateBroker(broker, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		assert.NilError(t, err)
+		request := requests[len(requests)-1]
+		assert.Equal(t, request.Method, "POST")
+		assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+		assert.Equal(t, broker.Kind, "Broker")
+	})
+
+	t.Run("update broker with dry-run", func(t *testing.T) {
+		broker := newBroker("foo")
+		err := client.UpdateBroker(broker, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+		assert.NilError(t, err)
+		request := requests[len(requests)-1]
+		assert.Equal(t, request.Method, "PUT")
+		assert.Equal(t, request.URL.Path, "/apis/eventing.knative.dev/v1beta1/namespaces/test-ns/brokers/foo")
+		assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+		assert.Equal(t, broker.Kind, "Broker")
+	})
+}
+
 func newTrigger(name string) *v1beta1.Trigger {
 	return NewTriggerBuilder(name).
 		Namespace(testNamespace).
@@ -0,0 +1,206 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+const (
+	// KnativeServingNamespace is the namespace holding the config maps of Knative serving
+	KnativeServingNamespace = "knative-serving"
+)
+
+// NewAdminCommand represents the 'kn admin' command group
+func NewAdminCommand(p *commands.KnParams) *cobra.Command {
+	adminCmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Manage the Knative serving cluster configuration",
+	}
+	adminCmd.AddCommand(NewDomainCommand(p))
+	adminCmd.AddCommand(NewAutoscalingCommand(p))
+	adminCmd.AddCommand(NewNetworkCommand(p))
+	adminCmd.AddCommand(NewFeaturesCommand(p))
+	return adminCmd
+}
+
+// configKey describes a key of a config map which can be updated with a flag of the same name
+type configKey struct {
+	name         string
+	defaultValue string
+	usage        string
+	validate     func(value string) error
+}
+
+// newConfigMapUpdateCommand returns an 'update' command with a flag for each of the given keys
+func newConfigMapUpdateCommand(p *commands.KnParams, group string, configMapName string, keys []configKey, short string, example string) *cobra.Command {
+	var dryRunFlags commands.DryRunFlags
+	values := make(map[string]*string, len(keys))
+
+	command := &cobra.Command{
+		Use:     "update",
+		Short:   short,
+		Example: example,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("'admin %s update' accepts no arguments", group)
+			}
+			changed := map[string]string{}
+			defaults := make(map[string]string, len(keys))
+			for _, key := range keys {
+				defaults[key.name] = key.defaultValue
+				if !cmd.Flags().Changed(key.name) {
+					continue
+				}
+				value := *values[key.name]
+				if err := key.validate(value); err != nil {
+					return fmt.Errorf("invalid value '%s' for --%s: %v", value, key.name, err)
+				}
+				changed[key.name] = value
+			}
+			if len(changed) == 0 {
+				return errors.New("flag(s) not set\nUsage: " + cmd.UseLine())
+			}
+			return updateConfigMap(p, cmd, &dryRunFlags, configMapName, defaults, func(data map[string]string) error {
+				for k, v := range changed {
+					data[k] = v
+				}
+				return nil
+			})
+		},
+	}
+	for _, key := range keys {
+		values[key.name] = command.Flags().String(key.name, "", fmt.Sprintf("%s Default: %s", key.usage, key.defaultValue))
+	}
+	dryRunFlags.Add(command)
+	return command
+}
+
+// configMapChange is a change of a single key in a config map
+type configMapChange struct {
+	key     string
+	current *string
+	new     *string
+}
+
+// updateConfigMap applies the given mutation to the data of a config map in the Knative serving
+// namespace and prints out the changed keys with their current, new and default values
+func updateConfigMap(p *commands.KnParams, cmd *cobra.Command, dryRunFlags *commands.DryRunFlags, name string,
+	defaults map[string]string, mutate func(data map[string]string) error) error {
+	err := dryRunFlags.Validate()
+	if err != nil {
+		return err
+	}
+	client, err := p.NewCoreClient(KnativeServingNamespace)
+	if err != nil {
+		return err
+	}
+	configMap, err := client.GetConfigMap(name)
+	if err != nil {
+		return err
+	}
+
+	original := make(map[string]string, len(configMap.Data))
+	for k, v := range configMap.Data {
+		original[k] = v
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	err = mutate(configMap.Data)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	changes := computeChanges(original, configMap.Data)
+	if len(changes) == 0 {
+		fmt.Fprintf(out, "ConfigMap '%s' in namespace '%s' is already up to date.\n", name, KnativeServingNamespace)
+		return nil
+	}
+	err = client.UpdateConfigMap(configMap, dryRunFlags.UpdateOptions()...)
+	if err != nil {
+		return err
+	}
+	if dryRunFlags.IsServer() {
+		fmt.Fprintf(out, "ConfigMap '%s' in namespace '%s' validated by the server but not updated (dry run):\n", name, KnativeServingNamespace)
+	} else {
+		fmt.Fprintf(out, "ConfigMap '%s' updated in namespace '%s':\n", name, KnativeServingNamespace)
+	}
+	return printChanges(out, changes, defaults)
+}
+
+// computeChanges returns the changed keys sorted by name. Keys starting with an underscore,
+// like '_example', are documentation only and ignored.
+func computeChanges(original map[string]string, updated map[string]string) []configMapChange {
+	var changes []configMapChange
+	for k, v := range updated {
+		old, found := original[k]
+		if strings.HasPrefix(k, "_") || (found && old == v) {
+			continue
+		}
+		newValue := v
+		change := configMapChange{key: k, new: &newValue}
+		if found {
+			change.current = &old
+		}
+		changes = append(changes, change)
+	}
+	for k, v := range original {
+		if _, found := updated[k]; found || strings.HasPrefix(k, "_") {
+			continue
+		}
+		old := v
+		changes = append(changes, configMapChange{key: k, current: &old})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].key < changes[j].key
+	})
+	return changes
+}
+
+func printChanges(out io.Writer, changes []configMapChange, defaults map[string]string) error {
+	tw := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tCURRENT\tNEW\tDEFAULT")
+	for _, change := range changes {
+		def, found := defaults[change.key]
+		var defValue *string
+		if found {
+			defValue = &def
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", change.key, formatValue(change.current), formatValue(change.new), formatValue(defValue))
+	}
+	return tw.Flush()
+}
+
+// formatValue formats a config map value for a single table cell
+func formatValue(value *string) string {
+	if value == nil {
+		return "<none>"
+	}
+	if strings.TrimSpace(*value) == "" {
+		return "\"\""
+	}
+	return strings.Join(strings.Fields(*value), " ")
+}
@@ -0,0 +1,108 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+)
+
+var blankConfig clientcmd.ClientConfig
+
+func init() {
+	var err error
+	blankConfig, err = clientcmd.NewClientConfigFromBytes([]byte(`kind: Config
+version: v1
+users:
+- name: u
+clusters:
+- name: c
+  cluster:
+    server: example.com
+contexts:
+- name: x
+  context:
+    user: u
+    cluster: c
+current-context: x
+`))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func executeAdminCommand(client clientcorev1.KnCoreClient, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewCoreClient = func(namespace string) (clientcorev1.KnCoreClient, error) {
+		return client, nil
+	}
+	cmd := NewAdminCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func newConfigMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: KnativeServingNamespace},
+		Data:       data,
+	}
+}
+
+func TestAdminUpdateConfigMapUpToDate(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+	r := client.Recorder()
+	r.GetConfigMap(FeaturesConfigMap, newConfigMap(FeaturesConfigMap, map[string]string{"multi-container": "enabled"}), nil)
+
+	output, err := executeAdminCommand(client, "features", "enable", "multi-container")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "already up to date"))
+	r.Validate()
+}
+
+func TestComputeChanges(t *testing.T) {
+	original := map[string]string{"a": "1", "b": "2", "_example": "doc"}
+	updated := map[string]string{"a": "1", "c": "3", "_example": "changed"}
+	changes := computeChanges(original, updated)
+	assert.Equal(t, len(changes), 2)
+	assert.Equal(t, changes[0].key, "b")
+	assert.Equal(t, *changes[0].current, "2")
+	assert.Assert(t, changes[0].new == nil)
+	assert.Equal(t, changes[1].key, "c")
+	assert.Assert(t, changes[1].current == nil)
+	assert.Equal(t, *changes[1].new, "3")
+}
+
+func TestFormatValue(t *testing.T) {
+	empty := ""
+	multiline := "selector:\n  app: foo\n"
+	assert.Equal(t, formatValue(nil), "<none>")
+	assert.Equal(t, formatValue(&empty), "\"\"")
+	assert.Equal(t, formatValue(&multiline), "selector: app: foo")
+}
@@ -0,0 +1,131 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+const (
+	// AutoscalerConfigMap holds the cluster wide configuration of the autoscaler
+	AutoscalerConfigMap = "config-autoscaler"
+)
+
+var autoscalerKeys = []configKey{
+	{
+		name:         "enable-scale-to-zero",
+		defaultValue: "true",
+		usage:        "Whether revisions without traffic can be scaled down to zero pods (true|false).",
+		validate:     validateOneOf("true", "false"),
+	},
+	{
+		name:         "scale-to-zero-grace-period",
+		defaultValue: "30s",
+		usage:        "Upper bound of the time the last pod is kept after the revision decided to scale to zero, e.g. 30s. Must be at least 6s.",
+		validate:     validateDurationRange(6*time.Second, 0),
+	},
+	{
+		name:         "stable-window",
+		defaultValue: "60s",
+		usage:        "Time window over which metrics are averaged in stable mode, e.g. 60s. Must be between 6s and 1h.",
+		validate:     validateDurationRange(6*time.Second, time.Hour),
+	},
+	{
+		name:         "container-concurrency-target-default",
+		defaultValue: "100",
+		usage:        "Default number of concurrent requests per pod targeted by the autoscaler. Must be at least 0.01.",
+		validate:     validateFloatRange(0.01, -1),
+	},
+	{
+		name:         "container-concurrency-target-percentage",
+		defaultValue: "70",
+		usage:        "Percentage of the container concurrency limit targeted by the autoscaler. Must be between 0 and 100.",
+		validate:     validateFloatRange(0, 100),
+	},
+	{
+		name:         "max-scale-up-rate",
+		defaultValue: "1000",
+		usage:        "Maximum ratio of desired to existing pods when scaling up. Must be greater than 1.",
+		validate: func(value string) error {
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil || f <= 1 {
+				return errors.New("must be a number greater than 1")
+			}
+			return nil
+		},
+	},
+}
+
+// NewAutoscalingCommand represents the 'kn admin autoscaling' command group
+func NewAutoscalingCommand(p *commands.KnParams) *cobra.Command {
+	autoscalingCmd := &cobra.Command{
+		Use:   "autoscaling",
+		Short: "Manage the cluster wide autoscaling configuration",
+	}
+	autoscalingCmd.AddCommand(NewAutoscalingUpdateCommand(p))
+	return autoscalingCmd
+}
+
+// NewAutoscalingUpdateCommand represents 'kn admin autoscaling update' command
+func NewAutoscalingUpdateCommand(p *commands.KnParams) *cobra.Command {
+	return newConfigMapUpdateCommand(p, "autoscaling", AutoscalerConfigMap, autoscalerKeys,
+		"Update the cluster wide autoscaling configuration", `
+  # Keep the last pod of a revision for one minute before scaling to zero
+  kn admin autoscaling update --scale-to-zero-grace-period 1m
+
+  # Disable scale to zero for all revisions
+  kn admin autoscaling update --enable-scale-to-zero false`)
+}
+
+// validateDurationRange returns a validation for a duration between min and max. A max of 0 means no upper bound.
+func validateDurationRange(min time.Duration, max time.Duration) func(string) error {
+	return func(value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return errors.New("must be a duration like '30s'")
+		}
+		if d < min || (max > 0 && d > max) {
+			if max > 0 {
+				return fmt.Errorf("must be between %v and %v", min, max)
+			}
+			return fmt.Errorf("must be at least %v", min)
+		}
+		return nil
+	}
+}
+
+// validateFloatRange returns a validation for a number between min and max. A negative max means no upper bound.
+func validateFloatRange(min float64, max float64) func(string) error {
+	return func(value string) error {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errors.New("must be a number")
+		}
+		if f < min || (max >= 0 && f > max) {
+			if max >= 0 {
+				return fmt.Errorf("must be between %v and %v", min, max)
+			}
+			return fmt.Errorf("must be at least %v", min)
+		}
+		return nil
+	}
+}
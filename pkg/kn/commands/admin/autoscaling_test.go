@@ -0,0 +1,69 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestAutoscalingUpdate(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+	r := client.Recorder()
+	r.GetConfigMap(AutoscalerConfigMap, newConfigMap(AutoscalerConfigMap, map[string]string{"stable-window": "60s"}), nil)
+	r.UpdateConfigMap(func(t *testing.T, a interface{}) {
+		cm := a.(*corev1.ConfigMap)
+		assert.DeepEqual(t, cm.Data, map[string]string{
+			"stable-window":              "60s",
+			"scale-to-zero-grace-period": "1m",
+			"enable-scale-to-zero":       "false",
+		})
+	}, nil)
+
+	output, err := executeAdminCommand(client, "autoscaling", "update", "--scale-to-zero-grace-period", "1m", "--enable-scale-to-zero", "false")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "ConfigMap 'config-autoscaler' updated", "scale-to-zero-grace-period", "1m", "30s", "enable-scale-to-zero", "true"))
+	r.Validate()
+}
+
+func TestAutoscalingUpdateErrors(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+
+	_, err := executeAdminCommand(client, "autoscaling", "update")
+	assert.ErrorContains(t, err, "flag(s) not set")
+
+	for _, tc := range []struct {
+		flag  string
+		value string
+		msg   string
+	}{
+		{"scale-to-zero-grace-period", "5s", "must be at least 6s"},
+		{"scale-to-zero-grace-period", "soon", "must be a duration"},
+		{"stable-window", "2h", "must be between 6s and 1h0m0s"},
+		{"enable-scale-to-zero", "yes", "must be one of true|false"},
+		{"container-concurrency-target-percentage", "120", "must be between 0 and 100"},
+		{"container-concurrency-target-default", "0", "must be at least 0.01"},
+		{"max-scale-up-rate", "1", "greater than 1"},
+	} {
+		_, err = executeAdminCommand(client, "autoscaling", "update", "--"+tc.flag, tc.value)
+		assert.ErrorContains(t, err, "invalid value '"+tc.value+"' for --"+tc.flag)
+		assert.ErrorContains(t, err, tc.msg)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+)
+
+const (
+	// DomainConfigMap holds the domains used for the routes
+	DomainConfigMap = "config-domain"
+)
+
+var domainDefaults = map[string]string{
+	"example.com": "",
+}
+
+// NewDomainCommand represents the 'kn admin domain' command group
+func NewDomainCommand(p *commands.KnParams) *cobra.Command {
+	domainCmd := &cobra.Command{
+		Use:   "domain",
+		Short: "Manage the domains used for routes",
+	}
+	domainCmd.AddCommand(NewDomainSetCommand(p))
+	domainCmd.AddCommand(NewDomainUnsetCommand(p))
+	return domainCmd
+}
+
+// NewDomainSetCommand represents 'kn admin domain set' command
+func NewDomainSetCommand(p *commands.KnParams) *cobra.Command {
+	var selector []string
+	var dryRunFlags commands.DryRunFlags
+
+	command := &cobra.Command{
+		Use:   "set DOMAIN",
+		Short: "Set a domain for routes",
+		Example: `
+  # Use 'mydomain.com' as default domain for all routes, replacing the current default domain
+  kn admin domain set mydomain.com
+
+  # Use 'internal.mydomain.com' for all routes with the label 'app=internal'
+  kn admin domain set internal.mydomain.com --selector app=internal
+
+  # Verify the change on the server without applying it
+  kn admin domain set mydomain.com --dry-run server`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'admin domain set' requires the domain given as single argument")
+			}
+			domain := args[0]
+			if errs := validation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+				return fmt.Errorf("invalid domain '%s': %s", domain, strings.Join(errs, ", "))
+			}
+			selectorMap, err := util.MapFromArray(selector, "=")
+			if err != nil {
+				return fmt.Errorf("Invalid --selector: %w", err)
+			}
+			value, err := domainSelectorValue(selectorMap)
+			if err != nil {
+				return err
+			}
+
+			return updateConfigMap(p, cmd, &dryRunFlags, DomainConfigMap, domainDefaults, func(data map[string]string) error {
+				if value == "" {
+					// There can be only one default domain
+					for k, v := range data {
+						if !strings.HasPrefix(k, "_") && strings.TrimSpace(v) == "" {
+							delete(data, k)
+						}
+					}
+				}
+				data[domain] = value
+				return nil
+			})
+		},
+	}
+	command.Flags().StringArrayVar(&selector, "selector", nil,
+		"Label selector (format: --selector KEY=VALUE) for routes which should use this domain. "+
+			"This flag can be given multiple times. Without a selector the domain becomes the default domain.")
+	dryRunFlags.Add(command)
+	return command
+}
+
+// NewDomainUnsetCommand represents 'kn admin domain unset' command
+func NewDomainUnsetCommand(p *commands.KnParams) *cobra.Command {
+	var dryRunFlags commands.DryRunFlags
+
+	command := &cobra.Command{
+		Use:   "unset DOMAIN",
+		Short: "Remove a domain for routes",
+		Example: `
+  # Remove the domain 'internal.mydomain.com'
+  kn admin domain unset internal.mydomain.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'admin domain unset' requires the domain given as single argument")
+			}
+			domain := args[0]
+			return updateConfigMap(p, cmd, &dryRunFlags, DomainConfigMap, domainDefaults, func(data map[string]string) error {
+				if _, found := data[domain]; !found {
+					return fmt.Errorf("domain '%s' is not configured in ConfigMap '%s'", domain, DomainConfigMap)
+				}
+				delete(data, domain)
+				return nil
+			})
+		},
+	}
+	dryRunFlags.Add(command)
+	return command
+}
+
+// domainSelectorValue returns the config map value for a domain used for routes matching the given labels
+func domainSelectorValue(selector map[string]string) (string, error) {
+	if len(selector) == 0 {
+		return "", nil
+	}
+	keys := make([]string, 0, len(selector))
+	for k, v := range selector {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return "", fmt.Errorf("invalid label key '%s' in --selector: %s", k, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return "", fmt.Errorf("invalid label value '%s' in --selector: %s", v, strings.Join(errs, ", "))
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	sb.WriteString("selector:\n")
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "  %s: %s\n", k, selector[k])
+	}
+	return sb.String(), nil
+}
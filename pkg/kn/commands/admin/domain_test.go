@@ -0,0 +1,99 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestDomainSetDefault(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+	r := client.Recorder()
+	r.GetConfigMap(DomainConfigMap, newConfigMap(DomainConfigMap, map[string]string{
+		"example.com":  "",
+		"internal.com": "selector:\n  app: internal\n",
+		"_example":     "docs",
+	}), nil)
+	r.UpdateConfigMap(func(t *testing.T, a interface{}) {
+		cm := a.(*corev1.ConfigMap)
+		assert.DeepEqual(t, cm.Data, map[string]string{
+			"mydomain.com": "",
+			"internal.com": "selector:\n  app: internal\n",
+			"_example":     "docs",
+		})
+	}, nil)
+
+	output, err := executeAdminCommand(client, "domain", "set", "mydomain.com")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "ConfigMap 'config-domain' updated", "KEY", "CURRENT", "NEW", "DEFAULT", "example.com", "mydomain.com", "<none>"))
+	r.Validate()
+}
+
+func TestDomainSetWithSelector(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+	r := client.Recorder()
+	r.GetConfigMap(DomainConfigMap, newConfigMap(DomainConfigMap, nil), nil)
+	r.UpdateConfigMap(func(t *testing.T, a interface{}) {
+		cm := a.(*corev1.ConfigMap)
+		assert.DeepEqual(t, cm.Data, map[string]string{
+			"internal.com": "selector:\n  app: internal\n  tier: backend\n",
+		})
+	}, nil)
+
+	output, err := executeAdminCommand(client, "domain", "set", "internal.com", "--selector", "tier=backend", "--selector", "app=internal", "--dry-run", "server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "not updated (dry run)", "selector: app: internal tier: backend"))
+	r.Validate()
+}
+
+func TestDomainSetErrors(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+
+	_, err := executeAdminCommand(client, "domain", "set")
+	assert.ErrorContains(t, err, "requires the domain")
+
+	_, err = executeAdminCommand(client, "domain", "set", "My_Domain")
+	assert.ErrorContains(t, err, "invalid domain 'My_Domain'")
+
+	_, err = executeAdminCommand(client, "domain", "set", "mydomain.com", "--selector", "app=in valid")
+	assert.ErrorContains(t, err, "invalid label value 'in valid'")
+
+	_, err = executeAdminCommand(client, "domain", "set", "mydomain.com", "--dry-run", "client")
+	assert.ErrorContains(t, err, "--dry-run")
+}
+
+func TestDomainUnset(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+	r := client.Recorder()
+	r.GetConfigMap(DomainConfigMap, newConfigMap(DomainConfigMap, map[string]string{"example.com": ""}), nil)
+	_, err := executeAdminCommand(client, "domain", "unset", "mydomain.com")
+	assert.ErrorContains(t, err, "domain 'mydomain.com' is not configured")
+
+	r.GetConfigMap(DomainConfigMap, newConfigMap(DomainConfigMap, map[string]string{"example.com": "", "mydomain.com": ""}), nil)
+	r.UpdateConfigMap(func(t *testing.T, a interface{}) {
+		cm := a.(*corev1.ConfigMap)
+		assert.DeepEqual(t, cm.Data, map[string]string{"example.com": ""})
+	}, nil)
+	output, err := executeAdminCommand(client, "domain", "unset", "mydomain.com")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "mydomain.com", "<none>"))
+	r.Validate()
+}
@@ -0,0 +1,89 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+const (
+	// FeaturesConfigMap holds the feature flags of Knative serving
+	FeaturesConfigMap = "config-features"
+
+	featureEnabled  = "enabled"
+	featureDisabled = "disabled"
+)
+
+var featureDefaults = map[string]string{
+	"multi-container":          featureDisabled,
+	"kubernetes.field-ref":     featureDisabled,
+	"kubernetes.node-selector": featureDisabled,
+	"kubernetes.tolerations":   featureDisabled,
+}
+
+// NewFeaturesCommand represents the 'kn admin features' command group
+func NewFeaturesCommand(p *commands.KnParams) *cobra.Command {
+	featuresCmd := &cobra.Command{
+		Use:   "features",
+		Short: "Manage the feature flags of Knative serving",
+	}
+	featuresCmd.AddCommand(newFeatureToggleCommand(p, "enable", featureEnabled))
+	featuresCmd.AddCommand(newFeatureToggleCommand(p, "disable", featureDisabled))
+	return featuresCmd
+}
+
+func newFeatureToggleCommand(p *commands.KnParams, verb string, value string) *cobra.Command {
+	var dryRunFlags commands.DryRunFlags
+
+	command := &cobra.Command{
+		Use:   verb + " FEATURE",
+		Short: fmt.Sprintf("%s a feature of Knative serving", strings.Title(verb)),
+		Long: fmt.Sprintf("%s a feature of Knative serving.\n\nSupported features: %s",
+			strings.Title(verb), strings.Join(supportedFeatures(), ", ")),
+		Example: fmt.Sprintf(`
+  # %s support for multiple containers in a revision
+  kn admin features %s multi-container`, strings.Title(verb), verb),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'admin features %s' requires the feature given as single argument", verb)
+			}
+			feature := args[0]
+			if _, ok := featureDefaults[feature]; !ok {
+				return fmt.Errorf("unknown feature '%s', supported features are: %s", feature, strings.Join(supportedFeatures(), ", "))
+			}
+			return updateConfigMap(p, cmd, &dryRunFlags, FeaturesConfigMap, featureDefaults, func(data map[string]string) error {
+				data[feature] = value
+				return nil
+			})
+		},
+	}
+	dryRunFlags.Add(command)
+	return command
+}
+
+func supportedFeatures() []string {
+	features := make([]string, 0, len(featureDefaults))
+	for feature := range featureDefaults {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+	return features
+}
@@ -0,0 +1,66 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestFeaturesEnable(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+	r := client.Recorder()
+	r.GetConfigMap(FeaturesConfigMap, newConfigMap(FeaturesConfigMap, map[string]string{"multi-container": "disabled"}), nil)
+	r.UpdateConfigMap(func(t *testing.T, a interface{}) {
+		cm := a.(*corev1.ConfigMap)
+		assert.Equal(t, cm.Data["multi-container"], "enabled")
+	}, nil)
+
+	output, err := executeAdminCommand(client, "features", "enable", "multi-container")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "ConfigMap 'config-features' updated", "multi-container", "disabled", "enabled"))
+	r.Validate()
+}
+
+func TestFeaturesDisable(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+	r := client.Recorder()
+	r.GetConfigMap(FeaturesConfigMap, newConfigMap(FeaturesConfigMap, map[string]string{"kubernetes.field-ref": "enabled"}), nil)
+	r.UpdateConfigMap(func(t *testing.T, a interface{}) {
+		cm := a.(*corev1.ConfigMap)
+		assert.Equal(t, cm.Data["kubernetes.field-ref"], "disabled")
+	}, errors.New("forbidden"))
+
+	_, err := executeAdminCommand(client, "features", "disable", "kubernetes.field-ref")
+	assert.ErrorContains(t, err, "forbidden")
+	r.Validate()
+}
+
+func TestFeaturesErrors(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+
+	_, err := executeAdminCommand(client, "features", "enable")
+	assert.ErrorContains(t, err, "requires the feature")
+
+	_, err = executeAdminCommand(client, "features", "enable", "time-travel")
+	assert.ErrorContains(t, err, "unknown feature 'time-travel'")
+	assert.ErrorContains(t, err, "multi-container")
+}
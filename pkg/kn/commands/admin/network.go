@@ -0,0 +1,97 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+const (
+	// NetworkConfigMap holds the cluster wide networking configuration
+	NetworkConfigMap = "config-network"
+)
+
+var networkKeys = []configKey{
+	{
+		name:         "ingress.class",
+		defaultValue: "istio.ingress.networking.knative.dev",
+		usage:        "Ingress implementation used for routes, e.g. kourier.ingress.networking.knative.dev.",
+		validate:     validateNotEmpty,
+	},
+	{
+		name:         "domainTemplate",
+		defaultValue: "{{.Name}}.{{.Namespace}}.{{.Domain}}",
+		usage:        "Go template for the host names of routes. Must contain {{.Name}}.",
+		validate: func(value string) error {
+			if !strings.Contains(value, "{{.Name}}") {
+				return errors.New("must contain {{.Name}}")
+			}
+			return nil
+		},
+	},
+	{
+		name:         "autoTLS",
+		defaultValue: "Disabled",
+		usage:        "Whether certificates are provisioned automatically for routes (Enabled|Disabled).",
+		validate:     validateOneOf("Enabled", "Disabled"),
+	},
+	{
+		name:         "httpProtocol",
+		defaultValue: "Enabled",
+		usage:        "How plain HTTP requests are handled when autoTLS is enabled (Enabled|Disabled|Redirected).",
+		validate:     validateOneOf("Enabled", "Disabled", "Redirected"),
+	},
+}
+
+// NewNetworkCommand represents the 'kn admin network' command group
+func NewNetworkCommand(p *commands.KnParams) *cobra.Command {
+	networkCmd := &cobra.Command{
+		Use:   "network",
+		Short: "Manage the cluster wide networking configuration",
+	}
+	networkCmd.AddCommand(NewNetworkUpdateCommand(p))
+	return networkCmd
+}
+
+// NewNetworkUpdateCommand represents 'kn admin network update' command
+func NewNetworkUpdateCommand(p *commands.KnParams) *cobra.Command {
+	return newConfigMapUpdateCommand(p, "network", NetworkConfigMap, networkKeys,
+		"Update the cluster wide networking configuration", `
+  # Provision certificates for all routes and redirect plain HTTP requests
+  kn admin network update --autoTLS Enabled --httpProtocol Redirected`)
+}
+
+func validateNotEmpty(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return errors.New("must not be empty")
+	}
+	return nil
+}
+
+func validateOneOf(allowed ...string) func(string) error {
+	return func(value string) error {
+		for _, a := range allowed {
+			if value == a {
+				return nil
+			}
+		}
+		return errors.New("must be one of " + strings.Join(allowed, "|"))
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestNetworkUpdate(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+	r := client.Recorder()
+	r.GetConfigMap(NetworkConfigMap, newConfigMap(NetworkConfigMap, nil), nil)
+	r.UpdateConfigMap(func(t *testing.T, a interface{}) {
+		cm := a.(*corev1.ConfigMap)
+		assert.DeepEqual(t, cm.Data, map[string]string{"autoTLS": "Enabled", "httpProtocol": "Redirected"})
+	}, nil)
+
+	output, err := executeAdminCommand(client, "network", "update", "--autoTLS", "Enabled", "--httpProtocol", "Redirected")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "ConfigMap 'config-network' updated", "autoTLS", "Disabled", "httpProtocol", "Redirected"))
+	r.Validate()
+}
+
+func TestNetworkUpdateErrors(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t, KnativeServingNamespace)
+
+	_, err := executeAdminCommand(client, "network", "update", "--domainTemplate", "{{.Namespace}}.{{.Domain}}")
+	assert.ErrorContains(t, err, "must contain {{.Name}}")
+
+	_, err = executeAdminCommand(client, "network", "update", "--autoTLS", "on")
+	assert.ErrorContains(t, err, "must be one of Enabled|Disabled")
+}
@@ -30,6 +30,7 @@ func NewBrokerCommand(p *commands.KnParams) *cobra.Command {
 	}
 	brokerCmd.AddCommand(NewBrokerCreateCommand(p))
 	brokerCmd.AddCommand(NewBrokerDescribeCommand(p))
+	brokerCmd.AddCommand(NewBrokerEditCommand(p))
 	brokerCmd.AddCommand(NewBrokerDeleteCommand(p))
 	brokerCmd.AddCommand(NewBrokerListCommand(p))
 	return brokerCmd
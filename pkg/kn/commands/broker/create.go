@@ -31,10 +31,18 @@ var createExample = `
   kn broker create mybroker
 
   # Create a broker 'mybroker' in the 'myproject' namespace
-  kn broker create mybroker --namespace myproject`
+  kn broker create mybroker --namespace myproject
+
+  # Validate a broker on the server and show it with all defaults applied, without creating it
+  kn broker create mybroker --dry-run server
+
+  # Print the broker as YAML without connecting to a cluster
+  kn broker create mybroker --offline -o yaml`
 
 // NewBrokerCreateCommand represents command to create new broker instance
 func NewBrokerCreateCommand(p *commands.KnParams) *cobra.Command {
+	var dryRunFlags commands.DryRunFlags
+	var offlineFlags commands.OfflineFlags
 
 	cmd := &cobra.Command{
 		Use:     "create NAME",
@@ -45,6 +53,22 @@ func NewBrokerCreateCommand(p *commands.KnParams) *cobra.Command {
 				return errors.New("'broker create' requires the broker name given as single argument")
 			}
 			name := args[0]
+			err = dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
+			err = offlineFlags.Validate(cmd)
+			if err != nil {
+				return err
+			}
+
+			if offlineFlags.Offline {
+				broker := clientv1beta1.
+					NewBrokerBuilder(name).
+					Namespace(offlineFlags.Namespace(cmd)).
+					Build()
+				return offlineFlags.PrintObject(cmd.OutOrStdout(), broker)
+			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -60,16 +84,22 @@ func NewBrokerCreateCommand(p *commands.KnParams) *cobra.Command {
 				NewBrokerBuilder(name).
 				Namespace(namespace)
 
-			err = eventingClient.CreateBroker(brokerBuilder.Build())
+			broker := brokerBuilder.Build()
+			err = eventingClient.CreateBroker(broker, dryRunFlags.CreateOptions()...)
 			if err != nil {
 				return fmt.Errorf(
 					"cannot create broker '%s' in namespace '%s' "+
 						"because: %s", name, namespace, err)
 			}
+			if dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(cmd.OutOrStdout(), broker)
+			}
 			fmt.Fprintf(cmd.OutOrStdout(), "Broker '%s' successfully created in namespace '%s'.\n", args[0], namespace)
 			return nil
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	dryRunFlags.Add(cmd)
+	offlineFlags.Add(cmd)
 	return cmd
 }
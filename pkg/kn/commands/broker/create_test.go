@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1beta1"
 
 	clienteventingv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
 	"knative.dev/client/pkg/util"
@@ -49,3 +51,27 @@ func TestBrokerCreateWithError(t *testing.T) {
 	assert.ErrorContains(t, err, "broker create")
 	assert.Assert(t, util.ContainsAll(err.Error(), "broker create", "requires", "name", "argument"))
 }
+
+func TestBrokerCreateDryRun(t *testing.T) {
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+
+	eventingRecorder := eventingClient.Recorder()
+	eventingRecorder.CreateBroker(func(t *testing.T, a interface{}) {
+		// Simulate the type information set by the server
+		a.(*v1beta1.Broker).TypeMeta = metav1.TypeMeta{Kind: "Broker", APIVersion: v1beta1.SchemeGroupVersion.String()}
+	}, nil)
+
+	out, err := executeBrokerCommand(eventingClient, "create", brokerName, "--dry-run", "server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: Broker", "name: foo", "namespace: default"))
+	assert.Assert(t, util.ContainsNone(out, "created"))
+
+	eventingRecorder.Validate()
+}
+
+func TestBrokerCreateOffline(t *testing.T) {
+	out, err := executeBrokerCommand(nil, "create", brokerName, "--offline", "-n", "mynamespace")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: Broker", "apiVersion: eventing.knative.dev/v1beta1", "name: foo", "namespace: mynamespace"))
+	assert.Assert(t, util.ContainsNone(out, "created"))
+}
@@ -31,11 +31,15 @@ var deleteExample = `
   kn broker create mybroker
 
   # Delete a broker 'mybroker' in the 'myproject' namespace
-  kn broker create mybroker --namespace myproject`
+  kn broker create mybroker --namespace myproject
+
+  # Check whether broker 'mybroker' could be deleted, without deleting it
+  kn broker delete mybroker --dry-run server`
 
 // NewBrokerDeleteCommand represents command to existing delete broker
 func NewBrokerDeleteCommand(p *commands.KnParams) *cobra.Command {
 	var waitFlags commands.WaitFlags
+	var dryRunFlags commands.DryRunFlags
 
 	cmd := &cobra.Command{
 		Use:     "delete NAME",
@@ -46,6 +50,10 @@ func NewBrokerDeleteCommand(p *commands.KnParams) *cobra.Command {
 				return errors.New("'broker delete' requires the broker name given as single argument")
 			}
 			name := args[0]
+			err = dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -61,17 +69,22 @@ func NewBrokerDeleteCommand(p *commands.KnParams) *cobra.Command {
 			if waitFlags.Wait {
 				timeout = time.Duration(waitFlags.TimeoutInSeconds) * time.Second
 			}
-			err = eventingClient.DeleteBroker(name, timeout)
+			err = eventingClient.DeleteBroker(name, timeout, dryRunFlags.DeleteOptions()...)
 			if err != nil {
 				return fmt.Errorf(
 					"cannot delete broker '%s' in namespace '%s' "+
 						"because: %s", name, namespace, err)
 			}
+			if dryRunFlags.IsServer() {
+				fmt.Fprintf(cmd.OutOrStdout(), "Broker '%s' would be deleted in namespace '%s' (server dry run).\n", name, namespace)
+				return nil
+			}
 			fmt.Fprintf(cmd.OutOrStdout(), "Broker '%s' successfully deleted in namespace '%s'.\n", args[0], namespace)
 			return nil
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	waitFlags.AddConditionWaitFlags(cmd, commands.WaitDefaultTimeout, "delete", "broker", "deleted")
+	dryRunFlags.Add(cmd)
 	return cmd
 }
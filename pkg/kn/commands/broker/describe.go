@@ -62,10 +62,19 @@ func NewBrokerDescribeCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return describeBroker(cmd.OutOrStdout(), broker, false)
+			out := cmd.OutOrStdout()
+			err = describeBroker(out, broker, false)
+			if err != nil {
+				return err
+			}
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents {
+				return commands.PrintEvents(p, out, namespace, []commands.EventObject{{Kind: "Broker", Name: broker.Name}})
+			}
+			return nil
 		},
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), false)
+	cmd.Flags().Bool("events", false, "Show the Kubernetes events of the broker.")
 	return cmd
 }
 
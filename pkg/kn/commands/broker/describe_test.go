@@ -17,19 +17,23 @@ limitations under the License.
 package broker
 
 import (
+	"bytes"
 	"errors"
 	"strings"
 	"testing"
 
 	"gotest.tools/assert"
 	"gotest.tools/assert/cmp"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	clientcorev1 "knative.dev/client/pkg/core/v1"
 	clientv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
+	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/util"
 )
 
@@ -95,3 +99,35 @@ func getBroker() *v1beta1.Broker {
 		},
 	}
 }
+
+func TestBrokerDescribeEvents(t *testing.T) {
+	client := clientv1beta1.NewMockKnEventingClient(t, "mynamespace")
+	coreClient := clientcorev1.NewMockKnCoreClient(t, "mynamespace")
+
+	recorder := client.Recorder()
+	recorder.GetBroker("foo", getBroker(), nil)
+	coreRecorder := coreClient.Recorder()
+	coreRecorder.ListEvents("involvedObject.kind=Broker,involvedObject.name=foo", &corev1.EventList{Items: []corev1.Event{
+		{InvolvedObject: corev1.ObjectReference{Kind: "Broker", Name: "foo"}, Type: corev1.EventTypeWarning,
+			Reason: "BrokerClassMissing", Message: "Broker class is missing"},
+	}}, nil)
+
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	output := new(bytes.Buffer)
+	knParams.NewEventingClient = func(namespace string) (clientv1beta1.KnEventingClient, error) {
+		return client, nil
+	}
+	knParams.NewCoreClient = func(namespace string) (clientcorev1.KnCoreClient, error) {
+		return coreClient, nil
+	}
+	cmd := NewBrokerCommand(knParams)
+	cmd.SetArgs([]string{"describe", "foo", "--events"})
+	cmd.SetOutput(output)
+	err := cmd.Execute()
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output.String(), "Events:", "Warning", "BrokerClassMissing", "Broker/foo", "Broker class is missing"))
+
+	recorder.Validate()
+	coreRecorder.Validate()
+}
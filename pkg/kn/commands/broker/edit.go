@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
+
+	clientv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
+	"knative.dev/client/pkg/kn/commands"
+)
+
+var editExample = `
+  # Edit the broker 'mybroker' in the editor given with $KUBE_EDITOR or $EDITOR
+  kn broker edit mybroker
+
+  # Edit the broker 'mybroker' in the 'myproject' namespace
+  kn broker edit mybroker --namespace myproject`
+
+// NewBrokerEditCommand represents command to edit a broker in an editor
+func NewBrokerEditCommand(p *commands.KnParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit NAME",
+		Short: "Edit a broker in an editor",
+		Long: `Edit a broker in an editor.
+
+The broker is opened as YAML in the editor given with the KUBE_EDITOR or EDITOR environment
+variable, falling back to 'vi'. Its status and runtime metadata are left out. The edited broker
+is validated before the broker gets updated. If the validation or the update fails, the editor
+is reopened with the error shown on top of the file.`,
+		Example: editExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'broker edit' requires the broker name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			eventingClient, err := p.NewEventingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			broker, err := eventingClient.GetBroker(name)
+			if err != nil {
+				return err
+			}
+
+			editable := &v1beta1.Broker{
+				TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "Broker"},
+				ObjectMeta: commands.EditableObjectMeta(broker.ObjectMeta),
+				Spec:       broker.Spec,
+			}
+			changed, err := commands.EditInEditor(cmd, "broker", name, editable, func(edited []byte) error {
+				return updateEditedBroker(eventingClient, name, namespace, edited)
+			})
+			if err != nil || !changed {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Broker '%s' updated in namespace '%s'.\n", name, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	return cmd
+}
+
+// updateEditedBroker validates the broker given as edited YAML and updates the broker with it
+func updateEditedBroker(client clientv1beta1.KnEventingClient, name string, namespace string, edited []byte) error {
+	editedBroker := &v1beta1.Broker{}
+	err := commands.ReadEdited(edited, "broker", name, namespace, editedBroker)
+	if err != nil {
+		return err
+	}
+
+	return commands.UpdateEditedWithRetry(func() error {
+		broker, err := client.GetBroker(name)
+		if err != nil {
+			return err
+		}
+		commands.MergeEditedObjectMeta(&broker.ObjectMeta, editedBroker.ObjectMeta)
+		broker.Spec = editedBroker.Spec
+
+		return client.UpdateBroker(broker)
+	})
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
+
+	clientv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestBrokerEdit(t *testing.T) {
+	editor, err := commands.NewTestEditor(`s|team: payments|team: billing|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	client := clientv1beta1.NewMockKnEventingClient(t)
+	recorder := client.Recorder()
+	recorder.GetBroker("foo", getEditBroker(), nil)
+	recorder.GetBroker("foo", getEditBroker(), nil)
+	recorder.UpdateBroker(func(t *testing.T, a interface{}) {
+		updated := a.(*v1beta1.Broker)
+		assert.DeepEqual(t, updated.Labels, map[string]string{"team": "billing"})
+		assert.Equal(t, updated.Annotations[corev1.LastAppliedConfigAnnotation], "{}")
+		assert.Equal(t, updated.ResourceVersion, "5")
+	}, nil)
+
+	out, err := executeBrokerCommand(client, "edit", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Broker", "foo", "updated", "namespace", "default"))
+	assert.Assert(t, util.ContainsAll(editor.Input(1), "# Please edit the broker below", "kind: Broker", "eventing.knative.dev/broker.class: MTChannelBasedBroker"))
+	assert.Assert(t, util.ContainsNone(editor.Input(1), "resourceVersion", "last-applied-configuration", "foo-broker.test"))
+
+	recorder.Validate()
+}
+
+func TestBrokerEditReopenOnError(t *testing.T) {
+	editor, err := commands.NewTestEditor(`s|team: payments|team: billing|`, `s|team: billing|team: sales|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	client := clientv1beta1.NewMockKnEventingClient(t)
+	recorder := client.Recorder()
+	recorder.GetBroker("foo", getEditBroker(), nil)
+	recorder.GetBroker("foo", getEditBroker(), nil)
+	recorder.UpdateBroker(mock.Any(), errors.New("admission webhook denied the request"))
+	recorder.GetBroker("foo", getEditBroker(), nil)
+	recorder.UpdateBroker(mock.Any(), nil)
+
+	_, err = executeBrokerCommand(client, "edit", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(editor.Input(2), "# broker 'foo' could not be updated:", "admission webhook denied the request", "team: billing"))
+
+	recorder.Validate()
+}
+
+func TestBrokerEditError(t *testing.T) {
+	client := clientv1beta1.NewMockKnEventingClient(t)
+	recorder := client.Recorder()
+	recorder.GetBroker("foo", nil, errors.New("brokers.eventing.knative.dev 'foo' not found"))
+
+	_, err := executeBrokerCommand(client, "edit", "foo")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = executeBrokerCommand(client, "edit")
+	assert.ErrorContains(t, err, "broker name")
+
+	recorder.Validate()
+}
+
+func getEditBroker() *v1beta1.Broker {
+	broker := getBroker()
+	broker.ResourceVersion = "5"
+	broker.Labels = map[string]string{"team": "payments"}
+	broker.Annotations = map[string]string{
+		v1beta1.BrokerClassAnnotationKey:   "MTChannelBasedBroker",
+		corev1.LastAppliedConfigAnnotation: "{}",
+	}
+	return broker
+}
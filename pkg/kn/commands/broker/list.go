@@ -27,6 +27,8 @@ import (
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
+	"knative.dev/eventing/pkg/apis/eventing"
 	"knative.dev/eventing/pkg/apis/eventing/v1beta1"
 )
 
@@ -35,11 +37,21 @@ var listExample = `
   kn broker list
 
   # List all brokers in JSON output format
-  kn broker list -o json`
+  kn broker list -o json
+
+  # List all brokers with the label 'team=payments'
+  kn broker list -l team=payments
+
+  # List all brokers with additional columns like the class and the dead letter sink
+  kn broker list -o wide
+
+  # List all brokers and watch for changes
+  kn broker list --watch`
 
 // NewBrokerListCommand represents command to list all brokers
 func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 	brokerListFlags := flags.NewListPrintFlags(ListHandlers)
+	selectorFilter := flags.LabelSelectorFilter{}
 
 	cmd := &cobra.Command{
 		Use:     "list",
@@ -56,18 +68,37 @@ func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			brokerList, err := eventingClient.ListBrokers()
+			var listConfigs []util.ListConfig
+			if selectorFilter.Selector != "" {
+				listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
+			}
+
+			// empty namespace indicates all-namespaces flag is specified
+			if namespace == "" {
+				brokerListFlags.EnsureWithNamespace()
+			}
+
+			listConfigs = append(listConfigs, util.WithChunkSize(brokerListFlags.ChunkSize))
+			if brokerListFlags.Streaming() {
+				listConfigs = append(listConfigs, util.WithPageHandler(func(page runtime.Object) error {
+					return brokerListFlags.PrintPage(page, cmd.OutOrStdout())
+				}))
+			}
+			brokerList, err := eventingClient.ListBrokers(listConfigs...)
 			if err != nil {
 				return err
 			}
-			if len(brokerList.Items) == 0 {
+			if len(brokerList.Items) == 0 && !brokerListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No brokers found.\n")
 				return nil
 			}
 
-			// empty namespace indicates all-namespaces flag is specified
-			if namespace == "" {
-				brokerListFlags.EnsureWithNamespace()
+			if brokerListFlags.Watch {
+				watcher, err := eventingClient.WatchBrokers(listConfigs...)
+				if err != nil {
+					return err
+				}
+				return brokerListFlags.PrintWatch(brokerList, watcher, cmd.OutOrStdout())
 			}
 
 			err = brokerListFlags.Print(brokerList, cmd.OutOrStdout())
@@ -79,6 +110,9 @@ func NewBrokerListCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
 	brokerListFlags.AddFlags(cmd)
+	brokerListFlags.AddWatchFlag(cmd)
+	brokerListFlags.AddChunkSizeFlag(cmd)
+	selectorFilter.Add(cmd, "brokers")
 	return cmd
 }
 
@@ -92,6 +126,8 @@ func ListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Ready state conditions", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready state of the Broker instance", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+		{Name: "Class", Type: "string", Description: "Class of the Broker instance", Priority: 2},
+		{Name: "Dead Letter Sink", Type: "string", Description: "Sink for events which could not be delivered", Priority: 2},
 	}
 	h.TableHandler(brokerColumnDefinitions, printBroker)
 	h.TableHandler(brokerColumnDefinitions, printBrokerList)
@@ -135,5 +171,16 @@ func printBroker(broker *v1beta1.Broker, options hprinters.PrintOptions) ([]meta
 		conditions,
 		ready,
 		reason)
+	if options.Wide {
+		row.Cells = append(row.Cells, broker.Annotations[eventing.BrokerClassKey], brokerDeadLetterSink(broker))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
+
+// brokerDeadLetterSink returns the dead letter sink of the broker's delivery spec
+func brokerDeadLetterSink(broker *v1beta1.Broker) string {
+	if broker.Spec.Delivery == nil || broker.Spec.Delivery.DeadLetterSink == nil {
+		return ""
+	}
+	return flags.SinkToString(*broker.Spec.Delivery.DeadLetterSink)
+}
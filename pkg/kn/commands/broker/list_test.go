@@ -21,10 +21,14 @@ import (
 	"testing"
 
 	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/watch"
 
 	clienteventingv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
 	"knative.dev/client/pkg/util"
+	eventingduckv1beta1 "knative.dev/eventing/pkg/apis/duck/v1beta1"
+	"knative.dev/eventing/pkg/apis/eventing"
 	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 func TestBrokerList(t *testing.T) {
@@ -49,6 +53,27 @@ func TestBrokerList(t *testing.T) {
 	eventingRecorder.Validate()
 }
 
+func TestBrokerListWide(t *testing.T) {
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	broker := createBroker("foo1")
+	broker.Annotations = map[string]string{eventing.BrokerClassKey: "MTChannelBasedBroker"}
+	broker.Spec.Delivery = &eventingduckv1beta1.DeliverySpec{
+		DeadLetterSink: &duckv1.Destination{Ref: &duckv1.KReference{Kind: "Service", Name: "dls"}},
+	}
+	eventingRecorder.ListBrokers(&v1beta1.BrokerList{Items: []v1beta1.Broker{*broker}}, nil)
+
+	output, err := executeBrokerCommand(eventingClient, "list", "-o", "wide")
+	assert.NilError(t, err)
+
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsAll(outputLines[0], "NAME", "URL", "READY", "CLASS", "DEAD LETTER SINK"))
+	assert.Check(t, util.ContainsAll(outputLines[1], "foo1", "MTChannelBasedBroker", "ksvc:dls"))
+
+	eventingRecorder.Validate()
+}
+
 func TestBrokerListEmpty(t *testing.T) {
 	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
 	eventingRecorder := eventingClient.Recorder()
@@ -61,6 +86,29 @@ func TestBrokerListEmpty(t *testing.T) {
 	eventingRecorder.Validate()
 }
 
+func TestBrokerListWatch(t *testing.T) {
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	eventingRecorder.ListBrokers(&v1beta1.BrokerList{}, nil)
+	watcher := watch.NewFakeWithChanSize(2, false)
+	watcher.Add(createBroker("foo1"))
+	watcher.Add(createBroker("foo2"))
+	watcher.Stop()
+	eventingRecorder.WatchBrokers(watcher, nil)
+
+	output, err := executeBrokerCommand(eventingClient, "list", "--watch")
+	assert.NilError(t, err)
+
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsNone(output, "No brokers found"))
+	assert.Check(t, util.ContainsAll(outputLines[0], "NAME", "URL", "AGE", "CONDITIONS", "READY", "REASON"))
+	assert.Check(t, util.ContainsAll(outputLines[1], "foo1"))
+	assert.Check(t, util.ContainsAll(outputLines[2], "foo2"))
+
+	eventingRecorder.Validate()
+}
+
 func TestTriggerListAllNamespace(t *testing.T) {
 	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
 	eventingRecorder := eventingClient.Recorder()
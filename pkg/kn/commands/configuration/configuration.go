@@ -0,0 +1,59 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/wait"
+)
+
+// NewConfigurationCommand represents the 'kn configuration' command group
+func NewConfigurationCommand(p *commands.KnParams) *cobra.Command {
+	configurationCmd := &cobra.Command{
+		Use:   "configuration",
+		Short: "Manage configurations",
+	}
+	configurationCmd.AddCommand(NewConfigurationListCommand(p))
+	configurationCmd.AddCommand(NewConfigurationDescribeCommand(p))
+	configurationCmd.AddCommand(NewConfigurationCreateCommand(p))
+	configurationCmd.AddCommand(NewConfigurationUpdateCommand(p))
+	configurationCmd.AddCommand(NewConfigurationDeleteCommand(p))
+	return configurationCmd
+}
+
+func waitForConfiguration(client clientservingv1.KnServingClient, name string, out io.Writer, timeout int) error {
+	err, duration := client.WaitForConfiguration(name, time.Duration(timeout)*time.Second, wait.SimpleMessageCallback(out))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%7.3fs Ready.\n", float64(duration.Round(time.Millisecond))/float64(time.Second))
+	return nil
+}
+
+func showLatestRevision(client clientservingv1.KnServingClient, name string, what string, out io.Writer) error {
+	configuration, err := client.GetConfiguration(name)
+	if err != nil {
+		return fmt.Errorf("cannot fetch configuration '%s' in namespace '%s' for extracting the latest revision: %v", name, client.Namespace(), err)
+	}
+	fmt.Fprintf(out, "Configuration '%s' %s with latest ready revision '%s' in namespace '%s'.\n", name, what, configuration.Status.LatestReadyRevisionName, client.Namespace())
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/service"
+	servinglib "knative.dev/client/pkg/serving"
+)
+
+var createExample = `
+  # Create a configuration 'web' using image at dev.local/ns/image:latest
+  kn configuration create web --image dev.local/ns/image:latest
+
+  # Create a configuration with an environment variable and a fixed revision name 'web-v1'
+  kn configuration create web --image dev.local/ns/image:v1 --env KEY=VALUE --revision-name v1
+
+  # Create a configuration without waiting for its first revision to become ready
+  kn configuration create web --image dev.local/ns/image:latest --no-wait`
+
+// NewConfigurationCreateCommand represents 'kn configuration create' command
+func NewConfigurationCreateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags service.ConfigurationEditFlags
+	var waitFlags commands.WaitFlags
+
+	configurationCreateCommand := &cobra.Command{
+		Use:     "create NAME --image IMAGE",
+		Short:   "Create a configuration",
+		Example: createExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'configuration create' requires the configuration name given as single argument")
+			}
+			if editFlags.PodSpecFlags.Image == "" {
+				return errors.New("'configuration create' requires the image name to run provided with the --image option")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			configuration, err := constructConfiguration(cmd, editFlags, name, namespace)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			err = client.CreateConfiguration(configuration)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Configuration '%s' created in namespace '%s'.\n", name, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Creating configuration '%s' in namespace '%s':\n", name, namespace)
+			fmt.Fprintln(out, "")
+			err = waitForConfiguration(client, name, out, waitFlags.TimeoutInSeconds)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "")
+			return showLatestRevision(client, name, "created", out)
+		},
+	}
+	commands.AddNamespaceFlags(configurationCreateCommand.Flags(), false)
+	editFlags.AddUpdateFlags(configurationCreateCommand)
+	waitFlags.AddConditionWaitFlags(configurationCreateCommand, commands.WaitDefaultTimeout, "create", "configuration", "ready")
+	return configurationCreateCommand
+}
+
+// constructConfiguration creates a configuration from the provided options
+func constructConfiguration(cmd *cobra.Command, editFlags service.ConfigurationEditFlags, name string, namespace string) (*servingv1.Configuration, error) {
+	configuration := servingv1.Configuration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	configuration.Spec.Template = servingv1.RevisionTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				servinglib.UserImageAnnotationKey: "", // Placeholder. Will be replaced or deleted as we apply mutations.
+			},
+		},
+	}
+	configuration.Spec.Template.Spec.Containers = []corev1.Container{{}}
+
+	err := editFlags.ApplyToConfiguration(&configuration, nil, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return &configuration, nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestConfigurationCreate(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.CreateConfiguration(func(t *testing.T, a interface{}) {
+		configuration := a.(*servingv1.Configuration)
+		assert.Equal(t, configuration.Name, "foo")
+		assert.Equal(t, configuration.Namespace, "default")
+		assert.Equal(t, configuration.Spec.Template.Name, "foo-v1")
+		container := configuration.Spec.Template.Spec.Containers[0]
+		assert.Equal(t, container.Image, "gcr.io/foo/bar:v1")
+		assert.DeepEqual(t, container.Env, []corev1.EnvVar{{Name: "KEY", Value: "value"}})
+		assert.Equal(t, configuration.Labels["team"], "a")
+	}, nil)
+	r.WaitForConfiguration("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetConfiguration("foo", getConfiguration("foo"), nil)
+
+	output, err := executeConfigurationCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:v1",
+		"--env", "KEY=value", "--revision-name", "v1", "--label-service", "team=a")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Creating configuration 'foo'", "Ready", "Configuration 'foo' created with latest ready revision 'foo-rev1'"))
+	r.Validate()
+}
+
+func TestConfigurationCreateNoWait(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.CreateConfiguration(mock.Any(), nil)
+
+	output, err := executeConfigurationCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:v1", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Configuration 'foo' created in namespace 'default'"))
+	r.Validate()
+}
+
+func TestConfigurationCreateErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeConfigurationCommand(client, "create", "--image", "gcr.io/foo/bar:v1")
+	assert.ErrorContains(t, err, "requires the configuration name")
+
+	_, err = executeConfigurationCommand(client, "create", "foo")
+	assert.ErrorContains(t, err, "requires the image name")
+
+	_, err = executeConfigurationCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:v1", "--cluster-local")
+	assert.ErrorContains(t, err, "--cluster-local can not be used for a configuration")
+}
@@ -0,0 +1,76 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewConfigurationDeleteCommand represents 'kn configuration delete' command
+func NewConfigurationDeleteCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+
+	configurationDeleteCommand := &cobra.Command{
+		Use:   "delete NAME [NAME ...]",
+		Short: "Delete configurations",
+		Example: `
+  # Delete a configuration 'web' in default namespace
+  kn configuration delete web
+
+  # Delete configurations 'web' and 'worker' in namespace 'dev'
+  kn configuration delete web worker -n dev`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("'kn configuration delete' requires one or more configuration name")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			errs := []string{}
+			for _, name := range args {
+				timeout := time.Duration(0)
+				if waitFlags.Wait {
+					timeout = time.Duration(waitFlags.TimeoutInSeconds) * time.Second
+				}
+				err = client.DeleteConfiguration(name, timeout)
+				if err != nil {
+					errs = append(errs, err.Error())
+				} else {
+					fmt.Fprintf(cmd.OutOrStdout(), "Configuration '%s' deleted in namespace '%s'.\n", name, namespace)
+				}
+			}
+			if len(errs) > 0 {
+				return errors.New("Error: " + strings.Join(errs, "\nError: "))
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(configurationDeleteCommand.Flags(), false)
+	waitFlags.AddConditionWaitFlags(configurationDeleteCommand, commands.WaitDefaultTimeout, "delete", "configuration", "deleted")
+	return configurationDeleteCommand
+}
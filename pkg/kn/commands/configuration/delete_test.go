@@ -0,0 +1,58 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestConfigurationDelete(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.DeleteConfiguration("foo", mock.Any(), nil)
+	r.DeleteConfiguration("bar", time.Duration(0), nil)
+
+	output, err := executeConfigurationCommand(client, "delete", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Configuration 'foo' deleted in namespace 'default'"))
+
+	output, err = executeConfigurationCommand(client, "delete", "bar", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Configuration 'bar' deleted"))
+	r.Validate()
+}
+
+func TestConfigurationDeleteErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeConfigurationCommand(client, "delete")
+	assert.ErrorContains(t, err, "requires one or more configuration name")
+
+	r := client.Recorder()
+	r.DeleteConfiguration("foo", mock.Any(), errors.New("configurations.serving.knative.dev \"foo\" not found"))
+	r.DeleteConfiguration("bar", mock.Any(), nil)
+	output, err := executeConfigurationCommand(client, "delete", "foo", "bar")
+	assert.ErrorContains(t, err, "\"foo\" not found")
+	assert.Assert(t, util.ContainsAll(output, "Configuration 'bar' deleted"))
+	r.Validate()
+}
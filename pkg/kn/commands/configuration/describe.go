@@ -0,0 +1,119 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/revision"
+	"knative.dev/client/pkg/printers"
+)
+
+// NewConfigurationDescribeCommand represents 'kn configuration describe' command
+func NewConfigurationDescribeCommand(p *commands.KnParams) *cobra.Command {
+	// For machine readable output
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+	command := &cobra.Command{
+		Use:   "describe NAME",
+		Short: "Show details of a configuration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn configuration describe' requires name of the configuration as single argument")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			configuration, err := client.GetConfiguration(args[0])
+			if err != nil {
+				return err
+			}
+
+			if machineReadablePrintFlags.OutputFlagSpecified() {
+				printer, err := machineReadablePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				return printer.PrintObj(configuration, cmd.OutOrStdout())
+			}
+			printDetails, err := cmd.Flags().GetBool("verbose")
+			if err != nil {
+				return err
+			}
+			return describe(cmd.OutOrStdout(), configuration, printDetails)
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	machineReadablePrintFlags.AddFlags(command)
+	flags.BoolP("verbose", "v", false, "More output.")
+	return command
+}
+
+func describe(w io.Writer, configuration *servingv1.Configuration, printDetails bool) error {
+	dw := printers.NewPrefixWriter(w)
+	commands.WriteMetadata(dw, &configuration.ObjectMeta, printDetails)
+	writeOwner(dw, configuration, printDetails)
+
+	// The template is shown like a revision
+	template := &servingv1.Revision{
+		ObjectMeta: configuration.Spec.Template.ObjectMeta,
+		Spec:       configuration.Spec.Template.Spec,
+	}
+	revision.WriteImage(dw, template)
+	revision.WritePort(dw, template)
+	revision.WriteProbes(dw, template)
+	revision.WriteEnv(dw, template, printDetails)
+	revision.WriteEnvFrom(dw, template, printDetails)
+	revision.WriteMounts(dw, template)
+	revision.WriteScale(dw, template)
+	revision.WriteConcurrencyOptions(dw, template)
+	revision.WriteResources(dw, template)
+	revision.WriteSidecars(dw, template, printDetails)
+	dw.WriteLine()
+
+	dw.WriteAttribute("Latest Created", configuration.Status.LatestCreatedRevisionName)
+	dw.WriteAttribute("Latest Ready", configuration.Status.LatestReadyRevisionName)
+	dw.WriteLine()
+	commands.WriteConditions(dw, configuration.Status.Conditions, printDetails)
+	if err := dw.Flush(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func writeOwner(dw printers.PrefixWriter, configuration *servingv1.Configuration, printDetails bool) {
+	for _, owner := range configuration.OwnerReferences {
+		if owner.Kind == "Service" {
+			svcName := owner.Name
+			if printDetails {
+				svcName = fmt.Sprintf("%s (%s)", svcName, owner.APIVersion)
+			}
+			dw.WriteAttribute("Service", svcName)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestConfigurationDescribe(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	configuration := getConfiguration("foo")
+	configuration.OwnerReferences = []metav1.OwnerReference{{Kind: "Service", Name: "foo-svc", APIVersion: "serving.knative.dev/v1"}}
+	configuration.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "KEY", Value: "value"}}
+	r.GetConfiguration("foo", configuration, nil)
+
+	output, err := executeConfigurationCommand(client, "describe", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Name:", "foo", "Service:", "foo-svc", "Image:", "gcr.io/foo/bar:v1", "Env:", "KEY=value",
+		"Latest Created:", "foo-rev2", "Latest Ready:", "foo-rev1", "Conditions:", "Ready"))
+	r.Validate()
+}
+
+func TestConfigurationDescribeMachineReadable(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetConfiguration("foo", getConfiguration("foo"), nil)
+
+	output, err := executeConfigurationCommand(client, "describe", "foo", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "name: foo", "image: gcr.io/foo/bar:v1", "latestReadyRevisionName: foo-rev1"))
+	r.Validate()
+}
+
+func TestConfigurationDescribeErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeConfigurationCommand(client, "describe")
+	assert.ErrorContains(t, err, "requires name of the configuration")
+
+	r := client.Recorder()
+	r.GetConfiguration("foo", (*servingv1.Configuration)(nil), errors.New("configuration foo not found"))
+	_, err = executeConfigurationCommand(client, "describe", "foo")
+	assert.ErrorContains(t, err, "not found")
+	r.Validate()
+}
@@ -0,0 +1,80 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	hprinters "knative.dev/client/pkg/printers"
+)
+
+// ConfigurationListHandlers adds print handlers for configuration list command
+func ConfigurationListHandlers(h hprinters.PrintHandler) {
+	kConfigurationColumnDefinitions := []metav1beta1.TableColumnDefinition{
+		{Name: "Namespace", Type: "string", Description: "Namespace of the Knative configuration", Priority: 0},
+		{Name: "Name", Type: "string", Description: "Name of the Knative configuration.", Priority: 1},
+		{Name: "Latest Created", Type: "string", Description: "Name of the latest created revision.", Priority: 1},
+		{Name: "Latest Ready", Type: "string", Description: "Name of the latest ready revision.", Priority: 1},
+		{Name: "Age", Type: "string", Description: "Age of the configuration.", Priority: 1},
+		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of configuration components.", Priority: 1},
+		{Name: "Ready", Type: "string", Description: "Ready condition status of the configuration.", Priority: 1},
+		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the configuration.", Priority: 1},
+		{Name: "Image", Type: "string", Description: "Container image of the configuration's template.", Priority: 2},
+	}
+	h.TableHandler(kConfigurationColumnDefinitions, printConfiguration)
+	h.TableHandler(kConfigurationColumnDefinitions, printConfigurationList)
+}
+
+// printConfigurationList populates the Knative configuration list table rows
+func printConfigurationList(configurationList *servingv1.ConfigurationList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(configurationList.Items))
+	for i := range configurationList.Items {
+		r, err := printConfiguration(&configurationList.Items[i], options)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+// printConfiguration populates the Knative configuration table rows
+func printConfiguration(configuration *servingv1.Configuration, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: configuration},
+	}
+	if options.AllNamespaces {
+		row.Cells = append(row.Cells, configuration.Namespace)
+	}
+	row.Cells = append(row.Cells,
+		configuration.Name,
+		configuration.Status.LatestCreatedRevisionName,
+		configuration.Status.LatestReadyRevisionName,
+		commands.TranslateTimestampSince(configuration.CreationTimestamp),
+		commands.ConditionsValue(configuration.Status.Conditions),
+		commands.ReadyCondition(configuration.Status.Conditions),
+		commands.NonReadyConditionReason(configuration.Status.Conditions))
+	if options.Wide {
+		image := ""
+		if containers := configuration.Spec.Template.Spec.Containers; len(containers) > 0 {
+			image = containers[0].Image
+		}
+		row.Cells = append(row.Cells, image)
+	}
+	return []metav1beta1.TableRow{row}, nil
+}
@@ -0,0 +1,116 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+// NewConfigurationListCommand represents 'kn configuration list' command
+func NewConfigurationListCommand(p *commands.KnParams) *cobra.Command {
+	configurationListFlags := flags.NewListPrintFlags(ConfigurationListHandlers)
+	selectorFilter := flags.LabelSelectorFilter{}
+	configurationListCommand := &cobra.Command{
+		Use:   "list [NAME]",
+		Short: "List configurations",
+		Example: `
+  # List all configurations
+  kn configuration list
+
+  # List configuration 'web' in namespace 'dev'
+  kn configuration list web -n dev
+
+  # List all configurations in YAML format
+  kn configuration list -o yaml
+
+  # List all configurations with the label 'team=payments'
+  kn configuration list -l team=payments`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			if len(args) > 1 {
+				return errors.New("'kn configuration list' accepts only one additional argument")
+			}
+			var listConfigs []util.ListConfig
+			if len(args) == 1 {
+				listConfigs = append(listConfigs, clientservingv1.WithName(args[0]))
+			}
+			if selectorFilter.Selector != "" {
+				listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
+			}
+
+			// empty namespace indicates all-namespaces flag is specified
+			if namespace == "" {
+				configurationListFlags.EnsureWithNamespace()
+			}
+
+			listConfigs = append(listConfigs, util.WithChunkSize(configurationListFlags.ChunkSize))
+			if configurationListFlags.Streaming() {
+				listConfigs = append(listConfigs, util.WithPageHandler(func(page runtime.Object) error {
+					configurationList := page.(*servingv1.ConfigurationList)
+					sortConfigurations(configurationList)
+					return configurationListFlags.PrintPage(configurationList, cmd.OutOrStdout())
+				}))
+			}
+			configurationList, err := client.ListConfigurations(listConfigs...)
+			if err != nil {
+				return err
+			}
+			if len(configurationList.Items) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No configurations found.\n")
+				return nil
+			}
+
+			sortConfigurations(configurationList)
+			return configurationListFlags.Print(configurationList, cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(configurationListCommand.Flags(), true)
+	configurationListFlags.AddFlags(configurationListCommand)
+	configurationListFlags.AddChunkSizeFlag(configurationListCommand)
+	selectorFilter.Add(configurationListCommand, "configurations")
+	return configurationListCommand
+}
+
+// Sort configurationList by namespace and name (in this order)
+func sortConfigurations(configurationList *servingv1.ConfigurationList) {
+	sort.SliceStable(configurationList.Items, func(i, j int) bool {
+		a := configurationList.Items[i]
+		b := configurationList.Items[j]
+
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestConfigurationListEmpty(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.ListConfigurations(mock.Any(), &servingv1.ConfigurationList{}, nil)
+
+	output, err := executeConfigurationCommand(client, "list")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No configurations found"))
+	r.Validate()
+}
+
+func TestConfigurationList(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	list := &servingv1.ConfigurationList{Items: []servingv1.Configuration{*getConfiguration("foo"), *getConfiguration("bar")}}
+	r.ListConfigurations(mock.Any(), list, nil)
+
+	output, err := executeConfigurationCommand(client, "list")
+	assert.NilError(t, err)
+	lines := strings.Split(output, "\n")
+	assert.Assert(t, util.ContainsAll(lines[0], "NAME", "LATEST CREATED", "LATEST READY", "AGE", "CONDITIONS", "READY", "REASON"))
+	assert.Assert(t, util.ContainsAll(lines[1], "bar", "bar-rev2", "bar-rev1", "True"))
+	assert.Assert(t, util.ContainsAll(lines[2], "foo", "foo-rev2", "foo-rev1"))
+	r.Validate()
+}
+
+func TestConfigurationListByName(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	list := &servingv1.ConfigurationList{Items: []servingv1.Configuration{*getConfiguration("foo")}}
+	r.ListConfigurations(clientservingv1.HasFieldSelector("metadata.name", "foo"), list, nil)
+
+	output, err := executeConfigurationCommand(client, "list", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo-rev1"))
+
+	_, err = executeConfigurationCommand(client, "list", "foo", "bar")
+	assert.ErrorContains(t, err, "accepts only one additional argument")
+	r.Validate()
+}
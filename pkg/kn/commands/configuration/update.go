@@ -0,0 +1,117 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/service"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var updateExample = `
+  # Update the image of configuration 'web'
+  kn configuration update web --image dev.local/ns/image:v2
+
+  # Add an environment variable and remove another one from configuration 'web'
+  kn configuration update web --env KEY1=VALUE1 --env KEY2-`
+
+// NewConfigurationUpdateCommand represents 'kn configuration update' command
+func NewConfigurationUpdateCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags service.ConfigurationEditFlags
+	var waitFlags commands.WaitFlags
+
+	configurationUpdateCommand := &cobra.Command{
+		Use:     "update NAME",
+		Short:   "Update a configuration",
+		Example: updateExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'configuration update' requires the configuration name given as single argument")
+			}
+			if cmd.Flags().NFlag() == 0 {
+				return fmt.Errorf("flag(s) not set\nUsage: %s", cmd.Use)
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			updateFunc := func(configuration *servingv1.Configuration) (*servingv1.Configuration, error) {
+				var baseRevision *servingv1.Revision
+				if !cmd.Flags().Changed("image") && editFlags.LockToDigest {
+					baseRevision, err = getBaseRevision(client, configuration)
+					if _, ok := err.(*clientservingv1.NoBaseRevisionError); ok {
+						fmt.Fprintf(out, "Warning: No revision found to update image digest\n")
+					} else if err != nil {
+						return nil, err
+					}
+				}
+				err = editFlags.ApplyToConfiguration(configuration, baseRevision, cmd)
+				if err != nil {
+					return nil, err
+				}
+				return configuration, nil
+			}
+			err = client.UpdateConfigurationWithRetry(name, updateFunc, service.MaxUpdateRetries)
+			if err != nil {
+				return err
+			}
+
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Configuration '%s' updated in namespace '%s'.\n", name, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Updating configuration '%s' in namespace '%s':\n", name, namespace)
+			fmt.Fprintln(out, "")
+			err = waitForConfiguration(client, name, out, waitFlags.TimeoutInSeconds)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "")
+			return showLatestRevision(client, name, "updated", out)
+		},
+	}
+	commands.AddNamespaceFlags(configurationUpdateCommand.Flags(), false)
+	editFlags.AddUpdateFlags(configurationUpdateCommand)
+	waitFlags.AddConditionWaitFlags(configurationUpdateCommand, commands.WaitDefaultTimeout, "update", "configuration", "ready")
+	return configurationUpdateCommand
+}
+
+// getBaseRevision looks up the revision corresponding to the configuration's current template
+func getBaseRevision(client clientservingv1.KnServingClient, configuration *servingv1.Configuration) (*servingv1.Revision, error) {
+	wrapper := &servingv1.Service{
+		ObjectMeta: configuration.ObjectMeta,
+		Spec: servingv1.ServiceSpec{
+			ConfigurationSpec: configuration.Spec,
+		},
+		Status: servingv1.ServiceStatus{
+			ConfigurationStatusFields: configuration.Status.ConfigurationStatusFields,
+		},
+	}
+	return client.GetBaseRevision(wrapper)
+}
@@ -0,0 +1,111 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configuration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	servinglib "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestConfigurationUpdate(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	configuration := getConfiguration("foo")
+	configuration.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "OLD", Value: "value"}}
+	r.GetConfiguration("foo", configuration, nil)
+	r.UpdateConfiguration(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Configuration)
+		container := updated.Spec.Template.Spec.Containers[0]
+		assert.Equal(t, container.Image, "gcr.io/foo/bar:v2")
+		assert.DeepEqual(t, container.Env, []corev1.EnvVar{{Name: "NEW", Value: "value"}})
+	}, nil)
+	r.WaitForConfiguration("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetConfiguration("foo", getConfiguration("foo"), nil)
+
+	output, err := executeConfigurationCommand(client, "update", "foo", "--image", "gcr.io/foo/bar:v2", "--env", "NEW=value", "--env", "OLD-")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Updating configuration 'foo'", "Configuration 'foo' updated with latest ready revision 'foo-rev1'"))
+	r.Validate()
+}
+
+func TestConfigurationUpdateLockToDigest(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	configuration := getConfiguration("foo")
+	configuration.Spec.Template.Annotations = map[string]string{servinglib.UserImageAnnotationKey: "gcr.io/foo/bar:v1"}
+	baseRevision := &servingv1.Revision{}
+	baseRevision.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/bar:v1"}}
+	baseRevision.Status.DeprecatedImageDigest = "gcr.io/foo/bar@sha256:deadbeef"
+	r.GetConfiguration("foo", configuration, nil)
+	r.GetRevision("foo-rev2", baseRevision, nil)
+	r.UpdateConfiguration(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Configuration)
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar@sha256:deadbeef")
+	}, nil)
+
+	output, err := executeConfigurationCommand(client, "update", "foo", "--env", "KEY=value", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Configuration 'foo' updated in namespace 'default'"))
+	r.Validate()
+}
+
+func TestConfigurationUpdateLockToDigestWithoutBaseRevision(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	configuration := getConfiguration("foo")
+	configuration.Status.LatestCreatedRevisionName = ""
+	r.GetConfiguration("foo", configuration, nil)
+	r.UpdateConfiguration(mock.Any(), nil)
+
+	output, err := executeConfigurationCommand(client, "update", "foo", "--env", "KEY=value", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Warning: No revision found to update image digest\nConfiguration 'foo' updated"))
+	r.Validate()
+}
+
+func TestConfigurationUpdateBaseRevisionError(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetConfiguration("foo", getConfiguration("foo"), nil)
+	r.GetRevision("foo-rev2", nil, errors.New("forbidden"))
+
+	_, err := executeConfigurationCommand(client, "update", "foo", "--env", "KEY=value", "--no-wait")
+	assert.ErrorContains(t, err, "forbidden")
+	r.Validate()
+}
+
+func TestConfigurationUpdateErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeConfigurationCommand(client, "update")
+	assert.ErrorContains(t, err, "requires the configuration name")
+
+	_, err = executeConfigurationCommand(client, "update", "foo")
+	assert.ErrorContains(t, err, "flag(s) not set")
+}
@@ -16,6 +16,7 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"sort"
 	"strconv"
 	"strings"
@@ -23,8 +24,10 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/duration"
 	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
 	"knative.dev/pkg/apis"
 )
 
@@ -222,3 +225,165 @@ func joinAndTruncate(sortedKeys []string, m map[string]string, width int) string
 	}
 	return string(ret[:width-4]) + " ..."
 }
+
+// EventObject identifies an object whose events are shown by 'describe --events'
+type EventObject struct {
+	Kind string
+	Name string
+	// NamePrefix matches all objects of the kind whose name starts with Name,
+	// e.g. the pods of a deployment
+	NamePrefix bool
+}
+
+// RevisionEventObjects returns the revision and the objects created for running it:
+// the PodAutoscaler, the Deployment and its ReplicaSets and Pods
+func RevisionEventObjects(revisionName string) []EventObject {
+	return []EventObject{
+		{Kind: "Revision", Name: revisionName},
+		{Kind: "PodAutoscaler", Name: revisionName},
+		{Kind: "Deployment", Name: revisionName + "-deployment"},
+		{Kind: "ReplicaSet", Name: revisionName + "-deployment-", NamePrefix: true},
+		{Kind: "Pod", Name: revisionName + "-deployment-", NamePrefix: true},
+	}
+}
+
+func (o EventObject) matches(ref corev1.ObjectReference) bool {
+	if ref.Kind != o.Kind {
+		return false
+	}
+	if o.NamePrefix {
+		return strings.HasPrefix(ref.Name, o.Name)
+	}
+	return ref.Name == o.Name
+}
+
+// ListEvents returns the events of the given objects in the namespace, ordered by the
+// time they were last seen. Repeated events of the same object are merged into one.
+func ListEvents(p *KnParams, namespace string, objects []EventObject) ([]corev1.Event, error) {
+	client, err := p.NewCoreClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+	var events []corev1.Event
+	listed := map[string]bool{}
+	for _, o := range objects {
+		selector := o.fieldSelector()
+		if listed[selector] {
+			continue
+		}
+		listed[selector] = true
+		eventList, err := client.ListEvents(selector)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, eventList.Items...)
+	}
+	return filterEvents(events, objects), nil
+}
+
+// fieldSelector selects the events of the object on the server. Names can only be
+// matched exactly, so objects matched by a name prefix are filtered on the client.
+func (o EventObject) fieldSelector() string {
+	selector := fields.Set{"involvedObject.kind": o.Kind}
+	if !o.NamePrefix {
+		selector["involvedObject.name"] = o.Name
+	}
+	return selector.String()
+}
+
+func filterEvents(events []corev1.Event, objects []EventObject) []corev1.Event {
+	var result []corev1.Event
+	index := map[string]int{}
+	for _, event := range events {
+		matched := false
+		for _, o := range objects {
+			if o.matches(event.InvolvedObject) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		key := strings.Join([]string{event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Type, event.Reason, event.Message}, "\x00")
+		if i, ok := index[key]; ok {
+			mergeEvent(&result[i], event)
+			continue
+		}
+		index[key] = len(result)
+		event.Count = eventCount(event)
+		result = append(result, event)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return lastSeen(result[i]).Before(lastSeen(result[j]))
+	})
+	return result
+}
+
+func mergeEvent(target *corev1.Event, event corev1.Event) {
+	target.Count += eventCount(event)
+	if lastSeen(event).After(lastSeen(*target)) {
+		target.LastTimestamp = metav1.NewTime(lastSeen(event))
+	}
+}
+
+func eventCount(event corev1.Event) int32 {
+	if event.Count < 1 {
+		return 1
+	}
+	return event.Count
+}
+
+// lastSeen returns when an event was observed last, falling back to the newer event time
+// and the creation time for events which do not record it
+func lastSeen(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// WriteEvents writes the given events as a table in a section of its own
+func WriteEvents(dw printers.PrefixWriter, events []corev1.Event) {
+	section := dw.WriteAttribute("Events", "")
+	if len(events) == 0 {
+		section.Writef("%s\n", "No events found.")
+		return
+	}
+	typeLen, reasonLen, objectLen := len("TYPE"), len("REASON"), len("OBJECT")
+	for _, event := range events {
+		typeLen = util.Max(typeLen, len(event.Type))
+		reasonLen = util.Max(reasonLen, len(event.Reason))
+		objectLen = util.Max(objectLen, len(eventObjectName(event)))
+	}
+	format := "%6s %-" + strconv.Itoa(typeLen) + "s %-" + strconv.Itoa(reasonLen) + "s %-" + strconv.Itoa(objectLen) + "s %s\n"
+	section.Writef(format, "AGE", "TYPE", "REASON", "OBJECT", "MESSAGE")
+	for _, event := range events {
+		message := strings.TrimSpace(event.Message)
+		if event.Count > 1 {
+			message = fmt.Sprintf("%s (x%d)", message, event.Count)
+		}
+		section.Writef(format, Age(lastSeen(event)), event.Type, event.Reason, eventObjectName(event), message)
+	}
+}
+
+func eventObjectName(event corev1.Event) string {
+	return event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name
+}
+
+// PrintEvents lists the events of the given objects and writes them to the output,
+// separated by an empty line from the preceding description
+func PrintEvents(p *KnParams, out io.Writer, namespace string, objects []EventObject) error {
+	events, err := ListEvents(p, namespace, objects)
+	if err != nil {
+		return err
+	}
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteLine()
+	WriteEvents(dw, events)
+	return dw.Flush()
+}
@@ -16,12 +16,17 @@ package commands
 
 import (
 	"bytes"
+	"errors"
 	"regexp"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcorev1 "knative.dev/client/pkg/core/v1"
 	"knative.dev/client/pkg/printers"
 	"knative.dev/pkg/apis"
 )
@@ -108,3 +113,70 @@ OK TYPE AGE REASON
  I Ccc Eh.`))
 	}
 }
+
+func newEvent(kind, name, reason, message string, count int32, lastSeen time.Time) corev1.Event {
+	return corev1.Event{
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Name: name},
+		Type:           corev1.EventTypeNormal,
+		Reason:         reason,
+		Message:        message,
+		Count:          count,
+		LastTimestamp:  metav1.NewTime(lastSeen),
+	}
+}
+
+func TestFilterEvents(t *testing.T) {
+	now := time.Now()
+	events := []corev1.Event{
+		newEvent("Pod", "foo-1-deployment-abc-xyz", "Pulled", "Image pulled", 1, now.Add(-1*time.Minute)),
+		newEvent("Revision", "foo-1", "Created", "Revision created", 0, now.Add(-5*time.Minute)),
+		newEvent("Pod", "foo-1-deployment-abc-xyz", "Pulled", "Image pulled", 2, now.Add(-30*time.Second)),
+		newEvent("Revision", "bar-1", "Created", "Revision created", 1, now.Add(-2*time.Minute)),
+		newEvent("Service", "foo-1", "Created", "Wrong kind", 1, now),
+	}
+	filtered := filterEvents(events, RevisionEventObjects("foo-1"))
+	assert.Equal(t, len(filtered), 2)
+	assert.Equal(t, filtered[0].InvolvedObject.Kind, "Revision")
+	assert.Equal(t, filtered[0].Count, int32(1))
+	assert.Equal(t, filtered[1].InvolvedObject.Kind, "Pod")
+	assert.Equal(t, filtered[1].Count, int32(3))
+	assert.Assert(t, filtered[1].LastTimestamp.Time.Equal(metav1.NewTime(now.Add(-30*time.Second)).Time))
+}
+
+func TestWriteEvents(t *testing.T) {
+	buf := &bytes.Buffer{}
+	dw := printers.NewBarePrefixWriter(buf)
+	WriteEvents(dw, nil)
+	assert.Equal(t, normalizeSpace(buf.String()), normalizeSpace("Events:\nNo events found."))
+
+	buf.Reset()
+	event := newEvent("Revision", "foo-1", "ContainerMissing", "Image not found", 4, time.Now().Add(-2*time.Minute))
+	event.Type = corev1.EventTypeWarning
+	WriteEvents(dw, []corev1.Event{event})
+	assert.Equal(t, normalizeSpace(buf.String()), normalizeSpace(`Events:
+AGE TYPE REASON OBJECT MESSAGE
+2m Warning ContainerMissing Revision/foo-1 Image not found (x4)`))
+}
+
+func TestPrintEvents(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t)
+	r := client.Recorder()
+	r.ListEvents("involvedObject.kind=Broker,involvedObject.name=default", &corev1.EventList{Items: []corev1.Event{
+		newEvent("Broker", "default", "Ready", "Broker is ready", 1, time.Now()),
+		newEvent("Broker", "other", "Ready", "Other broker", 1, time.Now()),
+	}}, nil)
+	p := &KnParams{NewCoreClient: func(namespace string) (clientcorev1.KnCoreClient, error) {
+		return client, nil
+	}}
+
+	buf := &bytes.Buffer{}
+	err := PrintEvents(p, buf, "default", []EventObject{{Kind: "Broker", Name: "default"}})
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(buf.String(), "Broker is ready"))
+	assert.Assert(t, !strings.Contains(buf.String(), "Other broker"))
+
+	r.ListEvents("involvedObject.kind=Pod", (*corev1.EventList)(nil), errors.New("forbidden"))
+	err = PrintEvents(p, buf, "default", []EventObject{{Kind: "Pod", Name: "foo-", NamePrefix: true}})
+	assert.ErrorContains(t, err, "forbidden")
+	r.Validate()
+}
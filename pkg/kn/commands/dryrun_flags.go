@@ -0,0 +1,117 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+const (
+	// DryRunNone performs the request as usual
+	DryRunNone = "none"
+	// DryRunServer sends the request to the server without persisting the resource
+	DryRunServer = "server"
+	// DryRunClient only prints what would be done without sending any mutating request
+	DryRunClient = "client"
+)
+
+// DryRunFlags for performing a mutating request as server side dry-run
+type DryRunFlags struct {
+	// Mode is the dry-run mode, either "none", "server" or, if enabled, "client"
+	Mode string
+
+	// Whether the command supports a client side dry-run
+	withClient bool
+}
+
+// Add adds the --dry-run flag to the given command
+func (p *DryRunFlags) Add(command *cobra.Command) {
+	command.Flags().StringVar(&p.Mode, "dry-run", DryRunNone,
+		"Must be \"none\" or \"server\". If \"server\", the request is sent to the server, which validates and "+
+			"defaults the resource without persisting it. The resource as returned by the server is printed.")
+}
+
+// AddWithClient adds the --dry-run flag to the given command, which also accepts the "client" mode.
+// This mode is used when the flag is given without a value.
+func (p *DryRunFlags) AddWithClient(command *cobra.Command) {
+	p.withClient = true
+	command.Flags().StringVar(&p.Mode, "dry-run", DryRunNone,
+		"Must be \"none\", \"client\" or \"server\". If \"client\", only print what would be done without sending "+
+			"it to the server. If \"server\", the requests are sent to the server without persisting anything. "+
+			"\"--dry-run\" without a value is the same as \"--dry-run=client\", other modes must be given as \"--dry-run=<mode>\".")
+	command.Flags().Lookup("dry-run").NoOptDefVal = DryRunClient
+}
+
+// Validate checks whether a valid dry-run mode has been given
+func (p *DryRunFlags) Validate() error {
+	switch p.Mode {
+	case "", DryRunNone, DryRunServer:
+		return nil
+	case DryRunClient:
+		if p.withClient {
+			return nil
+		}
+	}
+	if p.withClient {
+		return fmt.Errorf("invalid value '%s' for --dry-run, please specify one of none|client|server", p.Mode)
+	}
+	return fmt.Errorf("invalid value '%s' for --dry-run, please specify one of none|server", p.Mode)
+}
+
+// IsClient returns true if a client side dry-run has been requested
+func (p *DryRunFlags) IsClient() bool {
+	return p.Mode == DryRunClient
+}
+
+// IsServer returns true if a server side dry-run has been requested
+func (p *DryRunFlags) IsServer() bool {
+	return p.Mode == DryRunServer
+}
+
+// CreateOptions returns the options to pass to a create call, which is empty if no dry-run has been requested
+func (p *DryRunFlags) CreateOptions() []metav1.CreateOptions {
+	if !p.IsServer() {
+		return nil
+	}
+	return []metav1.CreateOptions{{DryRun: []string{metav1.DryRunAll}}}
+}
+
+// UpdateOptions returns the options to pass to an update call, which is empty if no dry-run has been requested
+func (p *DryRunFlags) UpdateOptions() []metav1.UpdateOptions {
+	if !p.IsServer() {
+		return nil
+	}
+	return []metav1.UpdateOptions{{DryRun: []string{metav1.DryRunAll}}}
+}
+
+// DeleteOptions returns the options to pass to a delete call, which is empty if no dry-run has been requested
+func (p *DryRunFlags) DeleteOptions() []metav1.DeleteOptions {
+	if !p.IsServer() {
+		return nil
+	}
+	return []metav1.DeleteOptions{{DryRun: []string{metav1.DryRunAll}}}
+}
+
+// PrintDryRunResult prints the object as returned by the server for a dry-run request in YAML
+func PrintDryRunResult(out io.Writer, obj runtime.Object) error {
+	printer := printers.YAMLPrinter{}
+	return printer.PrintObj(obj, out)
+}
@@ -0,0 +1,104 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/util"
+)
+
+func TestDryRunFlags(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		isServer bool
+		errMsg   string
+	}{
+		{[]string{}, false, ""},
+		{[]string{"--dry-run", "none"}, false, ""},
+		{[]string{"--dry-run", "server"}, true, ""},
+		{[]string{"--dry-run=client"}, false, "invalid value 'client' for --dry-run"},
+	} {
+		flags := &DryRunFlags{}
+		cmd := cobra.Command{}
+		flags.Add(&cmd)
+		assert.NilError(t, cmd.ParseFlags(tc.args))
+
+		err := flags.Validate()
+		if tc.errMsg != "" {
+			assert.ErrorContains(t, err, tc.errMsg)
+			continue
+		}
+		assert.NilError(t, err)
+		assert.Equal(t, flags.IsServer(), tc.isServer)
+		if tc.isServer {
+			assert.DeepEqual(t, flags.CreateOptions(), []metav1.CreateOptions{{DryRun: []string{metav1.DryRunAll}}})
+			assert.DeepEqual(t, flags.UpdateOptions(), []metav1.UpdateOptions{{DryRun: []string{metav1.DryRunAll}}})
+			assert.DeepEqual(t, flags.DeleteOptions(), []metav1.DeleteOptions{{DryRun: []string{metav1.DryRunAll}}})
+		} else {
+			assert.Assert(t, flags.CreateOptions() == nil)
+			assert.Assert(t, flags.UpdateOptions() == nil)
+			assert.Assert(t, flags.DeleteOptions() == nil)
+		}
+	}
+}
+
+func TestDryRunFlagsWithClient(t *testing.T) {
+	for _, tc := range []struct {
+		args     []string
+		isClient bool
+		isServer bool
+		errMsg   string
+	}{
+		{[]string{}, false, false, ""},
+		{[]string{"--dry-run"}, true, false, ""},
+		{[]string{"--dry-run=client"}, true, false, ""},
+		{[]string{"--dry-run=server"}, false, true, ""},
+		{[]string{"--dry-run=all"}, false, false, "invalid value 'all' for --dry-run, please specify one of none|client|server"},
+	} {
+		flags := &DryRunFlags{}
+		cmd := cobra.Command{}
+		flags.AddWithClient(&cmd)
+		assert.NilError(t, cmd.ParseFlags(tc.args))
+
+		err := flags.Validate()
+		if tc.errMsg != "" {
+			assert.ErrorContains(t, err, tc.errMsg)
+			continue
+		}
+		assert.NilError(t, err)
+		assert.Equal(t, flags.IsClient(), tc.isClient)
+		assert.Equal(t, flags.IsServer(), tc.isServer)
+		if tc.isClient {
+			assert.Assert(t, flags.DeleteOptions() == nil)
+		}
+	}
+}
+
+func TestPrintDryRunResult(t *testing.T) {
+	service := &servingv1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+	}
+	out := &bytes.Buffer{}
+	assert.NilError(t, PrintDryRunResult(out, service))
+	assert.Assert(t, util.ContainsAll(out.String(), "apiVersion: serving.knative.dev/v1", "kind: Service", "name: foo"))
+}
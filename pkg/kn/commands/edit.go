@@ -0,0 +1,186 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/client/pkg/util"
+)
+
+// MaxEditUpdateRetries is how often the update of an edited object is retried in case of
+// an optimistic lock error
+const MaxEditUpdateRetries = 3
+
+// EditFunc validates and stores the object given as edited YAML. The returned
+// error is shown to the user when the object is reopened in the editor.
+type EditFunc func(edited []byte) error
+
+// EditInEditor opens the given object as YAML in the editor and calls editFunc with
+// the edited YAML, until editFunc succeeds. On failure, the editor is reopened with
+// the error shown in a comment on top of the file. Editing is cancelled when the
+// file is saved empty or without any changes, in which case false is returned.
+func EditInEditor(cmd *cobra.Command, kind string, name string, obj interface{}, editFunc EditFunc) (bool, error) {
+	content, err := yaml.Marshal(obj)
+	if err != nil {
+		return false, err
+	}
+
+	var lastErr error
+	for {
+		header := editHeader(kind, name, lastErr)
+		edited, err := util.Edit(append(header, content...), ".yaml", cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr())
+		if err != nil {
+			return false, err
+		}
+		edited = stripHeader(edited)
+
+		if len(bytes.TrimSpace(edited)) == 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "Edit cancelled, saved file was empty.\n")
+			return false, nil
+		}
+		if bytes.Equal(edited, content) {
+			if lastErr != nil {
+				return false, lastErr
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Edit cancelled, no changes made.\n")
+			return false, nil
+		}
+
+		lastErr = editFunc(edited)
+		if lastErr == nil {
+			return true, nil
+		}
+		content = edited
+	}
+}
+
+// EditableObject is an object which can be validated locally after editing
+type EditableObject interface {
+	metav1.Object
+	runtime.Object
+	apis.Defaultable
+	apis.Validatable
+}
+
+// ReadEdited reads the edited YAML into obj, rejecting unknown fields and a change of
+// the name. The object is validated with the defaults applied, like the server does.
+func ReadEdited(edited []byte, kind string, name string, namespace string, obj EditableObject) error {
+	err := yaml.UnmarshalStrict(edited, obj)
+	if err != nil {
+		return err
+	}
+	if obj.GetName() != name {
+		return fmt.Errorf("the name of %s '%s' can't be changed", kind, name)
+	}
+	obj.SetNamespace(namespace)
+
+	defaulted := obj.DeepCopyObject().(EditableObject)
+	defaulted.SetDefaults(context.Background())
+	if fieldErr := defaulted.Validate(context.Background()); fieldErr != nil {
+		return fieldErr
+	}
+	return nil
+}
+
+// UpdateEditedWithRetry calls updateFunc and calls it again if the update fails with an
+// optimistic lock error. updateFunc is expected to fetch the latest version of the object,
+// apply the edited object to it and update it.
+func UpdateEditedWithRetry(updateFunc func() error) error {
+	for retries := 0; ; retries++ {
+		err := updateFunc()
+		if err != nil && apierrors.IsConflict(err) && retries < MaxEditUpdateRetries {
+			continue
+		}
+		return err
+	}
+}
+
+// EditableObjectMeta returns the metadata of an object as shown for editing. Only the
+// name, the labels and the annotations not maintained by tools are kept.
+func EditableObjectMeta(objectMeta metav1.ObjectMeta) metav1.ObjectMeta {
+	editable := metav1.ObjectMeta{
+		Name:        objectMeta.Name,
+		Labels:      objectMeta.Labels,
+		Annotations: map[string]string{},
+	}
+	for key, value := range objectMeta.Annotations {
+		if key != corev1.LastAppliedConfigAnnotation {
+			editable.Annotations[key] = value
+		}
+	}
+	if len(editable.Annotations) == 0 {
+		editable.Annotations = nil
+	}
+	return editable
+}
+
+// MergeEditedObjectMeta takes over the labels and annotations of the edited metadata,
+// keeping the annotations which are not shown for editing
+func MergeEditedObjectMeta(current *metav1.ObjectMeta, edited metav1.ObjectMeta) {
+	annotations := map[string]string{}
+	for key, value := range edited.Annotations {
+		annotations[key] = value
+	}
+	if value, ok := current.Annotations[corev1.LastAppliedConfigAnnotation]; ok {
+		annotations[corev1.LastAppliedConfigAnnotation] = value
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	current.Labels = edited.Labels
+	current.Annotations = annotations
+}
+
+// editHeader creates the comment shown on top of the edited file, including the
+// error of the previous attempt if there was any
+func editHeader(kind string, name string, lastErr error) []byte {
+	var header strings.Builder
+	header.WriteString(fmt.Sprintf("# Please edit the %s below. Lines beginning with a '#' on top of the file\n", kind))
+	header.WriteString("# will be ignored, and an empty file will abort the edit. If an error occurs\n")
+	header.WriteString("# while saving, this file will be reopened with the relevant failures.\n")
+	header.WriteString("#\n")
+	if lastErr != nil {
+		header.WriteString(fmt.Sprintf("# %s '%s' could not be updated:\n", kind, name))
+		for _, line := range strings.Split(strings.TrimSpace(lastErr.Error()), "\n") {
+			header.WriteString("# " + line + "\n")
+		}
+		header.WriteString("#\n")
+	}
+	return []byte(header.String())
+}
+
+// stripHeader removes the comment lines on top of the edited file
+func stripHeader(edited []byte) []byte {
+	for len(edited) > 0 && edited[0] == '#' {
+		end := bytes.IndexByte(edited, '\n')
+		if end < 0 {
+			return nil
+		}
+		edited = edited[end+1:]
+	}
+	return edited
+}
@@ -0,0 +1,172 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/client/pkg/util"
+)
+
+type editTestObject struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+func TestEditInEditor(t *testing.T) {
+	editor, err := NewTestEditor("s/v1/v2/", "s/v2/v3/")
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	var calls []editTestObject
+	cmd, out := newEditTestCommand()
+	changed, err := EditInEditor(cmd, "service", "foo", &editTestObject{Name: "foo", Image: "gcr.io/foo:v1"}, func(edited []byte) error {
+		obj := editTestObject{}
+		if err := yaml.UnmarshalStrict(edited, &obj); err != nil {
+			return err
+		}
+		calls = append(calls, obj)
+		if obj.Image == "gcr.io/foo:v2" {
+			return errors.New("image v2 is broken\nplease use another one")
+		}
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+	assert.DeepEqual(t, calls, []editTestObject{{"foo", "gcr.io/foo:v2"}, {"foo", "gcr.io/foo:v3"}})
+	assert.Equal(t, out.String(), "")
+
+	assert.Assert(t, util.ContainsAll(editor.Input(1), "# Please edit the service below", "image: gcr.io/foo:v1"))
+	assert.Assert(t, util.ContainsNone(editor.Input(1), "could not be updated"))
+	assert.Assert(t, util.ContainsAll(editor.Input(2), "# service 'foo' could not be updated:", "# image v2 is broken\n# please use another one\n", "image: gcr.io/foo:v2"))
+}
+
+func TestEditInEditorCancelled(t *testing.T) {
+	neverCalled := func(edited []byte) error {
+		return errors.New("unexpected call")
+	}
+
+	for _, script := range []string{"s/v2/v3/", "/.*/d"} {
+		editor, err := NewTestEditor(script)
+		assert.NilError(t, err)
+		cmd, out := newEditTestCommand()
+		changed, err := EditInEditor(cmd, "service", "foo", &editTestObject{Name: "foo", Image: "gcr.io/foo:v1"}, neverCalled)
+		editor.Close()
+		assert.NilError(t, err)
+		assert.Assert(t, !changed)
+		assert.Assert(t, util.ContainsAll(out.String(), "Edit cancelled"))
+	}
+
+	// Saving again without changes after an error stops editing with this error
+	editor, err := NewTestEditor("s/v1/v2/", "s/v2/v2/")
+	assert.NilError(t, err)
+	defer editor.Close()
+	cmd, _ := newEditTestCommand()
+	changed, err := EditInEditor(cmd, "service", "foo", &editTestObject{Name: "foo", Image: "gcr.io/foo:v1"}, func(edited []byte) error {
+		return errors.New("invalid image")
+	})
+	assert.ErrorContains(t, err, "invalid image")
+	assert.Assert(t, !changed)
+}
+
+func TestReadEdited(t *testing.T) {
+	service := &servingv1.Service{}
+	edited := "metadata:\n  name: foo\nspec:\n  template:\n    spec:\n      containers:\n      - image: gcr.io/foo/bar\n"
+	assert.NilError(t, ReadEdited([]byte(edited), "service", "foo", "default", service))
+	assert.Equal(t, service.Namespace, "default")
+	assert.Equal(t, service.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar")
+	// Defaults are only applied for validation
+	assert.Assert(t, service.Spec.Traffic == nil)
+
+	err := ReadEdited([]byte("metadata:\n  name: foo\nspce: {}\n"), "service", "foo", "default", &servingv1.Service{})
+	assert.ErrorContains(t, err, "spce")
+	err = ReadEdited([]byte(edited), "service", "bar", "default", &servingv1.Service{})
+	assert.ErrorContains(t, err, "the name of service 'bar' can't be changed")
+	err = ReadEdited([]byte("metadata:\n  name: foo\n"), "service", "foo", "default", &servingv1.Service{})
+	assert.ErrorContains(t, err, "missing field(s)")
+}
+
+func TestUpdateEditedWithRetry(t *testing.T) {
+	conflict := apierrors.NewConflict(schema.GroupResource{Resource: "services"}, "foo", errors.New("modified"))
+	calls := 0
+	err := UpdateEditedWithRetry(func() error {
+		calls++
+		if calls < 3 {
+			return conflict
+		}
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, calls, 3)
+
+	calls = 0
+	err = UpdateEditedWithRetry(func() error {
+		calls++
+		return conflict
+	})
+	assert.Assert(t, apierrors.IsConflict(err))
+	assert.Equal(t, calls, MaxEditUpdateRetries+1)
+
+	calls = 0
+	err = UpdateEditedWithRetry(func() error {
+		calls++
+		return errors.New("invalid")
+	})
+	assert.ErrorContains(t, err, "invalid")
+	assert.Equal(t, calls, 1)
+}
+
+func TestEditableObjectMeta(t *testing.T) {
+	objectMeta := metav1.ObjectMeta{
+		Name:            "foo",
+		Namespace:       "default",
+		ResourceVersion: "5",
+		Labels:          map[string]string{"app": "foo"},
+		Annotations:     map[string]string{"team": "payments", corev1.LastAppliedConfigAnnotation: "{}"},
+	}
+	editable := EditableObjectMeta(objectMeta)
+	assert.DeepEqual(t, editable, metav1.ObjectMeta{
+		Name:        "foo",
+		Labels:      map[string]string{"app": "foo"},
+		Annotations: map[string]string{"team": "payments"},
+	})
+
+	editable.Labels = nil
+	editable.Annotations = map[string]string{"team": "billing"}
+	MergeEditedObjectMeta(&objectMeta, editable)
+	assert.Assert(t, objectMeta.Labels == nil)
+	assert.DeepEqual(t, objectMeta.Annotations, map[string]string{"team": "billing", corev1.LastAppliedConfigAnnotation: "{}"})
+	assert.Equal(t, objectMeta.ResourceVersion, "5")
+}
+
+func newEditTestCommand() (*cobra.Command, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	cmd := &cobra.Command{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetIn(&bytes.Buffer{})
+	return cmd, out
+}
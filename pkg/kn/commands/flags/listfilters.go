@@ -30,3 +30,15 @@ func (s *SourceTypeFilters) Add(cmd *cobra.Command, what string) {
 	usage := fmt.Sprintf("Filter list on given %s. This flag can be given multiple times.", what)
 	cmd.Flags().StringSliceVarP(&s.Filters, "type", "t", nil, usage)
 }
+
+// LabelSelectorFilter defines the flag used to filter objects on labels
+type LabelSelectorFilter struct {
+	Selector string
+}
+
+// Add attaches the label selector flag to given command
+func (l *LabelSelectorFilter) Add(cmd *cobra.Command, what string) {
+	usage := fmt.Sprintf("Selector (label query) to filter %s on, supports '=', '==', '!=', 'in' and 'notin' "+
+		"(e.g. -l key1=value1,key2=value2).", what)
+	cmd.Flags().StringVarP(&l.Selector, "selector", "l", "", usage)
+}
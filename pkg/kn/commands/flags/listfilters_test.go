@@ -27,3 +27,14 @@ func TestSourceListFlags(t *testing.T) {
 	filters.Add(cmd, "foo")
 	assert.Check(t, cmd.Flag("type") != nil)
 }
+
+func TestLabelSelectorFilter(t *testing.T) {
+	filter := &LabelSelectorFilter{}
+	cmd := &cobra.Command{}
+	filter.Add(cmd, "foo")
+	flag := cmd.Flag("selector")
+	assert.Check(t, flag != nil)
+	assert.Equal(t, flag.Shorthand, "l")
+	assert.NilError(t, cmd.Flags().Parse([]string{"-l", "team=payments"}))
+	assert.Equal(t, filter.Selector, "team=payments")
+}
@@ -15,10 +15,17 @@
 package flags
 
 import (
+	"fmt"
 	"io"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"knative.dev/client/pkg/kn/commands"
@@ -32,8 +39,27 @@ type ListPrintFlags struct {
 	GenericPrintFlags  *genericclioptions.PrintFlags
 	HumanReadableFlags *commands.HumanPrintFlags
 	PrinterHandler     func(h hprinters.PrintHandler)
+	// HumanReadableConverter optionally converts lists and objects before
+	// they are printed in a human readable format while watching
+	HumanReadableConverter func(obj runtime.Object) (runtime.Object, error)
+	// Watch for changes after listing
+	Watch bool
+	// SortBy is a JSONPath expression for sorting the listed objects
+	SortBy string
+	// ChunkSize is the maximum number of objects fetched with a single request
+	ChunkSize int64
+
+	// writer shared by all pages printed with PrintPage
+	pageWriter *tabwriter.Writer
+	// the --chunk-size flag, for checking whether it has been given explicitly
+	chunkSizeFlag *pflag.Flag
 }
 
+const (
+	wideOutput          = "wide"
+	customColumnsOutput = "custom-columns"
+)
+
 // AllowedFormats is the list of formats in which data can be displayed
 func (f *ListPrintFlags) AllowedFormats() []string {
 	formats := f.GenericPrintFlags.AllowedFormats()
@@ -45,7 +71,7 @@ func (f *ListPrintFlags) AllowedFormats() []string {
 // returning a printer based on current flag values.
 func (f *ListPrintFlags) ToPrinter() (hprinters.ResourcePrinter, error) {
 	// if there are flags specified for generic printing
-	if f.GenericPrintFlags.OutputFlagSpecified() {
+	if !f.HumanReadableOutput() {
 		p, err := f.GenericPrintFlags.ToPrinter()
 		if err != nil {
 			return nil, err
@@ -53,36 +79,236 @@ func (f *ListPrintFlags) ToPrinter() (hprinters.ResourcePrinter, error) {
 		return p, nil
 	}
 
-	p, err := f.HumanReadableFlags.ToPrinter(f.PrinterHandler)
-	if err != nil {
-		return nil, err
+	return f.humanReadablePrinter(f.HumanReadableFlags)
+}
+
+// HumanReadableOutput returns true if the objects are printed as a table,
+// i.e. for the default, the wide and the custom columns output
+func (f *ListPrintFlags) HumanReadableOutput() bool {
+	if !f.GenericPrintFlags.OutputFlagSpecified() {
+		return true
 	}
-	return p, nil
+	format := *f.GenericPrintFlags.OutputFormat
+	return format == wideOutput || strings.HasPrefix(format, customColumnsOutput+"=")
+}
+
+// Streaming returns true if the pages of a chunked list should be printed
+// with PrintPage as soon as they are received. This is the case for a table
+// output if --chunk-size is given explicitly and the objects are neither sorted
+// nor watched. Otherwise the complete list is printed at once, so that the
+// ordering done by the print handlers spans all pages.
+func (f *ListPrintFlags) Streaming() bool {
+	return f.chunkSizeFlag != nil && f.chunkSizeFlag.Changed &&
+		f.ChunkSize > 0 && !f.Watch && f.SortBy == "" && f.HumanReadableOutput()
+}
+
+// PrintPage prints a single page of a chunked list as table rows. The header
+// is printed only for the first page which contains any objects. A subsequent
+// call to Print is a no-op once a page has been printed.
+func (f *ListPrintFlags) PrintPage(page runtime.Object, w io.Writer) error {
+	if meta.LenList(page) == 0 {
+		return nil
+	}
+	humanReadableFlags := f.HumanReadableFlags
+	if f.pageWriter == nil {
+		f.pageWriter = hprinters.NewTabWriter(w)
+	} else {
+		rowFlags := *f.HumanReadableFlags
+		rowFlags.NoHeaders = true
+		humanReadableFlags = &rowFlags
+	}
+	if err := f.printHumanReadable(page, f.pageWriter, humanReadableFlags); err != nil {
+		return err
+	}
+	return f.pageWriter.Flush()
 }
 
 // Print is to print an Object to a Writer
 func (f *ListPrintFlags) Print(obj runtime.Object, w io.Writer) error {
-	printer, err := f.ToPrinter()
+	if f.pageWriter != nil {
+		// all objects have already been printed page by page
+		return nil
+	}
+	if obj != nil && f.SortBy != "" {
+		if err := hprinters.SortList(obj, f.SortBy); err != nil {
+			return err
+		}
+	}
+
+	if !f.HumanReadableOutput() {
+		return f.printGeneric(obj, w)
+	}
+	return f.printHumanReadable(obj, w, f.HumanReadableFlags)
+}
+
+func (f *ListPrintFlags) printGeneric(obj runtime.Object, w io.Writer) error {
+	printer, err := f.GenericPrintFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+	unstructuredList, err := util.ToUnstructuredList(obj)
 	if err != nil {
 		return err
 	}
+	return printer.PrintObj(unstructuredList, w)
+}
+
+// PrintWatch prints the given list first and then a line for every object
+// received from the watcher, until the watch is closed. Objects which have
+// already been printed in the same version are skipped.
+func (f *ListPrintFlags) PrintWatch(list runtime.Object, watcher watch.Interface, w io.Writer) error {
+	defer watcher.Stop()
 
-	if f.GenericPrintFlags.OutputFlagSpecified() {
-		unstructuredList, err := util.ToUnstructuredList(obj)
+	if f.SortBy != "" {
+		if err := hprinters.SortList(list, f.SortBy); err != nil {
+			return err
+		}
+	}
+
+	printed := map[string]string{}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if key, version, ok := objectVersion(item); ok {
+			printed[key] = version
+		}
+	}
+
+	var printObj func(obj runtime.Object) error
+	if !f.HumanReadableOutput() {
+		if err := f.printGeneric(list, w); err != nil {
+			return err
+		}
+		printer, err := f.GenericPrintFlags.ToPrinter()
 		if err != nil {
 			return err
 		}
-		return printer.PrintObj(unstructuredList, w)
+		// Objects received from a watch don't carry their kind
+		gvk := list.GetObjectKind().GroupVersionKind()
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+		printObj = func(obj runtime.Object) error {
+			if obj.GetObjectKind().GroupVersionKind().Empty() {
+				obj.GetObjectKind().SetGroupVersionKind(gvk)
+			}
+			return printer.PrintObj(obj, w)
+		}
+	} else {
+		tw := hprinters.NewTabWriter(w)
+		if err := f.printHumanReadable(list, tw, f.HumanReadableFlags); err != nil {
+			return err
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		rowFlags := *f.HumanReadableFlags
+		rowFlags.NoHeaders = true
+		printObj = func(obj runtime.Object) error {
+			if err := f.printHumanReadable(obj, tw, &rowFlags); err != nil {
+				return err
+			}
+			return tw.Flush()
+		}
 	}
 
+	for event := range watcher.ResultChan() {
+		switch event.Type {
+		case watch.Error:
+			if status, ok := event.Object.(*metav1.Status); ok {
+				return fmt.Errorf("error while watching: %s", status.Message)
+			}
+			return fmt.Errorf("error while watching")
+		case watch.Deleted:
+			if key, _, ok := objectVersion(event.Object); ok {
+				delete(printed, key)
+			}
+		default:
+			if key, version, ok := objectVersion(event.Object); ok {
+				if printed[key] == version {
+					continue
+				}
+				printed[key] = version
+			}
+		}
+		if err := printObj(event.Object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *ListPrintFlags) printHumanReadable(obj runtime.Object, w io.Writer, humanReadableFlags *commands.HumanPrintFlags) error {
+	// Custom columns are evaluated against the objects as they are serialized
+	if _, custom := f.customColumns(); f.HumanReadableConverter != nil && !custom {
+		var err error
+		if obj, err = f.HumanReadableConverter(obj); err != nil {
+			return err
+		}
+	}
+	printer, err := f.humanReadablePrinter(humanReadableFlags)
+	if err != nil {
+		return err
+	}
 	return printer.PrintObj(obj, w)
 }
 
+// humanReadablePrinter returns a printer for the table, wide or custom columns output
+func (f *ListPrintFlags) humanReadablePrinter(humanReadableFlags *commands.HumanPrintFlags) (hprinters.ResourcePrinter, error) {
+	if spec, custom := f.customColumns(); custom {
+		p, err := hprinters.NewCustomColumnsPrinter(spec, humanReadableFlags.NoHeaders)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+	tableFlags := *humanReadableFlags
+	tableFlags.Wide = f.GenericPrintFlags.OutputFlagSpecified() && *f.GenericPrintFlags.OutputFormat == wideOutput
+	tableFlags.Sorted = f.SortBy != ""
+	return tableFlags.ToPrinter(f.PrinterHandler)
+}
+
+// customColumns returns the column spec given with "-o custom-columns=..."
+func (f *ListPrintFlags) customColumns() (string, bool) {
+	if !f.GenericPrintFlags.OutputFlagSpecified() {
+		return "", false
+	}
+	format := *f.GenericPrintFlags.OutputFormat
+	if !strings.HasPrefix(format, customColumnsOutput+"=") {
+		return "", false
+	}
+	return strings.TrimPrefix(format, customColumnsOutput+"="), true
+}
+
+// objectVersion returns the namespaced name and the resource version of the given object
+func objectVersion(obj runtime.Object) (string, string, bool) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil || accessor.GetResourceVersion() == "" {
+		return "", "", false
+	}
+	return accessor.GetNamespace() + "/" + accessor.GetName(), accessor.GetResourceVersion(), true
+}
+
 // AddFlags receives a *cobra.Command reference and binds
 // flags related to humanreadable and template printing.
 func (f *ListPrintFlags) AddFlags(cmd *cobra.Command) {
 	f.GenericPrintFlags.AddFlags(cmd)
 	f.HumanReadableFlags.AddFlags(cmd)
+	formats := append(f.GenericPrintFlags.AllowedFormats(), wideOutput, customColumnsOutput+"=HEADER:JSONPATH")
+	cmd.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(formats, "|"))
+	cmd.Flags().StringVar(&f.SortBy, "sort-by", "", "Sort the listed objects by the value of this JSONPath expression (e.g. '{.metadata.creationTimestamp}').")
+}
+
+// AddChunkSizeFlag binds the flag for listing objects in chunks
+func (f *ListPrintFlags) AddChunkSizeFlag(cmd *cobra.Command) {
+	cmd.Flags().Int64Var(&f.ChunkSize, "chunk-size", 500, "Return large lists in chunks rather than all at once. Pass 0 to disable. "+
+		"If given explicitly, a table is printed chunk by chunk as soon as each chunk is received, with the rows ordered within each chunk only.")
+	f.chunkSizeFlag = cmd.Flags().Lookup("chunk-size")
+}
+
+// AddWatchFlag binds the flag for watching for changes after listing
+func (f *ListPrintFlags) AddWatchFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.Watch, "watch", "w", false, "After listing the requested objects, watch for changes.")
 }
 
 // NewListFlags returns flags associated with humanreadable,
@@ -15,17 +15,26 @@
 package flags
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
 	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/util"
 )
 
 func TestListPrintFlagsFormats(t *testing.T) {
 	flags := NewListPrintFlags(nil)
 	formats := flags.AllowedFormats()
-	expected := []string{"json", "yaml", "name", "go-template", "go-template-file", "template", "templatefile", "jsonpath", "jsonpath-file", "no-headers"}
+	expected := []string{"json", "yaml", "name", "go-template", "go-template-file", "template", "templatefile", "jsonpath", "jsonpath-file", "no-headers", "wide", "custom-columns"}
 	assert.DeepEqual(t, formats, expected)
 }
 
@@ -63,3 +72,168 @@ func TestListPrintFlagsPrint(t *testing.T) {
 	err = flags.Print(nil, cmd.OutOrStdout())
 	assert.NilError(t, err)
 }
+
+func TestListPrintFlagsHumanReadableOutput(t *testing.T) {
+	for _, tc := range []struct {
+		args          []string
+		humanReadable bool
+	}{
+		{nil, true},
+		{[]string{"-o", "wide"}, true},
+		{[]string{"-o", "custom-columns=NAME:.metadata.name"}, true},
+		{[]string{"-o", "json"}, false},
+		{[]string{"-o", "custom-columns"}, false},
+	} {
+		flags := NewListPrintFlags(watchTestHandlers)
+		cmd := &cobra.Command{}
+		flags.AddFlags(cmd)
+		assert.NilError(t, cmd.Flags().Parse(tc.args))
+		assert.Equal(t, flags.HumanReadableOutput(), tc.humanReadable, "args %v", tc.args)
+	}
+}
+
+func TestListPrintFlagsSortBy(t *testing.T) {
+	flags := NewListPrintFlags(watchTestHandlers)
+	cmd := &cobra.Command{}
+	flags.AddFlags(cmd)
+	assert.NilError(t, cmd.Flags().Parse([]string{"--sort-by", "{.metadata.resourceVersion}", "--no-headers"}))
+
+	list := &servingv1.ServiceList{Items: []servingv1.Service{*newWatchService("foo", "2"), *newWatchService("bar", "1")}}
+	buf := &bytes.Buffer{}
+	assert.NilError(t, flags.Print(list, buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, len(lines), 2)
+	assert.Assert(t, util.ContainsAll(lines[0], "bar", "1"))
+	assert.Assert(t, util.ContainsAll(lines[1], "foo", "2"))
+}
+
+func TestListPrintFlagsWatchFlag(t *testing.T) {
+	flags := NewListPrintFlags(nil)
+	cmd := &cobra.Command{}
+	flags.AddWatchFlag(cmd)
+	assert.NilError(t, cmd.Flags().Parse([]string{"-w"}))
+	assert.Assert(t, flags.Watch)
+}
+
+func TestListPrintFlagsChunkSizeFlag(t *testing.T) {
+	flags := NewListPrintFlags(watchTestHandlers)
+	cmd := &cobra.Command{}
+	flags.AddFlags(cmd)
+	flags.AddWatchFlag(cmd)
+	flags.AddChunkSizeFlag(cmd)
+	assert.Equal(t, flags.ChunkSize, int64(500))
+	// The default chunk size doesn't stream so that the rows are ordered across all chunks
+	assert.Assert(t, !flags.Streaming())
+	assert.NilError(t, cmd.Flags().Parse([]string{"--chunk-size", "100"}))
+	assert.Assert(t, flags.Streaming())
+
+	for _, args := range [][]string{{"--chunk-size", "0"}, {"--chunk-size", "100", "-w"}, {"--chunk-size", "100", "--sort-by", ".metadata.name"}, {"--chunk-size", "100", "-o", "yaml"}} {
+		flags := NewListPrintFlags(watchTestHandlers)
+		cmd := &cobra.Command{}
+		flags.AddFlags(cmd)
+		flags.AddWatchFlag(cmd)
+		flags.AddChunkSizeFlag(cmd)
+		assert.NilError(t, cmd.Flags().Parse(args))
+		assert.Assert(t, !flags.Streaming(), "streaming with %v", args)
+	}
+}
+
+func TestListPrintFlagsPrintPage(t *testing.T) {
+	flags := NewListPrintFlags(watchTestHandlers)
+	flags.AddFlags(&cobra.Command{})
+
+	buf := &bytes.Buffer{}
+	assert.NilError(t, flags.PrintPage(&servingv1.ServiceList{}, buf))
+	assert.NilError(t, flags.PrintPage(&servingv1.ServiceList{Items: []servingv1.Service{*newWatchService("foo", "1")}}, buf))
+	assert.NilError(t, flags.PrintPage(&servingv1.ServiceList{Items: []servingv1.Service{*newWatchService("bar", "2")}}, buf))
+	// Already printed page by page
+	assert.NilError(t, flags.Print(&servingv1.ServiceList{Items: []servingv1.Service{*newWatchService("baz", "3")}}, buf))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, len(lines), 3)
+	assert.Assert(t, util.ContainsAll(lines[0], "NAME"))
+	assert.Assert(t, util.ContainsAll(lines[1], "foo", "1"))
+	assert.Assert(t, util.ContainsAll(lines[2], "bar", "2"))
+}
+
+func TestListPrintFlagsPrintWatch(t *testing.T) {
+	item := newWatchService("foo", "1")
+	item.Kind = "Service"
+	item.APIVersion = "serving.knative.dev/v1"
+	list := &servingv1.ServiceList{Items: []servingv1.Service{*item}}
+	list.Kind = "ServiceList"
+	list.APIVersion = "serving.knative.dev/v1"
+
+	t.Run("table", func(t *testing.T) {
+		flags := NewListPrintFlags(watchTestHandlers)
+		flags.AddFlags(&cobra.Command{})
+		watcher := watch.NewFakeWithChanSize(5, false)
+		// Already listed in the same version
+		watcher.Add(newWatchService("foo", "1"))
+		watcher.Modify(newWatchService("foo", "2"))
+		watcher.Add(newWatchService("bar", "3"))
+		watcher.Delete(newWatchService("foo", "2"))
+		watcher.Stop()
+
+		buf := &bytes.Buffer{}
+		assert.NilError(t, flags.PrintWatch(list, watcher, buf))
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Equal(t, len(lines), 5)
+		assert.Assert(t, util.ContainsAll(lines[0], "NAME", "VERSION"))
+		assert.Assert(t, util.ContainsAll(lines[1], "foo", "1"))
+		assert.Assert(t, util.ContainsAll(lines[2], "foo", "2"))
+		assert.Assert(t, util.ContainsAll(lines[3], "bar", "3"))
+		assert.Assert(t, util.ContainsAll(lines[4], "foo", "2"))
+	})
+
+	t.Run("output format", func(t *testing.T) {
+		flags := NewListPrintFlags(watchTestHandlers)
+		cmd := &cobra.Command{}
+		flags.AddFlags(cmd)
+		assert.NilError(t, cmd.Flags().Parse([]string{"-o", "name"}))
+		watcher := watch.NewFakeWithChanSize(1, false)
+		watcher.Add(newWatchService("bar", "3"))
+		watcher.Stop()
+
+		buf := &bytes.Buffer{}
+		assert.NilError(t, flags.PrintWatch(list, watcher, buf))
+		assert.Equal(t, buf.String(), "service.serving.knative.dev/foo\nservice.serving.knative.dev/bar\n")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		flags := NewListPrintFlags(watchTestHandlers)
+		flags.AddFlags(&cobra.Command{})
+		watcher := watch.NewFakeWithChanSize(1, false)
+		watcher.Error(&metav1.Status{Message: "too old resource version"})
+
+		err := flags.PrintWatch(list, watcher, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "too old resource version")
+		assert.Assert(t, watcher.IsStopped())
+	})
+}
+
+func newWatchService(name, version string) *servingv1.Service {
+	return &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: version}}
+}
+
+func watchTestHandlers(h hprinters.PrintHandler) {
+	columns := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Priority: 1},
+		{Name: "Version", Type: "string", Priority: 1},
+	}
+	printService := func(service *servingv1.Service, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+		row := metav1beta1.TableRow{Object: runtime.RawExtension{Object: service}}
+		row.Cells = append(row.Cells, service.Name, service.ResourceVersion)
+		return []metav1beta1.TableRow{row}, nil
+	}
+	printServiceList := func(list *servingv1.ServiceList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
+		var rows []metav1beta1.TableRow
+		for i := range list.Items {
+			r, _ := printService(&list.Items[i], options)
+			rows = append(rows, r...)
+		}
+		return rows, nil
+	}
+	h.TableHandler(columns, printService)
+	h.TableHandler(columns, printServiceList)
+}
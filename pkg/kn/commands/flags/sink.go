@@ -109,6 +109,48 @@ func (i *SinkFlags) ResolveSink(knclient clientdynamic.KnDynamicClient, namespac
 	return destination, nil
 }
 
+// sinkKinds maps the resources of the default sink mappings to their kinds, which
+// is needed for resolving sinks without connecting to a cluster
+var sinkKinds = map[schema.GroupVersionResource]string{
+	sinkMappings["broker"]: "Broker",
+	sinkMappings["ksvc"]:   "Service",
+}
+
+// ResolveSinkOffline returns the Destination referred to by the flags in the acceptor
+// without connecting to a cluster, so it is not validated that the referred object exists.
+// Only URIs and the default sink prefixes are supported.
+func (i *SinkFlags) ResolveSinkOffline(namespace string) (*duckv1.Destination, error) {
+	if i.sink == "" {
+		return nil, nil
+	}
+
+	prefix, name := parseSink(i.sink)
+	if prefix == "" {
+		// URI target
+		uri, err := apis.ParseURL(name)
+		if err != nil {
+			return nil, err
+		}
+		return &duckv1.Destination{URI: uri}, nil
+	}
+	typ, ok := sinkMappings[prefix]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sink prefix: '%s'", prefix)
+	}
+	kind, ok := sinkKinds[typ]
+	if !ok {
+		return nil, fmt.Errorf("sink prefix '%s' can not be resolved without connecting to a cluster", prefix)
+	}
+	return &duckv1.Destination{
+		Ref: &duckv1.KReference{
+			Kind:       kind,
+			APIVersion: typ.GroupVersion().String(),
+			Name:       name,
+			Namespace:  namespace,
+		},
+	}, nil
+}
+
 // parseSink takes the string given by the user into the prefix and the name of
 // the object. If the user put a URI instead, the prefix is empty and the name
 // is the whole URI.
@@ -137,3 +179,11 @@ func SinkToString(sink duckv1.Destination) string {
 	}
 	return ""
 }
+
+// SinkURIToString prepares a resolved sink URI for list output
+func SinkURIToString(uri *apis.URL) string {
+	if uri == nil {
+		return ""
+	}
+	return uri.String()
+}
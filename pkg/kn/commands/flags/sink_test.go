@@ -81,3 +81,39 @@ func TestResolve(t *testing.T) {
 		}
 	}
 }
+
+func TestResolveOffline(t *testing.T) {
+	targetExampleCom, err := apis.ParseURL("http://target.example.com")
+	assert.NilError(t, err)
+	cases := []resolveCase{
+		{"ksvc:mysvc", &duckv1.Destination{
+			Ref: &duckv1.KReference{Kind: "Service",
+				APIVersion: "serving.knative.dev/v1",
+				Namespace:  "default",
+				Name:       "mysvc"}}, ""},
+		{"absent", &duckv1.Destination{
+			Ref: &duckv1.KReference{Kind: "Service",
+				APIVersion: "serving.knative.dev/v1",
+				Namespace:  "default",
+				Name:       "absent"}}, ""},
+		{"broker:default", &duckv1.Destination{
+			Ref: &duckv1.KReference{Kind: "Broker",
+				APIVersion: "eventing.knative.dev/v1beta1",
+				Namespace:  "default",
+				Name:       "default"}}, ""},
+		{"http://target.example.com", &duckv1.Destination{
+			URI: targetExampleCom,
+		}, ""},
+		{"k8ssvc:foo", nil, "unsupported sink prefix: 'k8ssvc'"},
+	}
+	for _, c := range cases {
+		i := &SinkFlags{c.sink}
+		result, err := i.ResolveSinkOffline("default")
+		if c.destination != nil {
+			assert.DeepEqual(t, result, c.destination)
+			assert.NilError(t, err)
+		} else {
+			assert.ErrorContains(t, err, c.errContents)
+		}
+	}
+}
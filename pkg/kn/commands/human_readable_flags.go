@@ -32,19 +32,22 @@ import (
 type HumanPrintFlags struct {
 	WithNamespace bool
 	NoHeaders     bool
+	// Wide adds the additional columns of the wide output
+	Wide bool
+	// Sorted keeps the order of the printed objects
+	Sorted bool
 	//TODO: Add more flags as required
 }
 
 // AllowedFormats returns more customized formating options
 func (f *HumanPrintFlags) AllowedFormats() []string {
-	// TODO: Add more formats eg: wide
-	return []string{"no-headers"}
+	return []string{"no-headers", "wide", "custom-columns"}
 }
 
 // ToPrinter receives returns a printer capable of
 // handling human-readable output.
 func (f *HumanPrintFlags) ToPrinter(getHandlerFunc func(h hprinters.PrintHandler)) (hprinters.ResourcePrinter, error) {
-	p := hprinters.NewTablePrinter(hprinters.PrintOptions{AllNamespaces: f.WithNamespace, NoHeaders: f.NoHeaders})
+	p := hprinters.NewTablePrinter(hprinters.PrintOptions{AllNamespaces: f.WithNamespace, NoHeaders: f.NoHeaders, Wide: f.Wide, Sorted: f.Sorted})
 	getHandlerFunc(p)
 	return p, nil
 }
@@ -0,0 +1,229 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"knative.dev/serving/pkg/apis/serving"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+)
+
+// Name of the container which runs the user's image in a revision's pod
+const UserContainerName = "user-container"
+
+// Maximum length of a single log line
+const maxLogLineLength = 1024 * 1024
+
+// How often to look for new pods when following the logs
+var LogsPollInterval = 2 * time.Second
+
+// Flags for selecting the logs to show
+type LogsFlags struct {
+	// Stream new log lines and pick up new pods until interrupted
+	Follow bool
+	// Only show log lines newer than this duration
+	Since time.Duration
+	// Number of recent log lines to show per pod, -1 for all
+	Tail int64
+	// Container of the pods whose logs to show
+	Container string
+}
+
+// AddLogsFlags adds the flags for following, limiting and selecting the logs to stream
+func (l *LogsFlags) AddLogsFlags(command *cobra.Command) {
+	flags := command.Flags()
+	flags.BoolVarP(&l.Follow, "follow", "f", false,
+		"Stream new log lines until interrupted. Logs of pods which are started later on, e.g. when scaling up, are picked up, too.")
+	flags.DurationVar(&l.Since, "since", 0,
+		"Only show log lines newer than a relative duration like 10s, 5m or 1h. Defaults to all log lines.")
+	flags.Int64Var(&l.Tail, "tail", -1,
+		"Number of the most recent log lines to show for each pod. Defaults to all log lines.")
+	flags.StringVarP(&l.Container, "container", "c", UserContainerName,
+		"Name of the container whose logs to show, e.g. 'queue-proxy'.")
+}
+
+// RevisionLabelSelector returns a label selector matching the pods of the given revisions
+func RevisionLabelSelector(revisions ...string) (string, error) {
+	requirement, err := labels.NewRequirement(serving.RevisionLabelKey, selection.In, revisions)
+	if err != nil {
+		return "", err
+	}
+	return labels.NewSelector().Add(*requirement).String(), nil
+}
+
+// StreamLogs writes the logs of all pods matching the given label selector to out, every line
+// prefixed with the name of its pod. 'what' describes the owner of the pods for messages.
+func (l *LogsFlags) StreamLogs(client clientcorev1.KnCoreClient, labelSelector string, what string, out io.Writer) error {
+	return l.streamLogs(client, labelSelector, what, out, nil)
+}
+
+// streamLogs is like StreamLogs but when following the logs it returns when the given channel is closed
+func (l *LogsFlags) streamLogs(client clientcorev1.KnCoreClient, labelSelector string, what string, out io.Writer, stop <-chan struct{}) error {
+	streamer := &logStreamer{
+		client:    client,
+		options:   l.podLogOptions(),
+		out:       out,
+		streaming: make(map[string]bool),
+		failing:   make(map[string]bool),
+	}
+
+	started, err := streamer.startStreams(labelSelector)
+	if err != nil {
+		// Let the streams started already finish so that nothing is written after returning
+		streamer.wait.Wait()
+		return err
+	}
+	if !l.Follow {
+		if started == 0 {
+			return fmt.Errorf("no running pods found for %s in namespace '%s', it might be scaled to zero", what, client.Namespace())
+		}
+		streamer.wait.Wait()
+		return nil
+	}
+
+	if started == 0 {
+		streamer.println(fmt.Sprintf("Waiting for pods of %s to start ...", what))
+	}
+	ticker := time.NewTicker(LogsPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			streamer.wait.Wait()
+			return nil
+		case <-ticker.C:
+			_, err := streamer.startStreams(labelSelector)
+			if err != nil {
+				streamer.abort()
+				return err
+			}
+		}
+	}
+}
+
+func (l *LogsFlags) podLogOptions() *corev1.PodLogOptions {
+	options := &corev1.PodLogOptions{
+		Container: l.Container,
+		Follow:    l.Follow,
+	}
+	if l.Since > 0 {
+		seconds := int64(l.Since.Seconds())
+		options.SinceSeconds = &seconds
+	}
+	if l.Tail >= 0 {
+		tail := l.Tail
+		options.TailLines = &tail
+	}
+	return options
+}
+
+// logStreamer copies the log streams of multiple pods line by line to a single writer
+type logStreamer struct {
+	client  clientcorev1.KnCoreClient
+	options *corev1.PodLogOptions
+
+	// Guards the writes to out and the open streams
+	lock sync.Mutex
+	out  io.Writer
+	// Streams which have been opened, for closing them when aborting
+	streams []io.ReadCloser
+	// Set when aborting, after which nothing is written anymore
+	aborted bool
+
+	// Pods whose logs are streamed already
+	streaming map[string]bool
+	// Pods whose logs couldn't be fetched when following and which have been warned about
+	failing map[string]bool
+	wait    sync.WaitGroup
+}
+
+// startStreams starts streaming the logs of all pods which are not streamed yet and
+// returns the number of new streams. When following the logs, a pod whose logs can't
+// be fetched is only warned about and picked up again with the next call.
+func (s *logStreamer) startStreams(labelSelector string) (int, error) {
+	podList, err := s.client.ListPods(labelSelector)
+	if err != nil {
+		return 0, err
+	}
+	started := 0
+	for _, pod := range podList.Items {
+		if s.streaming[pod.Name] || pod.Status.Phase == corev1.PodPending {
+			continue
+		}
+		stream, err := s.client.GetPodLogs(pod.Name, s.options)
+		if err != nil {
+			if !s.options.Follow {
+				return started, fmt.Errorf("cannot get logs of pod '%s': %v", pod.Name, err)
+			}
+			if !s.failing[pod.Name] {
+				s.failing[pod.Name] = true
+				s.println(fmt.Sprintf("Warning: cannot get logs of pod '%s', retrying: %v", pod.Name, err))
+			}
+			continue
+		}
+		s.streaming[pod.Name] = true
+		s.lock.Lock()
+		s.streams = append(s.streams, stream)
+		s.lock.Unlock()
+		s.wait.Add(1)
+		go s.copy(pod.Name, stream)
+		started++
+	}
+	return started, nil
+}
+
+func (s *logStreamer) copy(pod string, stream io.ReadCloser) {
+	defer s.wait.Done()
+	defer stream.Close()
+	prefix := "[" + pod + "] "
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineLength)
+	for scanner.Scan() {
+		s.println(prefix + scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		s.println(prefix + "Error reading logs: " + err.Error())
+	}
+}
+
+func (s *logStreamer) println(line string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.aborted {
+		return
+	}
+	fmt.Fprintln(s.out, line)
+}
+
+// abort closes all open streams and waits until their copying has stopped
+func (s *logStreamer) abort() {
+	s.lock.Lock()
+	s.aborted = true
+	for _, stream := range s.streams {
+		stream.Close()
+	}
+	s.lock.Unlock()
+	s.wait.Wait()
+}
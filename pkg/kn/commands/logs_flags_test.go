@@ -0,0 +1,244 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestLogsFlags(t *testing.T) {
+	var logsFlags LogsFlags
+	cmd := &cobra.Command{Use: "logs", Run: func(cmd *cobra.Command, args []string) {}}
+	logsFlags.AddLogsFlags(cmd)
+
+	options := logsFlags.podLogOptions()
+	assert.DeepEqual(t, options, &corev1.PodLogOptions{Container: UserContainerName})
+
+	cmd.SetArgs([]string{"-f", "--since", "5m", "--tail", "10", "-c", "queue-proxy"})
+	assert.NilError(t, cmd.Execute())
+	since := int64(300)
+	tail := int64(10)
+	assert.DeepEqual(t, logsFlags.podLogOptions(), &corev1.PodLogOptions{
+		Container:    "queue-proxy",
+		Follow:       true,
+		SinceSeconds: &since,
+		TailLines:    &tail,
+	})
+}
+
+func TestRevisionLabelSelector(t *testing.T) {
+	selector, err := RevisionLabelSelector("foo-rev1", "foo-rev2")
+	assert.NilError(t, err)
+	assert.Equal(t, selector, "serving.knative.dev/revision in (foo-rev1,foo-rev2)")
+
+	_, err = RevisionLabelSelector()
+	assert.ErrorContains(t, err, "can't be empty")
+}
+
+func TestStreamLogs(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t)
+	r := client.Recorder()
+
+	podList := &corev1.PodList{Items: []corev1.Pod{
+		newLogsPod("foo-1", corev1.PodRunning),
+		newLogsPod("foo-2", corev1.PodPending),
+	}}
+	r.ListPods("serving.knative.dev/revision in (foo)", podList, nil)
+	r.GetPodLogs("foo-1", &corev1.PodLogOptions{Container: UserContainerName}, ioutil.NopCloser(strings.NewReader("hello\nworld\n")), nil)
+
+	logsFlags := LogsFlags{Container: UserContainerName, Tail: -1}
+	out := new(bytes.Buffer)
+	err := logsFlags.StreamLogs(client, "serving.knative.dev/revision in (foo)", "revision 'foo'", out)
+	assert.NilError(t, err)
+	assert.Equal(t, out.String(), "[foo-1] hello\n[foo-1] world\n")
+
+	r.ListPods(mock.Any(), &corev1.PodList{}, nil)
+	err = logsFlags.StreamLogs(client, "serving.knative.dev/revision in (foo)", "revision 'foo'", out)
+	assert.ErrorContains(t, err, "no running pods found for revision 'foo'")
+
+	r.Validate()
+}
+
+func TestStreamLogsPodLogsError(t *testing.T) {
+	client := clientcorev1.NewMockKnCoreClient(t)
+	r := client.Recorder()
+
+	podList := &corev1.PodList{Items: []corev1.Pod{
+		newLogsPod("foo-1", corev1.PodRunning),
+		newLogsPod("foo-2", corev1.PodRunning),
+	}}
+	reader, writer := io.Pipe()
+	go func() {
+		writer.Write([]byte("hello\n"))
+		time.Sleep(10 * time.Millisecond)
+		writer.Write([]byte("world\n"))
+		writer.Close()
+	}()
+	r.ListPods(mock.Any(), podList, nil)
+	r.GetPodLogs("foo-1", mock.Any(), reader, nil)
+	r.GetPodLogs("foo-2", mock.Any(), nil, errors.New("container not started"))
+
+	logsFlags := LogsFlags{Container: UserContainerName, Tail: -1}
+	out := new(bytes.Buffer)
+	err := logsFlags.StreamLogs(client, "serving.knative.dev/revision in (foo)", "revision 'foo'", out)
+	assert.ErrorContains(t, err, "cannot get logs of pod 'foo-2': container not started")
+	// The logs of the pod streamed already have been written completely before returning
+	assert.Equal(t, out.String(), "[foo-1] hello\n[foo-1] world\n")
+
+	r.Validate()
+}
+
+func TestStreamLogsFollowListError(t *testing.T) {
+	oldInterval := LogsPollInterval
+	defer func() { LogsPollInterval = oldInterval }()
+	LogsPollInterval = time.Millisecond
+
+	reader, writer := io.Pipe()
+	client := &listErrorCoreClient{stream: reader}
+	logsFlags := LogsFlags{Container: UserContainerName, Follow: true, Tail: -1}
+	out := new(bytes.Buffer)
+	err := logsFlags.streamLogs(client, "serving.knative.dev/revision in (foo)", "revision 'foo'", out, nil)
+	assert.ErrorContains(t, err, "connection lost")
+	assert.Equal(t, out.String(), "")
+
+	// The stream has been closed before returning
+	_, err = writer.Write([]byte("late\n"))
+	assert.Equal(t, err, io.ErrClosedPipe)
+}
+
+func TestStreamLogsFollow(t *testing.T) {
+	oldInterval := LogsPollInterval
+	defer func() { LogsPollInterval = oldInterval }()
+	LogsPollInterval = time.Millisecond
+
+	stop := make(chan struct{})
+	client := &followCoreClient{stop: stop}
+	logsFlags := LogsFlags{Container: UserContainerName, Follow: true, Tail: -1}
+	out := new(bytes.Buffer)
+	err := logsFlags.streamLogs(client, "serving.knative.dev/revision in (foo)", "revision 'foo'", out, stop)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out.String(), "Waiting for pods of revision 'foo'", "[foo-1] started"))
+	assert.Equal(t, client.logCalls, 1)
+}
+
+func TestStreamLogsFollowPodLogsError(t *testing.T) {
+	oldInterval := LogsPollInterval
+	defer func() { LogsPollInterval = oldInterval }()
+	LogsPollInterval = time.Millisecond
+
+	stop := make(chan struct{})
+	client := &followCoreClient{stop: stop, failingPod: "foo-0"}
+	logsFlags := LogsFlags{Container: UserContainerName, Follow: true, Tail: -1}
+	out := new(bytes.Buffer)
+	err := logsFlags.streamLogs(client, "serving.knative.dev/revision in (foo)", "revision 'foo'", out, stop)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out.String(), "Warning: cannot get logs of pod 'foo-0', retrying: container not started", "[foo-1] started"))
+	assert.Equal(t, strings.Count(out.String(), "Warning:"), 1)
+}
+
+func newLogsPod(name string, phase corev1.PodPhase) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+}
+
+// followCoreClient returns no pods on the first call and a running pod afterwards.
+// The stop channel is closed when the logs of the pod have been read completely.
+// If failingPod is set, this pod is returned in front of the running pod and its logs can't be fetched.
+// Calls to other methods of the embedded (nil) interface panic.
+type followCoreClient struct {
+	clientcorev1.KnCoreClient
+	lock      sync.Mutex
+	stop      chan struct{}
+	listCalls int
+	logCalls  int
+
+	failingPod string
+}
+
+func (c *followCoreClient) Namespace() string {
+	return "default"
+}
+
+func (c *followCoreClient) ListPods(labelSelector string) (*corev1.PodList, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.listCalls++
+	if c.listCalls == 1 {
+		return &corev1.PodList{}, nil
+	}
+	pods := []corev1.Pod{newLogsPod("foo-1", corev1.PodRunning)}
+	if c.failingPod != "" {
+		pods = append([]corev1.Pod{newLogsPod(c.failingPod, corev1.PodRunning)}, pods...)
+	}
+	return &corev1.PodList{Items: pods}, nil
+}
+
+func (c *followCoreClient) GetPodLogs(name string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if name == c.failingPod {
+		return nil, errors.New("container not started")
+	}
+	c.logCalls++
+	return &closeNotifier{strings.NewReader("started\n"), c.stop}, nil
+}
+
+// listErrorCoreClient returns a running pod whose logs are the given stream on the first call
+// and fails on the following calls
+type listErrorCoreClient struct {
+	clientcorev1.KnCoreClient
+	stream    io.ReadCloser
+	listCalls int
+}
+
+func (c *listErrorCoreClient) ListPods(labelSelector string) (*corev1.PodList, error) {
+	c.listCalls++
+	if c.listCalls > 1 {
+		return nil, errors.New("connection lost")
+	}
+	return &corev1.PodList{Items: []corev1.Pod{newLogsPod("foo-1", corev1.PodRunning)}}, nil
+}
+
+func (c *listErrorCoreClient) GetPodLogs(name string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	return c.stream, nil
+}
+
+type closeNotifier struct {
+	io.Reader
+	closed chan struct{}
+}
+
+func (c *closeNotifier) Close() error {
+	close(c.closed)
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	eventingscheme "knative.dev/eventing/pkg/client/clientset/versioned/scheme"
+	servingscheme "knative.dev/serving/pkg/client/clientset/versioned/scheme"
+)
+
+// OfflineFlags for generating a resource locally without connecting to a cluster
+type OfflineFlags struct {
+	// Offline is set if the resource should only be printed
+	Offline bool
+
+	printFlags *genericclioptions.PrintFlags
+}
+
+// Add adds the --offline flag together with the flags for choosing the output format
+func (f *OfflineFlags) Add(command *cobra.Command) {
+	command.Flags().BoolVar(&f.Offline, "offline", false,
+		"Print the resource instead of creating it, without connecting to a cluster. "+
+			"The namespace is only set when given with --namespace.")
+	f.printFlags = genericclioptions.NewPrintFlags("").WithDefaultOutput("yaml")
+	f.printFlags.AddFlags(command)
+	command.Flag("output").Usage = fmt.Sprintf("Output format used with --offline. One of: %s.", strings.Join(f.printFlags.AllowedFormats(), "|"))
+}
+
+// Validate checks that the output flags are only used together with --offline
+func (f *OfflineFlags) Validate(cmd *cobra.Command) error {
+	if !f.Offline && cmd.Flags().Changed("output") {
+		return errors.New("flag --output can only be used together with --offline")
+	}
+	if f.Offline && cmd.Flags().Lookup("dry-run") != nil && cmd.Flags().Changed("dry-run") {
+		return errors.New("flags --offline and --dry-run can not be used together")
+	}
+	return nil
+}
+
+// Namespace returns the namespace given with --namespace, which is empty if not given.
+// In contrast to KnParams.GetNamespace, no kubeconfig is consulted.
+func (f *OfflineFlags) Namespace(cmd *cobra.Command) string {
+	return cmd.Flag("namespace").Value.String()
+}
+
+// PrintObject prints the given object in the requested output format. The group, version and
+// kind of the object are set if the object is a Knative serving, eventing or sources resource.
+func (f *OfflineFlags) PrintObject(out io.Writer, obj runtime.Object) error {
+	if obj.GetObjectKind().GroupVersionKind().Empty() {
+		for _, scheme := range []*runtime.Scheme{servingscheme.Scheme, eventingscheme.Scheme} {
+			gvks, _, err := scheme.ObjectKinds(obj)
+			if err == nil {
+				obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+				break
+			}
+		}
+	}
+	printer, err := f.printFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+	return printer.PrintObj(obj, out)
+}
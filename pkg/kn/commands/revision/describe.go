@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"knative.dev/serving/pkg/apis/serving"
@@ -48,6 +49,9 @@ func NewRevisionDescribeCommand(p *commands.KnParams) *cobra.Command {
 			if len(args) != 1 {
 				return errors.New("'kn revision describe' requires name of the revision as single argument")
 			}
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents && machineReadablePrintFlags.OutputFlagSpecified() {
+				return errors.New("'kn revision describe' can't show events with --output")
+			}
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
 				return err
@@ -82,13 +86,22 @@ func NewRevisionDescribeCommand(p *commands.KnParams) *cobra.Command {
 				}
 			}
 			// Do the human-readable printing thing.
-			return describe(cmd.OutOrStdout(), revision, service, printDetails)
+			out := cmd.OutOrStdout()
+			err = describe(out, revision, service, printDetails)
+			if err != nil {
+				return err
+			}
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents {
+				return commands.PrintEvents(p, out, namespace, commands.RevisionEventObjects(revision.Name))
+			}
+			return nil
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	machineReadablePrintFlags.AddFlags(command)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.Bool("events", false, "Show the Kubernetes events of the revision and of its autoscaler, deployment and pods.")
 	return command
 }
 
@@ -97,11 +110,14 @@ func describe(w io.Writer, revision *servingv1.Revision, service *servingv1.Serv
 	commands.WriteMetadata(dw, &revision.ObjectMeta, printDetails)
 	WriteImage(dw, revision)
 	WritePort(dw, revision)
+	WriteProbes(dw, revision)
 	WriteEnv(dw, revision, printDetails)
 	WriteEnvFrom(dw, revision, printDetails)
+	WriteMounts(dw, revision)
 	WriteScale(dw, revision)
 	WriteConcurrencyOptions(dw, revision)
 	WriteResources(dw, revision)
+	WriteSidecars(dw, revision, printDetails)
 	serviceName, ok := revision.Labels[serving.ServiceLabelKey]
 	if ok {
 		serviceSection := dw.WriteAttribute("Service", serviceName)
@@ -128,7 +144,14 @@ func describe(w io.Writer, revision *servingv1.Revision, service *servingv1.Serv
 }
 
 func WriteConcurrencyOptions(dw printers.PrefixWriter, revision *servingv1.Revision) {
-	target := clientserving.ConcurrencyTarget(&revision.ObjectMeta)
+	scale, _ := clientserving.ScalingInfo(&revision.ObjectMeta)
+	var target *int
+	if scale == nil || scale.Metric == "" || scale.Metric == clientserving.AutoscaleMetricConcurrency {
+		target = clientserving.ConcurrencyTarget(&revision.ObjectMeta)
+	}
+	if scale != nil {
+		writeAutoscaling(dw, scale)
+	}
 	limit := revision.Spec.ContainerConcurrency
 	autoscaleWindow := clientserving.AutoscaleWindow(&revision.ObjectMeta)
 	concurrencyUtilization := clientserving.ConcurrencyTargetUtilization(&revision.ObjectMeta)
@@ -150,6 +173,34 @@ func WriteConcurrencyOptions(dw printers.PrefixWriter, revision *servingv1.Revis
 
 }
 
+// writeAutoscaling writes the autoscaler class and metric with its target if the metric
+// is not concurrency, as well as the initial scale and the panic mode settings
+func writeAutoscaling(dw printers.PrefixWriter, scale *clientserving.Scaling) {
+	nonConcurrencyMetric := scale.Metric != "" && scale.Metric != clientserving.AutoscaleMetricConcurrency
+	if scale.Class == "" && scale.Metric == "" && scale.Init == nil && scale.PanicWindow == "" && scale.PanicThreshold == "" {
+		return
+	}
+	section := dw.WriteAttribute("Autoscaling", "")
+	if scale.Class != "" {
+		section.WriteAttribute("Class", scale.Class)
+	}
+	if scale.Metric != "" {
+		section.WriteAttribute("Metric", scale.Metric)
+	}
+	if nonConcurrencyMetric && scale.Target != "" {
+		section.WriteAttribute("Target", scale.Target)
+	}
+	if scale.Init != nil {
+		section.WriteAttribute("Initial Scale", strconv.Itoa(*scale.Init))
+	}
+	if scale.PanicWindow != "" {
+		section.WriteAttribute("Panic Window", scale.PanicWindow+"%")
+	}
+	if scale.PanicThreshold != "" {
+		section.WriteAttribute("Panic Threshold", scale.PanicThreshold+"%")
+	}
+}
+
 // Write the image attribute (with
 func WriteImage(dw printers.PrefixWriter, revision *servingv1.Revision) {
 	c, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
@@ -188,15 +239,76 @@ func WritePort(dw printers.PrefixWriter, revision *servingv1.Revision) {
 	}
 }
 
+// WriteProbes writes the readiness and liveness probes of the serving container
+func WriteProbes(dw printers.PrefixWriter, revision *servingv1.Revision) {
+	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
+	if err != nil {
+		return
+	}
+	if container.ReadinessProbe != nil {
+		dw.WriteAttribute("Readiness", clientserving.ProbeToString(container.ReadinessProbe))
+	}
+	if container.LivenessProbe != nil {
+		dw.WriteAttribute("Liveness", clientserving.ProbeToString(container.LivenessProbe))
+	}
+}
+
 func WriteEnv(dw printers.PrefixWriter, revision *servingv1.Revision, printDetails bool) {
-	env := stringifyEnv(revision)
+	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
+	if err != nil {
+		return
+	}
+	writeContainerEnv(dw, container, printDetails)
+}
+
+func WriteEnvFrom(dw printers.PrefixWriter, revision *servingv1.Revision, printDetails bool) {
+	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
+	if err != nil {
+		return
+	}
+	writeContainerEnvFrom(dw, container, printDetails)
+}
+
+// WriteMounts writes the volumes mounted into the serving container together with their source,
+// the projected keys and the file mode
+func WriteMounts(dw printers.PrefixWriter, revision *servingv1.Revision) {
+	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
+	if err != nil || len(container.VolumeMounts) == 0 {
+		return
+	}
+	section := dw.WriteAttribute("Mounts", "")
+	for _, mount := range container.VolumeMounts {
+		section.WriteAttribute(mount.MountPath, stringifyVolume(mount.Name, revision.Spec.Volumes))
+	}
+}
+
+// WriteSidecars writes the name and image of all containers besides the serving container
+// and with details also their environment and resources
+func WriteSidecars(dw printers.PrefixWriter, revision *servingv1.Revision, printDetails bool) {
+	sidecars := clientserving.SidecarsOfRevisionSpec(&revision.Spec)
+	if len(sidecars) == 0 {
+		return
+	}
+	section := dw.WriteAttribute("Sidecars", "")
+	for _, sidecar := range sidecars {
+		sidecarSection := section.WriteAttribute(sidecar.Name, sidecar.Image)
+		if printDetails {
+			writeContainerEnv(sidecarSection, sidecar, printDetails)
+			writeContainerEnvFrom(sidecarSection, sidecar, printDetails)
+			writeContainerResources(sidecarSection, sidecar)
+		}
+	}
+}
+
+func writeContainerEnv(dw printers.PrefixWriter, container *corev1.Container, printDetails bool) {
+	env := stringifyEnv(container)
 	if env != nil {
 		commands.WriteSliceDesc(dw, env, "Env", printDetails)
 	}
 }
 
-func WriteEnvFrom(dw printers.PrefixWriter, revision *servingv1.Revision, printDetails bool) {
-	envFrom := stringifyEnvFrom(revision)
+func writeContainerEnvFrom(dw printers.PrefixWriter, container *corev1.Container, printDetails bool) {
+	envFrom := stringifyEnvFrom(container)
 	if envFrom != nil {
 		commands.WriteSliceDesc(dw, envFrom, "EnvFrom", printDetails)
 	}
@@ -218,6 +330,10 @@ func WriteResources(dw printers.PrefixWriter, r *servingv1.Revision) {
 	if err != nil {
 		return
 	}
+	writeContainerResources(dw, c)
+}
+
+func writeContainerResources(dw printers.PrefixWriter, c *corev1.Container) {
 	requests := c.Resources.Requests
 	limits := c.Resources.Limits
 	writeResourcesHelper(dw, "Memory", requests.Memory(), limits.Memory())
@@ -270,29 +386,51 @@ func formatScale(minScale *int, maxScale *int) string {
 	return ret
 }
 
-func stringifyEnv(revision *servingv1.Revision) []string {
-	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
-	if err != nil {
-		return nil
-	}
-
+func stringifyEnv(container *corev1.Container) []string {
 	envVars := make([]string, 0, len(container.Env))
 	for _, env := range container.Env {
 		value := env.Value
 		if env.ValueFrom != nil {
-			value = "[ref]"
+			value = clientserving.EnvVarSourceToString(env.ValueFrom)
 		}
 		envVars = append(envVars, fmt.Sprintf("%s=%s", env.Name, value))
 	}
 	return envVars
 }
 
-func stringifyEnvFrom(revision *servingv1.Revision) []string {
-	container, err := clientserving.ContainerOfRevisionSpec(&revision.Spec)
-	if err != nil {
-		return nil
+// stringifyVolume describes the source of a volume like cm:name [key=path, ...] mode=0440
+func stringifyVolume(name string, volumes []corev1.Volume) string {
+	for _, volume := range volumes {
+		if volume.Name != name {
+			continue
+		}
+		var source string
+		var items []corev1.KeyToPath
+		var mode *int32
+		switch {
+		case volume.ConfigMap != nil:
+			source, items, mode = "cm:"+volume.ConfigMap.Name, volume.ConfigMap.Items, volume.ConfigMap.DefaultMode
+		case volume.Secret != nil:
+			source, items, mode = "secret:"+volume.Secret.SecretName, volume.Secret.Items, volume.Secret.DefaultMode
+		default:
+			return name
+		}
+		if len(items) > 0 {
+			projections := make([]string, 0, len(items))
+			for _, item := range items {
+				projections = append(projections, item.Key+"="+item.Path)
+			}
+			source += " [" + strings.Join(projections, ", ") + "]"
+		}
+		if mode != nil {
+			source += fmt.Sprintf(" mode=%04o", *mode)
+		}
+		return source
 	}
+	return name
+}
 
+func stringifyEnvFrom(container *corev1.Container) []string {
 	var result []string
 	for _, envFromSource := range container.EnvFrom {
 		if envFromSource.ConfigMapRef != nil {
@@ -17,6 +17,7 @@ package revision
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,13 +26,16 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	clienttesting "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/serving/pkg/apis/autoscaling"
 	apiserving "knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	"sigs.k8s.io/yaml"
 
+	clientcorev1 "knative.dev/client/pkg/core/v1"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/util"
 )
@@ -126,6 +130,136 @@ func TestDescribeRevisionBasic(t *testing.T) {
 	assert.Assert(t, util.ContainsAll(data, "EnvFrom:", "cm:test1, cm:test2"))
 }
 
+func TestDescribeRevisionEnvReferences(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3)
+	container := &expectedRevision.Spec.Containers[0]
+	container.Env = append(container.Env, v1.EnvVar{Name: "PASSWORD", ValueFrom: &v1.EnvVarSource{
+		SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "db"}, Key: "password"},
+	}})
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev", "--verbose"}, &expectedRevision)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(data, "env1=eval1", "PASSWORD=secret:db:password"))
+}
+
+func TestDescribeRevisionMounts(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3)
+	mode := int32(0440)
+	expectedRevision.Spec.Volumes = []v1.Volume{
+		{Name: "cfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+			LocalObjectReference: v1.LocalObjectReference{Name: "app-config"},
+			Items:                []v1.KeyToPath{{Key: "settings.json", Path: "config.json"}},
+			DefaultMode:          &mode,
+		}}},
+		{Name: "creds", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "db"}}},
+	}
+	expectedRevision.Spec.Containers[0].VolumeMounts = []v1.VolumeMount{
+		{Name: "cfg", MountPath: "/etc/cfg", ReadOnly: true},
+		{Name: "creds", MountPath: "/etc/creds", ReadOnly: true},
+	}
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev"}, &expectedRevision)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(data, "Mounts:", "/etc/cfg", "cm:app-config [settings.json=config.json] mode=0440", "/etc/creds", "secret:db"))
+}
+
+func TestDescribeRevisionAutoscaling(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3)
+	expectedRevision.Annotations = map[string]string{
+		autoscaling.ClassAnnotationKey:                    autoscaling.KPA,
+		autoscaling.MetricAnnotationKey:                   "rps",
+		autoscaling.TargetAnnotationKey:                   "150",
+		autoscaling.InitialScaleAnnotationKey:             "2",
+		autoscaling.PanicWindowPercentageAnnotationKey:    "10",
+		autoscaling.PanicThresholdPercentageAnnotationKey: "200",
+		autoscaling.WindowAnnotationKey:                   "60s",
+	}
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev"}, &expectedRevision)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(data, "Autoscaling:", "Class:", "kpa", "Metric:", "rps", "Target:", "150",
+		"Initial Scale:", "2", "Panic Window:", "10%", "Panic Threshold:", "200%", "Concurrency:", "Window:", "60s"))
+	// The rps target must not be shown as concurrency target
+	assert.Equal(t, strings.Count(data, "Target:"), 1)
+}
+
+func TestDescribeRevisionEvents(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	revision := createTestRevision("test-rev", 3)
+	fakeServing.AddReactor("get", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &revision, nil
+		})
+
+	coreClient := clientcorev1.NewMockKnCoreClient(t)
+	knParams.NewCoreClient = func(namespace string) (clientcorev1.KnCoreClient, error) {
+		return coreClient, nil
+	}
+	r := coreClient.Recorder()
+	r.ListEvents("involvedObject.kind=Revision,involvedObject.name=test-rev", &v1.EventList{}, nil)
+	r.ListEvents("involvedObject.kind=PodAutoscaler,involvedObject.name=test-rev", &v1.EventList{Items: []v1.Event{
+		{InvolvedObject: v1.ObjectReference{Kind: "PodAutoscaler", Name: "test-rev"}, Type: v1.EventTypeNormal,
+			Reason: "ScaledToZero", Message: "Scaled to zero"},
+	}}, nil)
+	r.ListEvents("involvedObject.kind=Deployment,involvedObject.name=test-rev-deployment", &v1.EventList{Items: []v1.Event{
+		{InvolvedObject: v1.ObjectReference{Kind: "Deployment", Name: "test-rev-deployment"}, Type: v1.EventTypeNormal,
+			Reason: "ScalingReplicaSet", Message: "Scaled up replica set"},
+	}}, nil)
+	r.ListEvents("involvedObject.kind=ReplicaSet", &v1.EventList{}, nil)
+	r.ListEvents("involvedObject.kind=Pod", &v1.EventList{Items: []v1.Event{
+		{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "other-rev-deployment-abc-xyz"}, Type: v1.EventTypeNormal,
+			Reason: "Pulled", Message: "Other revision"},
+	}}, nil)
+
+	cmd.SetArgs([]string{"revision", "describe", "test-rev", "--events"})
+	err := cmd.Execute()
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(buf.String(), "Events:", "PodAutoscaler/test-rev", "Scaled to zero", "Deployment/test-rev-deployment"))
+	assert.Assert(t, util.ContainsNone(buf.String(), "Other revision"))
+	r.Validate()
+}
+
+func TestDescribeRevisionProbes(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3)
+	container := &expectedRevision.Spec.Containers[0]
+	container.ReadinessProbe = &v1.Probe{
+		Handler: v1.Handler{
+			HTTPGet: &v1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)},
+		},
+		PeriodSeconds: 5,
+	}
+	container.LivenessProbe = &v1.Probe{
+		Handler: v1.Handler{
+			Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/alive"}},
+		},
+	}
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev"}, &expectedRevision)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(data, "Readiness:", "http:/healthz:8080,period=5", "Liveness:", "exec:cat,/tmp/alive"))
+}
+
+func TestDescribeRevisionSidecars(t *testing.T) {
+	expectedRevision := createTestRevision("test-rev", 3)
+	containers := expectedRevision.Spec.Containers
+	expectedRevision.Spec.Containers = []v1.Container{
+		{
+			Name:  "proxy",
+			Image: "gcr.io/test/proxy",
+			Env:   []v1.EnvVar{{Name: "UPSTREAM", Value: "localhost:8080"}},
+		},
+		containers[0],
+	}
+
+	_, data, err := fakeRevision([]string{"revision", "describe", "test-rev", "--verbose"}, &expectedRevision)
+	assert.NilError(t, err)
+
+	// The serving container is still described on top level
+	assert.Assert(t, util.ContainsAll(data, "Image:", "gcr.io/test/image", "Port:", "8080", "env1=eval1"))
+	assert.Assert(t, util.ContainsAll(data, "Sidecars:", "proxy:", "gcr.io/test/proxy", "UPSTREAM=localhost:8080"))
+}
+
 func createTestRevision(revision string, gen int64) servingv1.Revision {
 	labels := make(map[string]string)
 	labels[apiserving.ConfigurationGenerationLabelKey] = fmt.Sprintf("%d", gen)
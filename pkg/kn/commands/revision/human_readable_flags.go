@@ -15,6 +15,8 @@
 package revision
 
 import (
+	"strconv"
+
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/serving/pkg/apis/serving"
@@ -45,6 +47,8 @@ func RevisionListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of the revision.", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready condition status of the revision.", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the revision.", Priority: 1},
+		{Name: "Image", Type: "string", Description: "Image digest of the revision, or its image if not resolved yet.", Priority: 2},
+		{Name: "Concurrency", Type: "string", Description: "Container concurrency of the revision.", Priority: 2},
 	}
 	h.TableHandler(RevisionColumnDefinitions, printRevision)
 	h.TableHandler(RevisionColumnDefinitions, printRevisionList)
@@ -96,9 +100,38 @@ func printRevision(revision *servingv1.Revision, options hprinters.PrintOptions)
 		trunc(conditions),
 		trunc(ready),
 		trunc(reason))
+	if options.Wide {
+		// The image isn't truncated to keep the digest usable
+		row.Cells = append(row.Cells,
+			revisionImage(revision),
+			revisionConcurrency(revision))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
+// revisionImage returns the resolved image digest of the revision's first container,
+// falling back to the image as specified
+func revisionImage(revision *servingv1.Revision) string {
+	if len(revision.Status.ContainerStatuses) > 0 && revision.Status.ContainerStatuses[0].ImageDigest != "" {
+		return revision.Status.ContainerStatuses[0].ImageDigest
+	}
+	if revision.Status.DeprecatedImageDigest != "" {
+		return revision.Status.DeprecatedImageDigest
+	}
+	if len(revision.Spec.Containers) > 0 {
+		return revision.Spec.Containers[0].Image
+	}
+	return ""
+}
+
+// revisionConcurrency returns the container concurrency of the revision, if set
+func revisionConcurrency(revision *servingv1.Revision) string {
+	if revision.Spec.ContainerConcurrency == nil {
+		return ""
+	}
+	return strconv.FormatInt(*revision.Spec.ContainerConcurrency, 10)
+}
+
 func trunc(txt string) string {
 	if len(txt) <= ListColumnMaxLength {
 		return txt
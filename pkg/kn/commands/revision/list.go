@@ -23,11 +23,13 @@ import (
 	"knative.dev/serving/pkg/apis/serving"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
 )
 
 // Service name filter, used with "-s"
@@ -36,6 +38,7 @@ var serviceNameFilter string
 // NewRevisionListCommand represents 'kn revision list' command
 func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 	revisionListFlags := flags.NewListPrintFlags(RevisionListHandlers)
+	selectorFilter := flags.LabelSelectorFilter{}
 
 	revisionListCommand := &cobra.Command{
 		Use:   "list",
@@ -52,7 +55,13 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
   kn revision list -o json
 
   # List revision 'web'
-  kn revision list web`,
+  kn revision list web
+
+  # List all revisions with the label 'team=payments'
+  kn revision list -l team=payments
+
+  # List revisions for a service 'svc1' and watch for changes
+  kn revision list -s svc1 --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -64,7 +73,7 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			// Create list filters
-			var params []clientservingv1.ListConfig
+			var params []util.ListConfig
 			params, err = appendServiceFilter(params, client, cmd)
 			if err != nil {
 				return err
@@ -73,6 +82,30 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if selectorFilter.Selector != "" {
+				params = append(params, util.WithLabelSelector(selectorFilter.Selector))
+			}
+
+			// Add namespace column if no namespace is given (i.e. "--all-namespaces" option is given)
+			if namespace == "" {
+				revisionListFlags.EnsureWithNamespace()
+			}
+
+			// Print every page as soon as it's received when listing in chunks
+			params = append(params, util.WithChunkSize(revisionListFlags.ChunkSize))
+			streamed := false
+			if revisionListFlags.Streaming() {
+				params = append(params, util.WithPageHandler(func(page runtime.Object) error {
+					revisionPage := page.(*servingv1.RevisionList)
+					err := enrichRevisionAnnotationsWithServiceData(p.NewServingClient, revisionPage)
+					if err != nil {
+						return err
+					}
+					sortRevisions(revisionPage)
+					streamed = true
+					return revisionListFlags.PrintPage(revisionPage, cmd.OutOrStdout())
+				}))
+			}
 
 			// Query for list with filters
 			revisionList, err := client.ListRevisions(params...)
@@ -81,18 +114,14 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			// Stop if nothing found
-			if len(revisionList.Items) == 0 {
+			if len(revisionList.Items) == 0 && !revisionListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No revisions found.\n")
 				return nil
 			}
 
-			// Add namespace column if no namespace is given (i.e. "--all-namespaces" option is given)
-			if namespace == "" {
-				revisionListFlags.EnsureWithNamespace()
-			}
-
 			// Only add temporary annotations if human readable output is requested
-			if !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() {
+			// and the revisions haven't been printed page by page already
+			if revisionListFlags.HumanReadableOutput() && !streamed {
 				err = enrichRevisionAnnotationsWithServiceData(p.NewServingClient, revisionList)
 				if err != nil {
 					return err
@@ -102,19 +131,40 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 			// Sort revisions by namespace, service, generation (in this order)
 			sortRevisions(revisionList)
 
+			if revisionListFlags.Watch {
+				watcher, err := client.WatchRevisions(params...)
+				if err != nil {
+					return err
+				}
+				// Watched revisions get the current traffic information, too
+				revisionListFlags.HumanReadableConverter = func(obj runtime.Object) (runtime.Object, error) {
+					revision, ok := obj.(*servingv1.Revision)
+					if !ok {
+						return obj, nil
+					}
+					list := &servingv1.RevisionList{Items: []servingv1.Revision{*revision}}
+					err := enrichRevisionAnnotationsWithServiceData(p.NewServingClient, list)
+					return &list.Items[0], err
+				}
+				return revisionListFlags.PrintWatch(revisionList, watcher, cmd.OutOrStdout())
+			}
+
 			// Print out infos via printer framework
 			return revisionListFlags.Print(revisionList, cmd.OutOrStdout())
 		},
 	}
 	commands.AddNamespaceFlags(revisionListCommand.Flags(), true)
 	revisionListFlags.AddFlags(revisionListCommand)
+	revisionListFlags.AddWatchFlag(revisionListCommand)
+	revisionListFlags.AddChunkSizeFlag(revisionListCommand)
+	selectorFilter.Add(revisionListCommand, "revisions")
 	revisionListCommand.Flags().StringVarP(&serviceNameFilter, "service", "s", "", "Service name")
 
 	return revisionListCommand
 }
 
 // If a service option is given append a filter to the list of filters
-func appendServiceFilter(lConfig []clientservingv1.ListConfig, client clientservingv1.KnServingClient, cmd *cobra.Command) ([]clientservingv1.ListConfig, error) {
+func appendServiceFilter(lConfig []util.ListConfig, client clientservingv1.KnServingClient, cmd *cobra.Command) ([]util.ListConfig, error) {
 	if !cmd.Flags().Changed("service") {
 		return lConfig, nil
 	}
@@ -130,7 +180,7 @@ func appendServiceFilter(lConfig []clientservingv1.ListConfig, client clientserv
 }
 
 // If an additional name is given append this as a revision name filter to the given list
-func appendRevisionNameFilter(lConfigs []clientservingv1.ListConfig, client clientservingv1.KnServingClient, args []string) ([]clientservingv1.ListConfig, error) {
+func appendRevisionNameFilter(lConfigs []util.ListConfig, client clientservingv1.KnServingClient, args []string) ([]util.ListConfig, error) {
 
 	switch len(args) {
 	case 0:
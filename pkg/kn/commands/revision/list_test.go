@@ -21,6 +21,7 @@ import (
 	"gotest.tools/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	clienttesting "k8s.io/client-go/testing"
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -102,6 +103,46 @@ func TestRevisionListDefaultOutput(t *testing.T) {
 	}
 }
 
+func TestRevisionListPagedOutput(t *testing.T) {
+	pages := []*servingv1.RevisionList{
+		{Items: []servingv1.Revision{
+			*createMockRevisionWithParams("foo-abcd", "foo", "1", "100", ""),
+			*createMockRevisionWithParams("bar-abcd", "bar", "1", "100", ""),
+		}},
+		{Items: []servingv1.Revision{
+			*createMockRevisionWithParams("foo-wxyz", "foo", "2", "0", ""),
+		}},
+	}
+	pages[0].Continue = "next"
+	listPaged := func(args ...string) []string {
+		knParams := &commands.KnParams{}
+		cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+		calls := 0
+		fakeServing.AddReactor("list", "revisions",
+			func(a clienttesting.Action) (bool, runtime.Object, error) {
+				calls++
+				return true, pages[calls-1].DeepCopy(), nil
+			})
+		cmd.SetArgs(append([]string{"revision", "list"}, args...))
+		assert.NilError(t, cmd.Execute())
+		return strings.Split(buf.String(), "\n")
+	}
+
+	// The rows of all pages are grouped by service and ordered by generation
+	output := listPaged()
+	assert.Check(t, util.ContainsAll(output[0], revisionListHeader...))
+	assert.Check(t, util.ContainsAll(output[1], "bar-abcd", "bar", "1"))
+	assert.Check(t, util.ContainsAll(output[2], "foo-wxyz", "foo", "2"))
+	assert.Check(t, util.ContainsAll(output[3], "foo-abcd", "foo", "1"))
+
+	// An explicit chunk size prints every page as soon as it's received
+	output = listPaged("--chunk-size", "2")
+	assert.Check(t, util.ContainsAll(output[0], revisionListHeader...))
+	assert.Check(t, util.ContainsAll(output[1], "bar-abcd", "bar", "1"))
+	assert.Check(t, util.ContainsAll(output[2], "foo-abcd", "foo", "1"))
+	assert.Check(t, util.ContainsAll(output[3], "foo-wxyz", "foo", "2"))
+}
+
 func TestRevisionListDefaultOutputNoHeaders(t *testing.T) {
 	revision1 := createMockRevisionWithParams("foo-abcd", "foo", "2", "100", "")
 	revision2 := createMockRevisionWithParams("bar-wxyz", "bar", "1", "100", "")
@@ -181,6 +222,31 @@ func TestRevisionListOutputWithTwoRevName(t *testing.T) {
 	assert.ErrorContains(t, err, "'kn revision list' accepts maximum 1 argument")
 }
 
+func TestRevisionListWatch(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	revision := createMockRevisionWithParams("foo-abcd", "foo", "1", "100", "")
+	fakeServing.AddReactor("list", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &servingv1.RevisionList{}, nil
+		})
+	fakeServing.AddWatchReactor("revisions",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			watcher := watch.NewFakeWithChanSize(2, false)
+			watcher.Add(revision)
+			watcher.Modify(revision)
+			watcher.Stop()
+			return true, watcher, nil
+		})
+	cmd.SetArgs([]string{"revision", "list", "--watch"})
+	assert.NilError(t, cmd.Execute())
+
+	output := strings.Split(buf.String(), "\n")
+	assert.Check(t, util.ContainsAll(output[0], revisionListHeader...))
+	assert.Check(t, util.ContainsAll(output[1], "foo-abcd", "foo", "1"))
+	assert.Check(t, util.ContainsAll(output[2], "foo-abcd", "foo", "1"))
+}
+
 func createMockRevisionWithParams(name, svcName, generation, traffic, tags string) *servingv1.Revision {
 	revision := &servingv1.Revision{
 		TypeMeta: metav1.TypeMeta{
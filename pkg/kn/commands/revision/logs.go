@@ -0,0 +1,75 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+var logsExample = `
+  # Show the logs of revision 'svc-xyzab-1'
+  kn revision logs svc-xyzab-1
+
+  # Follow the logs of revision 'svc-xyzab-1' starting with the last 10 lines
+  kn revision logs svc-xyzab-1 -f --tail 10`
+
+// NewRevisionLogsCommand returns a new command for showing the logs of a revision
+func NewRevisionLogsCommand(p *commands.KnParams) *cobra.Command {
+	var logsFlags commands.LogsFlags
+
+	command := &cobra.Command{
+		Use:     "logs NAME",
+		Short:   "Show the logs of a revision",
+		Example: logsExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'kn revision logs' requires name of the revision as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			coreClient, err := p.NewCoreClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			// Verify that the revision exists, as an unknown revision has just no pods
+			_, err = client.GetRevision(name)
+			if err != nil {
+				return err
+			}
+			selector, err := commands.RevisionLabelSelector(name)
+			if err != nil {
+				return err
+			}
+			return logsFlags.StreamLogs(coreClient, selector, fmt.Sprintf("revision '%s'", name), cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	logsFlags.AddLogsFlags(command)
+	return command
+}
@@ -0,0 +1,67 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestRevisionLogs(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	revision := createTestRevision("foo-rev1", 1)
+	fakeServing.AddReactor("get", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &revision, nil
+		})
+
+	coreClient := clientcorev1.NewMockKnCoreClient(t)
+	knParams.NewCoreClient = func(namespace string) (clientcorev1.KnCoreClient, error) {
+		return coreClient, nil
+	}
+	r := coreClient.Recorder()
+	r.ListPods("serving.knative.dev/revision in (foo-rev1)", &v1.PodList{Items: []v1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-rev1-deployment-abc"},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}}}, nil)
+	r.GetPodLogs("foo-rev1-deployment-abc", mock.Any(), ioutil.NopCloser(strings.NewReader("hello\n")), nil)
+
+	cmd.SetArgs([]string{"revision", "logs", "foo-rev1"})
+	err := cmd.Execute()
+	assert.NilError(t, err)
+	assert.Equal(t, buf.String(), "[foo-rev1-deployment-abc] hello\n")
+
+	r.Validate()
+}
+
+func TestRevisionLogsNoName(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, _, _ := commands.CreateTestKnCommand(NewRevisionCommand(knParams), knParams)
+	cmd.SetArgs([]string{"revision", "logs"})
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "requires name of the revision")
+}
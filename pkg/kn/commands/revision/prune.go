@@ -0,0 +1,207 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+var pruneExample = `
+  # Delete all revisions in the current namespace which receive no traffic
+  kn revision prune
+
+  # Show which revisions of service 'svc' older than 3 days would be deleted, keeping the 5 most recent ones
+  kn revision prune --service svc --keep 5 --older-than 72h --dry-run
+
+  # Let the server check the deletion of the revisions without deleting them
+  kn revision prune --dry-run=server`
+
+type pruneFlags struct {
+	service   string
+	keep      int
+	olderThan time.Duration
+}
+
+// NewRevisionPruneCommand represents 'revision prune' command
+func NewRevisionPruneCommand(p *commands.KnParams) *cobra.Command {
+	var flags pruneFlags
+	var dryRunFlags commands.DryRunFlags
+
+	command := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete revisions which receive no traffic",
+		Long: "Delete the revisions of services which receive no traffic. Revisions which are referenced by a traffic target, " +
+			"which are tagged or which are the latest created or latest ready revision of their service are never deleted.",
+		Example: pruneExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 && (args[0] == commands.DryRunServer || args[0] == commands.DryRunNone) {
+				return fmt.Errorf("'kn revision prune' accepts no arguments, use '--dry-run=%s' to select the dry-run mode", args[0])
+			}
+			if len(args) > 0 {
+				return errors.New("'kn revision prune' accepts no arguments, use --service to select a service")
+			}
+			if flags.keep < 0 {
+				return fmt.Errorf("invalid value %d for --keep, it must not be negative", flags.keep)
+			}
+			err := dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			toPrune, err := revisionsToPrune(client, flags, time.Now())
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if len(toPrune) == 0 {
+				fmt.Fprintf(out, "No revisions to prune in namespace '%s'.\n", namespace)
+				return nil
+			}
+			if dryRunFlags.IsClient() {
+				fmt.Fprintf(out, "Revisions which would be deleted in namespace '%s':\n", namespace)
+			} else {
+				fmt.Fprintf(out, "Deleting revisions in namespace '%s':\n", namespace)
+			}
+			for _, name := range toPrune {
+				fmt.Fprintf(out, "  %s\n", name)
+			}
+			if dryRunFlags.IsClient() {
+				return nil
+			}
+			fmt.Fprintln(out)
+
+			errs := []string{}
+			for _, name := range toPrune {
+				err = client.DeleteRevision(name, 0, dryRunFlags.DeleteOptions()...)
+				if err != nil {
+					errs = append(errs, err.Error())
+				} else if dryRunFlags.IsServer() {
+					fmt.Fprintf(out, "Revision '%s' would be deleted in namespace '%s' (server dry run).\n", name, namespace)
+				} else {
+					fmt.Fprintf(out, "Revision '%s' deleted in namespace '%s'.\n", name, namespace)
+				}
+			}
+			if len(errs) > 0 {
+				return errors.New("Error: " + strings.Join(errs, "\nError: "))
+			}
+			return nil
+		},
+	}
+	f := command.Flags()
+	commands.AddNamespaceFlags(f, false)
+	f.StringVar(&flags.service, "service", "", "Only prune the revisions of the given service.")
+	f.IntVar(&flags.keep, "keep", 0, "Number of the most recent revisions without traffic to keep for each service.")
+	f.DurationVar(&flags.olderThan, "older-than", 0, "Only prune revisions which have been created before this duration, e.g. '72h'.")
+	dryRunFlags.AddWithClient(command)
+	return command
+}
+
+// revisionsToPrune returns the names of the revisions to delete, ordered by service and
+// from the newest to the oldest revision
+func revisionsToPrune(client clientservingv1.KnServingClient, flags pruneFlags, now time.Time) ([]string, error) {
+	var listConfigs []util.ListConfig
+	if flags.service != "" {
+		listConfigs = append(listConfigs, clientservingv1.WithService(flags.service))
+	}
+	revisionList, err := client.ListRevisions(listConfigs...)
+	if err != nil {
+		return nil, err
+	}
+	sortRevisions(revisionList)
+
+	var toPrune []string
+	// Revisions considered for pruning per service, for honoring --keep
+	candidates := make(map[string]int)
+	inUse := make(map[string]map[string]bool)
+	for _, revision := range revisionList.Items {
+		serviceName := revision.Labels[serving.ServiceLabelKey]
+		// Revisions not owned by a service are left alone
+		if serviceName == "" {
+			continue
+		}
+		revisionsInUse, ok := inUse[serviceName]
+		if !ok {
+			revisionsInUse, err = revisionsInUseByService(client, serviceName)
+			if err != nil {
+				return nil, err
+			}
+			inUse[serviceName] = revisionsInUse
+		}
+		if revisionsInUse == nil || revisionsInUse[revision.Name] {
+			continue
+		}
+
+		candidates[serviceName]++
+		if candidates[serviceName] <= flags.keep {
+			continue
+		}
+		if flags.olderThan > 0 && now.Sub(revision.CreationTimestamp.Time) < flags.olderThan {
+			continue
+		}
+		toPrune = append(toPrune, revision.Name)
+	}
+	return toPrune, nil
+}
+
+// revisionsInUseByService returns the revisions of a service which must not be deleted, or nil
+// if the service does not exist (anymore)
+func revisionsInUseByService(client clientservingv1.KnServingClient, serviceName string) (map[string]bool, error) {
+	service, err := client.GetService(serviceName)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return revisionsInUse(service), nil
+}
+
+// revisionsInUse returns the revisions of a service which receive traffic, are tagged
+// or are the latest created or latest ready revision
+func revisionsInUse(service *servingv1.Service) map[string]bool {
+	revisions := RoutedRevisions(service)
+	for _, target := range service.Status.Traffic {
+		if target.RevisionName != "" && (target.Tag != "" || target.Percent == nil || *target.Percent > 0) {
+			revisions[target.RevisionName] = true
+		}
+	}
+	for _, name := range []string{service.Status.LatestCreatedRevisionName, service.Status.LatestReadyRevisionName} {
+		if name != "" {
+			revisions[name] = true
+		}
+	}
+	return revisions
+}
@@ -0,0 +1,154 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestRevisionPrune(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	// foo-rev6: latest created, foo-rev5: latest ready, foo-rev4: tagged,
+	// foo-rev3: pinned in spec, foo-rev2 and foo-rev1: unused
+	r.ListRevisions(mock.Any(), getPruneRevisionList("foo", 6), nil)
+	r.GetService("foo", getPruneService(), nil)
+	r.DeleteRevision("foo-rev2", time.Duration(0), nil)
+	r.DeleteRevision("foo-rev1", time.Duration(0), nil)
+
+	output, err := executeRevisionCommand(client, "prune")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Deleting revisions", "foo-rev2", "foo-rev1",
+		"Revision 'foo-rev2' deleted", "Revision 'foo-rev1' deleted"))
+	assert.Assert(t, util.ContainsNone(output, "foo-rev3", "foo-rev4", "foo-rev5", "foo-rev6"))
+
+	r.Validate()
+}
+
+func TestRevisionPruneKeepAndAge(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.ListRevisions(mock.Any(), getPruneRevisionList("foo", 6), nil)
+	r.GetService("foo", getPruneService(), nil)
+	r.DeleteRevision("foo-rev1", time.Duration(0), nil)
+	output, err := executeRevisionCommand(client, "prune", "--service", "foo", "--keep", "1", "--dry-run=server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Revision 'foo-rev1' would be deleted", "server dry run"))
+	assert.Assert(t, util.ContainsNone(output, "foo-rev2", "Revision 'foo-rev1' deleted"))
+
+	// Only foo-rev1 has been created more than 4 days ago
+	r.ListRevisions(mock.Any(), getPruneRevisionList("foo", 6), nil)
+	r.GetService("foo", getPruneService(), nil)
+	r.DeleteRevision("foo-rev1", time.Duration(0), nil)
+	output, err = executeRevisionCommand(client, "prune", "--older-than", "100h", "--dry-run=server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo-rev1"))
+	assert.Assert(t, util.ContainsNone(output, "foo-rev2"))
+
+	r.Validate()
+}
+
+func TestRevisionPruneClientDryRun(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	// No DeleteRevision is recorded, so any delete call fails the test
+	r.ListRevisions(mock.Any(), getPruneRevisionList("foo", 6), nil)
+	r.GetService("foo", getPruneService(), nil)
+	output, err := executeRevisionCommand(client, "prune", "--dry-run")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Revisions which would be deleted in namespace 'default'", "foo-rev2", "foo-rev1"))
+	assert.Assert(t, util.ContainsNone(output, "Deleting", "Revision 'foo-rev2' deleted", "foo-rev3"))
+
+	r.Validate()
+}
+
+func TestRevisionPruneNothingToDo(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	// Revisions of a deleted service are left alone
+	r.ListRevisions(mock.Any(), getPruneRevisionList("bar", 2), nil)
+	r.GetService("bar", nil, errors.NewNotFound(servingv1.Resource("service"), "bar"))
+	output, err := executeRevisionCommand(client, "prune")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No revisions to prune"))
+
+	_, err = executeRevisionCommand(client, "prune", "foo")
+	assert.ErrorContains(t, err, "accepts no arguments")
+
+	_, err = executeRevisionCommand(client, "prune", "--keep", "-1")
+	assert.ErrorContains(t, err, "must not be negative")
+
+	_, err = executeRevisionCommand(client, "prune", "--dry-run=all")
+	assert.ErrorContains(t, err, "invalid value 'all' for --dry-run, please specify one of none|client|server")
+
+	_, err = executeRevisionCommand(client, "prune", "--dry-run", "server")
+	assert.ErrorContains(t, err, "use '--dry-run=server'")
+
+	r.Validate()
+}
+
+func getPruneService() *servingv1.Service {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(90)},
+		{RevisionName: "foo-rev3", Percent: ptr.Int64(10)},
+	}
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-rev5", Percent: ptr.Int64(90)},
+		{RevisionName: "foo-rev3", Percent: ptr.Int64(10)},
+		{RevisionName: "foo-rev4", Tag: "old", Percent: ptr.Int64(0)},
+	}
+	service.Status.LatestCreatedRevisionName = "foo-rev6"
+	service.Status.LatestReadyRevisionName = "foo-rev5"
+	return service
+}
+
+// getPruneRevisionList returns revisions which have been created one day apart from each other,
+// the newest one just now
+func getPruneRevisionList(service string, count int) *servingv1.RevisionList {
+	list := &servingv1.RevisionList{}
+	now := time.Now()
+	for gen := 1; gen <= count; gen++ {
+		list.Items = append(list.Items, servingv1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              service + "-rev" + strconv.Itoa(gen),
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Duration(count-gen) * 24 * time.Hour)),
+				Labels: map[string]string{
+					serving.ServiceLabelKey:                 service,
+					serving.ConfigurationGenerationLabelKey: strconv.Itoa(gen),
+				},
+			},
+		})
+	}
+	return list
+}
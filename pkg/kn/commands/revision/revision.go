@@ -29,12 +29,28 @@ func NewRevisionCommand(p *commands.KnParams) *cobra.Command {
 	revisionCmd.AddCommand(NewRevisionListCommand(p))
 	revisionCmd.AddCommand(NewRevisionDescribeCommand(p))
 	revisionCmd.AddCommand(NewRevisionDeleteCommand(p))
+	revisionCmd.AddCommand(NewRevisionLogsCommand(p))
+	revisionCmd.AddCommand(NewRevisionPruneCommand(p))
 	return revisionCmd
 }
 
 // ============================================
 // Shared revision functions:
 
+// RoutedRevisions returns the names of the revisions which are explicitly referenced
+// by the traffic targets of a service's spec
+func RoutedRevisions(service *servingv1.Service) map[string]bool {
+	trafficList := service.Spec.RouteSpec.Traffic
+	revsMap := make(map[string]bool)
+
+	for _, traffic := range trafficList {
+		if traffic.RevisionName != "" {
+			revsMap[traffic.RevisionName] = true
+		}
+	}
+	return revsMap
+}
+
 // Extract traffic and tags for given revision from a service
 func trafficAndTagsForRevision(revision string, service *servingv1.Service) (int64, []string) {
 	if len(service.Status.Traffic) == 0 {
@@ -0,0 +1,103 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+)
+
+var createExample = `
+  # Create a route 'web' which sends all traffic to the latest ready revision of configuration 'web-config'
+  kn route create web --traffic web-config=100
+
+  # Create a route 'web' spanning the configurations 'frontend' and 'frontend-next'
+  kn route create web --traffic frontend=90,frontend-next=10 --tag frontend-next=next
+
+  # Create a route 'web' which sends all traffic to revision 'frontend-v1'
+  kn route create web --traffic frontend-v1=100`
+
+// NewRouteCreateCommand represents 'kn route create' command
+func NewRouteCreateCommand(p *commands.KnParams) *cobra.Command {
+	var trafficFlags flags.Traffic
+	var waitFlags commands.WaitFlags
+
+	routeCreateCommand := &cobra.Command{
+		Use:     "create NAME --traffic REF=PERCENT",
+		Short:   "Create a route",
+		Example: createExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'route create' requires the route name given as single argument")
+			}
+			if !trafficFlags.PercentagesChanged(cmd) {
+				return errors.New("'route create' requires the traffic distribution provided with the --traffic option")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			targets, err := computeTraffic(cmd, client, nil, &trafficFlags, name)
+			if err != nil {
+				return err
+			}
+			route := &servingv1.Route{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: servingv1.RouteSpec{
+					Traffic: targets,
+				},
+			}
+			err = client.CreateRoute(route)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Route '%s' created in namespace '%s'.\n", name, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Creating route '%s' in namespace '%s':\n", name, namespace)
+			fmt.Fprintln(out, "")
+			err = waitForRoute(client, name, out, waitFlags.TimeoutInSeconds)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "")
+			return showURL(client, name, "created", out)
+		},
+	}
+	commands.AddNamespaceFlags(routeCreateCommand.Flags(), false)
+	trafficFlags.Add(routeCreateCommand)
+	waitFlags.AddConditionWaitFlags(routeCreateCommand, commands.WaitDefaultTimeout, "create", "route", "ready")
+	return routeCreateCommand
+}
@@ -0,0 +1,78 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/traffic"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestRouteCreate(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetConfiguration("frontend", &servingv1.Configuration{}, nil)
+	r.GetConfiguration("frontend-next", &servingv1.Configuration{}, nil)
+	r.GetConfiguration("frontend-v1", nil, apierrors.NewNotFound(servingv1.Resource("configuration"), "frontend-v1"))
+	r.CreateRoute(func(t *testing.T, a interface{}) {
+		route := a.(*servingv1.Route)
+		assert.Equal(t, route.Name, "web")
+		assert.Equal(t, route.Namespace, "default")
+		assert.DeepEqual(t, route.Spec.Traffic, []servingv1.TrafficTarget{
+			traffic.NewConfigurationTarget("", "frontend", 80),
+			traffic.NewConfigurationTarget("next", "frontend-next", 10),
+			{RevisionName: "frontend-v1", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(10)},
+		})
+	}, nil)
+	r.WaitForRoute("web", mock.Any(), mock.Any(), nil, time.Second)
+	route := createMockRouteMeta("web")
+	route.Status.URL = &apis.URL{Scheme: "http", Host: "web.default.example.com"}
+	r.GetRoute("web", route, nil)
+
+	output, err := executeRouteCommand(client, "create", "web", "--traffic", "frontend=80,frontend-next=10,frontend-v1=10", "--tag", "frontend-next=next")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Creating route 'web'", "Ready to serve", "Route 'web' created", "http://web.default.example.com"))
+	r.Validate()
+}
+
+func TestRouteCreateErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeRouteCommand(client, "create", "--traffic", "foo=100")
+	assert.ErrorContains(t, err, "requires the route name")
+
+	_, err = executeRouteCommand(client, "create", "web")
+	assert.ErrorContains(t, err, "requires the traffic distribution")
+
+	_, err = executeRouteCommand(client, "create", "web", "--traffic", "@latest=100")
+	assert.ErrorContains(t, err, "'@latest' can not be used for a route")
+
+	r := client.Recorder()
+	r.GetConfiguration("foo", &servingv1.Configuration{}, nil)
+	_, err = executeRouteCommand(client, "create", "web", "--traffic", "foo=90")
+	assert.ErrorContains(t, err, "sum to 90, want 100")
+	r.Validate()
+}
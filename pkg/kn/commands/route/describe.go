@@ -38,6 +38,9 @@ func NewRouteDescribeCommand(p *commands.KnParams) *cobra.Command {
 			if len(args) != 1 {
 				return errors.New("'kn route describe' requires name of the route as single argument")
 			}
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents && machineReadablePrintFlags.OutputFlagSpecified() {
+				return errors.New("'kn route describe' can't show events with --output")
+			}
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
 				return err
@@ -64,13 +67,22 @@ func NewRouteDescribeCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return describe(cmd.OutOrStdout(), route, printDetails)
+			out := cmd.OutOrStdout()
+			err = describe(out, route, printDetails)
+			if err != nil {
+				return err
+			}
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents {
+				return commands.PrintEvents(p, out, namespace, routeEventObjects(route.Name))
+			}
+			return nil
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	machineReadablePrintFlags.AddFlags(command)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.Bool("events", false, "Show the Kubernetes events of the route and of its ingress.")
 	return command
 }
 
@@ -124,3 +136,11 @@ func formatTarget(target servingv1.TrafficTarget) string {
 	}
 	return targetHeader
 }
+
+// routeEventObjects returns the route together with the ingress created for it
+func routeEventObjects(name string) []commands.EventObject {
+	return []commands.EventObject{
+		{Kind: "Route", Name: name},
+		{Kind: "Ingress", Name: name},
+	}
+}
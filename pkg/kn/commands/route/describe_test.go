@@ -95,6 +95,12 @@ func TestCompletion(t *testing.T) {
 		assert.ErrorContains(t, err, "requires", "name", "route", "single", "argument")
 	})
 
+	t.Run("describe route with events and machine readable output", func(t *testing.T) {
+		action, _, err := fakeRouteDescribe([]string{"route", "describe", "foo", "--events", "-oyaml"}, &servingv1.Route{})
+		assert.ErrorContains(t, err, "can't show events with --output")
+		assert.Assert(t, action == nil)
+	})
+
 	t.Run("describe a route with human readable output", func(t *testing.T) {
 		setup(t)
 
@@ -15,6 +15,9 @@
 package route
 
 import (
+	"fmt"
+	"strings"
+
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -29,6 +32,8 @@ func RouteListHandlers(h hprinters.PrintHandler) {
 		{Name: "Name", Type: "string", Description: "Name of the Knative route.", Priority: 1},
 		{Name: "URL", Type: "string", Description: "URL of the Knative route.", Priority: 1},
 		{Name: "READY", Type: "string", Description: "Ready condition status of the Knative route.", Priority: 1},
+		{Name: "Traffic", Type: "string", Description: "Traffic split of the Knative route.", Priority: 2},
+		{Name: "Tags", Type: "string", Description: "Tags of the Knative route's traffic targets.", Priority: 2},
 	}
 	h.TableHandler(kRouteColumnDefinitions, printRoute)
 	h.TableHandler(kRouteColumnDefinitions, printKRouteList)
@@ -59,5 +64,23 @@ func printRoute(route *servingv1.Route, options hprinters.PrintOptions) ([]metav
 		name,
 		url,
 		ready)
+	if options.Wide {
+		traffic, tags := routeTraffic(route)
+		row.Cells = append(row.Cells, traffic, tags)
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
+
+// routeTraffic returns the traffic split and the tags of the route's traffic targets
+func routeTraffic(route *servingv1.Route) (string, string) {
+	var traffic, tags []string
+	for _, target := range route.Status.Traffic {
+		if target.Percent != nil && *target.Percent > 0 {
+			traffic = append(traffic, fmt.Sprintf("%d%% -> %s", *target.Percent, target.RevisionName))
+		}
+		if target.Tag != "" {
+			tags = append(tags, target.Tag)
+		}
+	}
+	return strings.Join(traffic, ","), strings.Join(tags, ",")
+}
@@ -20,9 +20,10 @@ import (
 
 	"knative.dev/client/pkg/kn/commands"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
 
 	"github.com/spf13/cobra"
-	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"knative.dev/client/pkg/kn/commands/flags"
 )
@@ -30,6 +31,7 @@ import (
 // NewrouteListCommand represents 'kn route list' command
 func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
 	routeListFlags := flags.NewListPrintFlags(RouteListHandlers)
+	selectorFilter := flags.LabelSelectorFilter{}
 	routeListCommand := &cobra.Command{
 		Use:   "list NAME",
 		Short: "List routes",
@@ -41,7 +43,13 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
   kn route list web -n dev
 
   # List all routes in YAML format
-  kn route list -o yaml`,
+  kn route list -o yaml
+
+  # List all routes with the label 'team=payments'
+  kn route list -l team=payments
+
+  # List all routes and watch for changes
+  kn route list --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			namespace, err := p.GetNamespace(cmd)
@@ -53,22 +61,37 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			var routeList *servingv1.RouteList
-			switch len(args) {
-			case 0:
-				routeList, err = client.ListRoutes()
-			case 1:
-				routeList, err = client.ListRoutes(clientservingv1.WithName(args[0]))
-			default:
+			if len(args) > 1 {
 				return errors.New("'kn route list' accepts only one additional argument")
 			}
+			var listConfigs []util.ListConfig
+			if len(args) == 1 {
+				listConfigs = append(listConfigs, clientservingv1.WithName(args[0]))
+			}
+			if selectorFilter.Selector != "" {
+				listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
+			}
+			listConfigs = append(listConfigs, util.WithChunkSize(routeListFlags.ChunkSize))
+			if routeListFlags.Streaming() {
+				listConfigs = append(listConfigs, util.WithPageHandler(func(page runtime.Object) error {
+					return routeListFlags.PrintPage(page, cmd.OutOrStdout())
+				}))
+			}
+			routeList, err := client.ListRoutes(listConfigs...)
 			if err != nil {
 				return err
 			}
-			if len(routeList.Items) == 0 {
+			if len(routeList.Items) == 0 && !routeListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No routes found.\n")
 				return nil
 			}
+			if routeListFlags.Watch {
+				watcher, err := client.WatchRoutes(listConfigs...)
+				if err != nil {
+					return err
+				}
+				return routeListFlags.PrintWatch(routeList, watcher, cmd.OutOrStdout())
+			}
 			err = routeListFlags.Print(routeList, cmd.OutOrStdout())
 			if err != nil {
 				return err
@@ -78,5 +101,8 @@ func NewRouteListCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(routeListCommand.Flags(), true)
 	routeListFlags.AddFlags(routeListCommand)
+	routeListFlags.AddWatchFlag(routeListCommand)
+	routeListFlags.AddChunkSizeFlag(routeListCommand)
+	selectorFilter.Add(routeListCommand, "routes")
 	return routeListCommand
 }
@@ -20,6 +20,7 @@ import (
 
 	"gotest.tools/assert"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	client_testing "k8s.io/client-go/testing"
 	"knative.dev/pkg/ptr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -121,6 +122,33 @@ func createMockTrafficTarget(revision string, percent int) *servingv1.TrafficTar
 	}
 }
 
+func TestRouteListWatch(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRouteCommand(knParams), knParams)
+	route := createMockRouteSingleTarget("foo", "foo-01234", 100)
+	fakeServing.AddReactor("list", "routes",
+		func(a client_testing.Action) (bool, runtime.Object, error) {
+			return true, &servingv1.RouteList{Items: []servingv1.Route{*route}}, nil
+		})
+	fakeServing.AddWatchReactor("routes",
+		func(a client_testing.Action) (bool, watch.Interface, error) {
+			name, found := a.(client_testing.WatchAction).GetWatchRestrictions().Fields.RequiresExactMatch("metadata.name")
+			assert.Assert(t, found)
+			assert.Equal(t, name, "foo")
+			watcher := watch.NewFakeWithChanSize(1, false)
+			watcher.Delete(route)
+			watcher.Stop()
+			return true, watcher, nil
+		})
+	cmd.SetArgs([]string{"route", "list", "foo", "-w"})
+	assert.NilError(t, cmd.Execute())
+
+	output := strings.Split(buf.String(), "\n")
+	assert.Check(t, util.ContainsAll(output[0], "NAME", "URL", "READY"))
+	assert.Check(t, util.ContainsAll(output[1], "foo"))
+	assert.Check(t, util.ContainsAll(output[2], "foo"))
+}
+
 func createMockRouteSingleTarget(name, revision string, percent int) *servingv1.Route {
 	route := createMockRouteMeta(name)
 	target := createMockTrafficTarget(revision, percent)
@@ -15,17 +15,91 @@
 package route
 
 import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/kn/traffic"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/wait"
 )
 
+const latestRevisionRef = "@latest"
+
 func NewRouteCommand(p *commands.KnParams) *cobra.Command {
 	routeCmd := &cobra.Command{
 		Use:   "route",
-		Short: "List and describe service routes",
+		Short: "Manage routes",
 	}
 	routeCmd.AddCommand(NewRouteListCommand(p))
 	routeCmd.AddCommand(NewRouteDescribeCommand(p))
+	routeCmd.AddCommand(NewRouteCreateCommand(p))
+	routeCmd.AddCommand(NewRouteUpdateCommand(p))
 	return routeCmd
 }
+
+// computeTraffic applies the traffic flags to the given targets. Revision references which
+// are neither a tag nor a revision in the existing targets are looked up as configurations
+// and, if found, routed to the latest ready revision of that configuration.
+func computeTraffic(cmd *cobra.Command, client clientservingv1.KnServingClient, targets []servingv1.TrafficTarget, trafficFlags *flags.Traffic, routeName string) ([]servingv1.TrafficTarget, error) {
+	var refs []string
+	for _, each := range append(trafficFlags.RevisionsPercentages, trafficFlags.RevisionsTags...) {
+		ref := strings.SplitN(each, "=", 2)[0]
+		if ref == latestRevisionRef {
+			return nil, fmt.Errorf("'%s' can not be used for a route, reference a configuration to route to its latest ready revision", latestRevisionRef)
+		}
+		refs = append(refs, ref)
+	}
+
+	for _, ref := range refs {
+		if isReferenced(targets, ref) {
+			continue
+		}
+		_, err := client.GetConfiguration(ref)
+		if apierrors.IsNotFound(err) {
+			// Not a configuration, so it's taken as a revision name
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, traffic.NewConfigurationTarget("", ref, 0))
+	}
+	return traffic.Compute(cmd, targets, trafficFlags, routeName)
+}
+
+// isReferenced checks whether a reference is already used as a tag, revision or configuration
+// in the given traffic targets
+func isReferenced(targets []servingv1.TrafficTarget, ref string) bool {
+	for _, target := range targets {
+		if target.Tag == ref || target.RevisionName == ref || target.ConfigurationName == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func waitForRoute(client clientservingv1.KnServingClient, name string, out io.Writer, timeout int) error {
+	err, duration := client.WaitForRoute(name, time.Duration(timeout)*time.Second, wait.SimpleMessageCallback(out))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%7.3fs Ready to serve.\n", float64(duration.Round(time.Millisecond))/float64(time.Second))
+	return nil
+}
+
+func showURL(client clientservingv1.KnServingClient, name string, what string, out io.Writer) error {
+	route, err := client.GetRoute(name)
+	if err != nil {
+		return fmt.Errorf("cannot fetch route '%s' in namespace '%s' for extracting the URL: %v", name, client.Namespace(), err)
+	}
+	fmt.Fprintf(out, "Route '%s' %s in namespace '%s' is available at URL:\n%s\n", name, what, client.Namespace(), route.Status.URL.String())
+	return nil
+}
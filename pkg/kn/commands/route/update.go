@@ -0,0 +1,108 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/kn/commands/service"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var updateExample = `
+  # Shift 20% of the traffic of route 'web' to the latest ready revision of configuration 'frontend-next'
+  kn route update web --traffic frontend=80,frontend-next=20
+
+  # Tag the revision 'frontend-v1' with 'stable' in route 'web'
+  kn route update web --tag frontend-v1=stable
+
+  # Remove the tag 'next' from route 'web'
+  kn route update web --untag next`
+
+// NewRouteUpdateCommand represents 'kn route update' command
+func NewRouteUpdateCommand(p *commands.KnParams) *cobra.Command {
+	var trafficFlags flags.Traffic
+	var waitFlags commands.WaitFlags
+
+	routeUpdateCommand := &cobra.Command{
+		Use:     "update NAME",
+		Short:   "Update the traffic of a route",
+		Example: updateExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'route update' requires the route name given as single argument")
+			}
+			if !trafficFlags.Changed(cmd) {
+				return errors.New("'route update' requires at least one of --traffic, --tag or --untag")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			err = client.UpdateRouteWithRetry(name, func(route *servingv1.Route) (*servingv1.Route, error) {
+				return updateRoute(cmd, client, route, &trafficFlags)
+			}, service.MaxUpdateRetries)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Route '%s' updated in namespace '%s'.\n", name, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Updating route '%s' in namespace '%s':\n", name, namespace)
+			fmt.Fprintln(out, "")
+			err = waitForRoute(client, name, out, waitFlags.TimeoutInSeconds)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "")
+			return showURL(client, name, "updated", out)
+		},
+	}
+	commands.AddNamespaceFlags(routeUpdateCommand.Flags(), false)
+	trafficFlags.Add(routeUpdateCommand)
+	waitFlags.AddConditionWaitFlags(routeUpdateCommand, commands.WaitDefaultTimeout, "update", "route", "ready")
+	return routeUpdateCommand
+}
+
+func updateRoute(cmd *cobra.Command, client clientservingv1.KnServingClient, route *servingv1.Route, trafficFlags *flags.Traffic) (*servingv1.Route, error) {
+	// Changes to a route managed by a service would be reverted by the service
+	for _, owner := range route.OwnerReferences {
+		if owner.Kind == "Service" {
+			return nil, fmt.Errorf("route '%s' is managed by service '%s', use 'kn service update %s' to change its traffic", route.Name, owner.Name, owner.Name)
+		}
+	}
+	targets, err := computeTraffic(cmd, client, route.Spec.Traffic, trafficFlags, route.Name)
+	if err != nil {
+		return nil, err
+	}
+	route.Spec.Traffic = targets
+	return route, nil
+}
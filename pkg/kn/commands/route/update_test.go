@@ -0,0 +1,69 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/traffic"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestRouteUpdate(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	route := createMockRouteMeta("web")
+	route.Spec.Traffic = []servingv1.TrafficTarget{
+		traffic.NewConfigurationTarget("", "frontend", 100),
+		{RevisionName: "frontend-v1", Tag: "stable", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+	}
+	r.GetRoute("web", route, nil)
+	r.GetConfiguration("frontend-next", &servingv1.Configuration{}, nil)
+	r.UpdateRoute(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Route)
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			traffic.NewConfigurationTarget("", "frontend", 70),
+			{RevisionName: "frontend-v1", Tag: "stable", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(10)},
+			traffic.NewConfigurationTarget("", "frontend-next", 20),
+		})
+	}, nil)
+
+	output, err := executeRouteCommand(client, "update", "web", "--traffic", "frontend=70,stable=10,frontend-next=20", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Route 'web' updated in namespace 'default'"))
+	r.Validate()
+}
+
+func TestRouteUpdateErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeRouteCommand(client, "update", "web")
+	assert.ErrorContains(t, err, "requires at least one of --traffic, --tag or --untag")
+
+	r := client.Recorder()
+	route := createMockRouteMeta("web")
+	route.OwnerReferences = []metav1.OwnerReference{{Kind: "Service", Name: "web"}}
+	r.GetRoute("web", route, nil)
+	_, err = executeRouteCommand(client, "update", "web", "--untag", "foo")
+	assert.ErrorContains(t, err, "managed by service 'web'")
+	r.Validate()
+}
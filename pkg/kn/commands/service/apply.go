@@ -0,0 +1,231 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	"knative.dev/client/pkg/kn/commands"
+	servinglib "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+var applyExample = `
+  # Create or update a service 'foo' from a file
+  kn service apply -f foo.yaml
+
+  # Create or update a service 's1' in namespace 'ns1' from a file which might not contain a name
+  kn service apply s1 -f service.json -n ns1`
+
+// NewServiceApplyCommand returns a new command for declaratively creating or updating a service
+func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
+	var filename string
+	var waitFlags commands.WaitFlags
+
+	serviceApplyCommand := &cobra.Command{
+		Use:   "apply [NAME] -f FILENAME",
+		Short: "Create or update a service from a file",
+		Long: `Create or update a service from a file.
+
+If the service does not exist, it is created. Otherwise the file is merged into the live service
+with a three-way merge between the last applied configuration, the given file and the live service.
+Fields which have been set by other tools or by 'kn service update' are kept.`,
+		Example: applyExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return errors.New("'service apply' accepts at most the service name as single argument")
+			}
+			if filename == "" {
+				return errors.New("'service apply' requires the service given in a file with --filename")
+			}
+			name := ""
+			if len(args) == 1 {
+				name = args[0]
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			service, err := readServiceFromFile(filename, name, namespace)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			return applyService(client, service, waitFlags, cmd)
+		},
+	}
+	flags := serviceApplyCommand.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVarP(&filename, "filename", "f", "", "Service to apply given as YAML or JSON file.")
+	serviceApplyCommand.MarkFlagFilename("filename")
+	waitFlags.AddConditionWaitFlags(serviceApplyCommand, commands.WaitDefaultTimeout, "apply", "service", "ready")
+	return serviceApplyCommand
+}
+
+func applyService(client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, cmd *cobra.Command) error {
+	out := cmd.OutOrStdout()
+	err := setLastAppliedConfiguration(service)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetService(service.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return createService(client, service, waitFlags, out)
+		}
+		return err
+	}
+
+	merged, err := mergeAppliedService(existing, service)
+	if err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(existing, merged) {
+		fmt.Fprintf(out, "Service '%s' unchanged in namespace '%s'.\n", service.Name, client.Namespace())
+		return nil
+	}
+
+	err = client.UpdateServiceWithRetry(service.Name, func(current *servingv1.Service) (*servingv1.Service, error) {
+		return mergeAppliedService(current, service)
+	}, MaxUpdateRetries)
+	if err != nil {
+		return err
+	}
+	return waitIfRequested(client, service, waitFlags, "Applying", "applied", out)
+}
+
+// setLastAppliedConfiguration stores the JSON serialization of the service to apply
+// (without this annotation) in the last-applied-configuration annotation
+func setLastAppliedConfiguration(service *servingv1.Service) error {
+	delete(service.Annotations, corev1.LastAppliedConfigAnnotation)
+	toStore := service.DeepCopy()
+	// The namespace is taken from the command line, not from the file
+	toStore.Namespace = ""
+	if len(toStore.Annotations) == 0 {
+		toStore.Annotations = nil
+	}
+	lastApplied, err := appliedConfiguration(toStore)
+	if err != nil {
+		return err
+	}
+	if service.Annotations == nil {
+		service.Annotations = map[string]string{}
+	}
+	service.Annotations[corev1.LastAppliedConfigAnnotation] = string(lastApplied)
+	return nil
+}
+
+// appliedConfiguration serializes the service to apply to JSON, without status and without
+// fields which are always serialized but have not been set in the file
+func appliedConfiguration(service *servingv1.Service) ([]byte, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(service)
+	if err != nil {
+		return nil, err
+	}
+	delete(obj, "status")
+	unstructured.RemoveNestedField(obj, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(obj, "spec", "template", "metadata", "creationTimestamp")
+	return json.Marshal(obj)
+}
+
+// mergeAppliedService calculates a three-way merge between the configuration stored
+// in the last-applied-configuration annotation of the current service, the service
+// to apply and the current service. The current service is not modified.
+func mergeAppliedService(current *servingv1.Service, applied *servingv1.Service) (*servingv1.Service, error) {
+	patchMeta, err := strategicpatch.NewPatchMetaFromStruct(current)
+	if err != nil {
+		return nil, err
+	}
+
+	var original []byte
+	if lastApplied, ok := current.Annotations[corev1.LastAppliedConfigAnnotation]; ok {
+		original = []byte(lastApplied)
+	}
+	modified, err := appliedConfiguration(applied)
+	if err != nil {
+		return nil, err
+	}
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := strategicpatch.CreateThreeWayMergePatch(original, modified, currentJSON, patchMeta, true)
+	if err != nil {
+		return nil, err
+	}
+	mergedJSON, err := strategicpatch.StrategicMergePatchUsingLookupPatchMeta(currentJSON, patch, patchMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &servingv1.Service{}
+	err = json.Unmarshal(mergedJSON, merged)
+	if err != nil {
+		return nil, err
+	}
+	adaptTemplateToChanges(current, applied, merged)
+	return merged, nil
+}
+
+// adaptTemplateToChanges removes template settings which have been set by kn for the
+// current revision, but which would be wrong for a new revision created from the
+// applied file.
+func adaptTemplateToChanges(current *servingv1.Service, applied *servingv1.Service, merged *servingv1.Service) {
+	currentTemplate := &current.Spec.Template
+	mergedTemplate := &merged.Spec.Template
+	if equality.Semantic.DeepEqual(currentTemplate, mergedTemplate) {
+		return
+	}
+
+	// A changed template needs a new revision name, so let the server pick one
+	// if the name has not been given explicitly in the file
+	if applied.Spec.Template.Name == "" && mergedTemplate.Name == currentTemplate.Name {
+		mergedTemplate.Name = ""
+	}
+
+	// The user image annotation refers to the image used by kn for the current revision
+	_, userImageApplied := applied.Spec.Template.Annotations[servinglib.UserImageAnnotationKey]
+	if !userImageApplied && !equality.Semantic.DeepEqual(containerImages(currentTemplate), containerImages(mergedTemplate)) {
+		servinglib.UnsetUserImageAnnot(mergedTemplate)
+	}
+}
+
+func containerImages(template *servingv1.RevisionTemplateSpec) []string {
+	images := []string{}
+	for _, container := range template.Spec.Containers {
+		images = append(images, container.Image)
+	}
+	return images
+}
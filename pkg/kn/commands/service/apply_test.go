@@ -0,0 +1,140 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	servinglib "knative.dev/client/pkg/serving"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceApplyError(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "apply", "foo")
+	assert.ErrorContains(t, err, "requires the service given in a file")
+
+	_, err = executeServiceCommand(client, "apply", "foo", "bar", "-f", "foo.yaml")
+	assert.ErrorContains(t, err, "at most the service name")
+}
+
+func TestServiceApplyCreate(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	svcFile := writeTempYAMLFile(t, getApplyService("gcr.io/foo/bar:v1", corev1.EnvVar{Name: "a", Value: "mouse"}))
+	defer os.RemoveAll(filepath.Dir(svcFile))
+
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(func(t *testing.T, a interface{}) {
+		created := a.(*servingv1.Service)
+		assert.Equal(t, created.Namespace, "default")
+		lastApplied := getLastApplied(t, created)
+		assert.Equal(t, lastApplied.Namespace, "")
+		assert.Equal(t, lastApplied.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v1")
+		_, ok := lastApplied.Annotations[corev1.LastAppliedConfigAnnotation]
+		assert.Assert(t, !ok)
+	}, nil)
+
+	output, err := executeServiceCommand(client, "apply", "-f", svcFile, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo", "created", "default"))
+	r.Validate()
+}
+
+func TestServiceApplyMerge(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	// Last applied configuration
+	lastApplied := getApplyService("gcr.io/foo/bar:v1", corev1.EnvVar{Name: "a", Value: "mouse"})
+	lastApplied.Annotations = map[string]string{"remove-me": "true"}
+	assert.NilError(t, setLastAppliedConfiguration(lastApplied))
+
+	// Live service with changes done by 'kn service update' and other tools
+	live := lastApplied.DeepCopy()
+	live.Labels = map[string]string{"team": "payments"}
+	live.Spec.Template.Name = "foo-abcde-2"
+	live.Spec.Template.Annotations = map[string]string{servinglib.UserImageAnnotationKey: "gcr.io/foo/bar:v1"}
+	live.Spec.Template.Spec.Containers[0].Env = append(live.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "b", Value: "cookie"})
+
+	// Service to apply
+	svcFile := writeTempYAMLFile(t, getApplyService("gcr.io/foo/bar:v2", corev1.EnvVar{Name: "a", Value: "cat"}))
+	defer os.RemoveAll(filepath.Dir(svcFile))
+
+	r.GetService("foo", live, nil)
+	r.GetService("foo", live, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.DeepEqual(t, updated.Labels, map[string]string{"team": "payments"})
+		_, ok := updated.Annotations["remove-me"]
+		assert.Assert(t, !ok, "annotation removed from file should be removed")
+		container := updated.Spec.Template.Spec.Containers[0]
+		assert.Equal(t, container.Image, "gcr.io/foo/bar:v2")
+		assert.DeepEqual(t, container.Env, []corev1.EnvVar{{Name: "a", Value: "cat"}, {Name: "b", Value: "cookie"}})
+		assert.Equal(t, updated.Spec.Template.Name, "")
+		_, ok = updated.Spec.Template.Annotations[servinglib.UserImageAnnotationKey]
+		assert.Assert(t, !ok, "user image annotation should be removed")
+		assert.Equal(t, getLastApplied(t, updated).Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+	}, nil)
+
+	output, err := executeServiceCommand(client, "apply", "-f", svcFile, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo", "applied", "default"))
+	r.Validate()
+}
+
+func TestServiceApplyUnchanged(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	live := getApplyService("gcr.io/foo/bar:v1", corev1.EnvVar{Name: "a", Value: "mouse"})
+	assert.NilError(t, setLastAppliedConfiguration(live))
+	live.Spec.Template.Spec.Containers[0].Env = append(live.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "b", Value: "cookie"})
+
+	svcFile := writeTempYAMLFile(t, getApplyService("gcr.io/foo/bar:v1", corev1.EnvVar{Name: "a", Value: "mouse"}))
+	defer os.RemoveAll(filepath.Dir(svcFile))
+
+	r.GetService("foo", live, nil)
+
+	output, err := executeServiceCommand(client, "apply", "-f", svcFile)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo", "unchanged"))
+	r.Validate()
+}
+
+func getApplyService(image string, env ...corev1.EnvVar) *servingv1.Service {
+	svc := getServiceWithOptions(getService("foo"))
+	svc.Spec.Template.Spec.Containers[0].Image = image
+	svc.Spec.Template.Spec.Containers[0].Env = env
+	return svc
+}
+
+func getLastApplied(t *testing.T, svc *servingv1.Service) *servingv1.Service {
+	lastApplied := &servingv1.Service{}
+	err := json.Unmarshal([]byte(svc.Annotations[corev1.LastAppliedConfigAnnotation]), lastApplied)
+	assert.NilError(t, err)
+	return lastApplied
+}
@@ -0,0 +1,214 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	servinglib "knative.dev/client/pkg/serving"
+)
+
+var cloneExample = `
+  # Clone the service 'svc' as 'svc-copy' in the current namespace
+  kn service clone svc svc-copy
+
+  # Clone the service 'svc' into the namespace 'staging', keeping its name
+  kn service clone svc svc --target-namespace staging
+
+  # Clone the service 'svc' onto the cluster of the kubeconfig context 'prod',
+  # pinning its image to the digest currently running
+  kn service clone svc svc --target-context prod --lock-to-digest
+
+  # Clone the service 'svc' with all revisions receiving traffic and restore its traffic split
+  kn service clone svc svc-copy --with-revisions
+
+  # Clone the service 'svc' with another environment variable and scale
+  kn service clone svc svc-debug --env DEBUG=true --scale 1`
+
+// NewServiceCloneCommand returns a new command for cloning a service
+func NewServiceCloneCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags ConfigurationEditFlags
+	var waitFlags commands.WaitFlags
+	var targetNamespace string
+	var targetContext string
+	var withRevisions bool
+
+	command := &cobra.Command{
+		Use:   "clone SOURCE TARGET",
+		Short: "Clone a service",
+		Long: `Clone a service under another name, into another namespace or onto the cluster of another kubeconfig context.
+
+The service is copied without its status and runtime metadata like with 'kn service export'. Only
+the latest revision is cloned, unless --with-revisions is given. In this case all revisions receiving
+traffic are recreated one after the other and the traffic split is restored. The clone can be changed
+further with the options of 'kn service update'. With --lock-to-digest, the image of the latest
+revision is pinned to the digest the source service is running.`,
+		Example: cloneExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("'service clone' requires the names of the source and the target service given as two arguments")
+			}
+			sourceName := args[0]
+			targetName := args[1]
+			if withRevisions && !waitFlags.Wait {
+				return errors.New("'service clone --with-revisions' can't be used with --no-wait, as each revision has to be ready before the next one is cloned")
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			targetClient := client
+			if targetContext != "" {
+				targetClient, err = p.NewServingClientForContext(targetContext, targetNamespace)
+			} else if targetNamespace != "" && targetNamespace != namespace {
+				targetClient, err = p.NewServingClient(targetNamespace)
+			}
+			if err != nil {
+				return err
+			}
+			if targetContext == "" && targetClient.Namespace() == namespace && targetName == sourceName {
+				return fmt.Errorf("cannot clone service '%s' onto itself, please provide another name, "+
+					"--target-namespace or --target-context", sourceName)
+			}
+
+			service, err := client.GetService(sourceName)
+			if err != nil {
+				return err
+			}
+
+			var baseRevision *servingv1.Revision
+			if editFlags.LockToDigest && cmd.Flags().Changed("lock-to-digest") && !cmd.Flags().Changed("image") {
+				baseRevision, err = client.GetBaseRevision(service)
+				if err != nil {
+					return fmt.Errorf("cannot lock the image of service '%s' to its digest: %w", sourceName, err)
+				}
+			}
+
+			out := cmd.OutOrStdout()
+			if withRevisions {
+				export, err := exportForKNImport(service.DeepCopy(), client)
+				if err != nil {
+					return err
+				}
+				renameClonedService(&export.Spec.Service, sourceName, targetName)
+				for i := range export.Spec.Revisions {
+					export.Spec.Revisions[i].Name = clonedRevisionName(export.Spec.Revisions[i].Name, sourceName, targetName)
+				}
+				templateName := export.Spec.Service.Spec.Template.Name
+				err = applyCloneFlags(cmd, &editFlags, &export.Spec.Service, baseRevision)
+				if err != nil {
+					return err
+				}
+				retargetTemplateTraffic(&export.Spec.Service, templateName)
+				return importService(targetClient, export, waitFlags.TimeoutInSeconds, out)
+			}
+
+			clone := exportLatestService(service.DeepCopy(), false)
+			renameClonedService(clone, sourceName, targetName)
+			err = applyCloneFlags(cmd, &editFlags, clone, baseRevision)
+			if err != nil {
+				return err
+			}
+
+			exists, err := serviceExists(targetClient, targetName)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return fmt.Errorf("cannot clone service '%s' to '%s' in namespace '%s' because the service already exists",
+					sourceName, targetName, targetClient.Namespace())
+			}
+			clone.Namespace = targetClient.Namespace()
+			return createService(targetClient, clone, waitFlags, out)
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&targetNamespace, "target-namespace", "", "Namespace to clone the service into. Defaults to the namespace of the source service, or of the target context if given.")
+	flags.StringVar(&targetContext, "target-context", "", "Kubeconfig context of the cluster to clone the service onto.")
+	flags.BoolVar(&withRevisions, "with-revisions", false, "Clone all revisions receiving traffic and restore the traffic split (experimental)")
+	editFlags.AddUpdateFlags(command)
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "clone", "service", "ready")
+	return command
+}
+
+// applyCloneFlags applies the configuration options to the cloned service. The image is pinned
+// to the digest of the base revision if given, even if no option changes the revision.
+func applyCloneFlags(cmd *cobra.Command, editFlags *ConfigurationEditFlags, clone *servingv1.Service, baseRevision *servingv1.Revision) error {
+	err := editFlags.Apply(clone, baseRevision, cmd)
+	if err != nil {
+		return err
+	}
+	if baseRevision != nil && !editFlags.AnyMutation(cmd) {
+		servinglib.SetUserImageAnnot(&clone.Spec.Template)
+		return servinglib.FreezeImageToDigest(&clone.Spec.Template, baseRevision)
+	}
+	return nil
+}
+
+// renameClonedService gives the cloned service its new name and renames the referenced
+// revisions accordingly
+func renameClonedService(service *servingv1.Service, sourceName string, targetName string) {
+	service.Name = targetName
+	service.Spec.Template.Name = clonedRevisionName(service.Spec.Template.Name, sourceName, targetName)
+	for i := range service.Spec.Traffic {
+		service.Spec.Traffic[i].RevisionName = clonedRevisionName(service.Spec.Traffic[i].RevisionName, sourceName, targetName)
+	}
+}
+
+// retargetTemplateTraffic moves the traffic of the template's former revision to the template,
+// after the configuration options have changed the template and thus its name. If the changed
+// template has no name, the traffic goes to the latest revision.
+func retargetTemplateTraffic(service *servingv1.Service, oldName string) {
+	newName := service.Spec.Template.Name
+	if oldName == "" || oldName == newName {
+		return
+	}
+	for i := range service.Spec.Traffic {
+		target := &service.Spec.Traffic[i]
+		if target.RevisionName != oldName {
+			continue
+		}
+		if newName == "" {
+			target.RevisionName = ""
+			target.LatestRevision = ptr.Bool(true)
+		} else {
+			target.RevisionName = newName
+		}
+	}
+}
+
+// clonedRevisionName replaces the prefix of a revision name given by the source service
+// with the name of the target service, as revision names have to start with it
+func clonedRevisionName(name string, sourceName string, targetName string) string {
+	if sourceName == targetName || !strings.HasPrefix(name, sourceName+"-") {
+		return name
+	}
+	return targetName + strings.TrimPrefix(name, sourceName)
+}
@@ -0,0 +1,290 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apiserving "knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	knflags "knative.dev/client/pkg/kn/flags"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceClone(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getCloneService(), nil)
+	r.GetService("bar", nil, errors.NewNotFound(servingv1.Resource("service"), "bar"))
+	r.CreateService(func(t *testing.T, a interface{}) {
+		created := a.(*servingv1.Service)
+		assert.Equal(t, created.Name, "bar")
+		assert.Equal(t, created.Namespace, "default")
+		assert.Equal(t, created.Spec.Template.Name, "bar-v1")
+		assert.Equal(t, created.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:baz")
+		assert.Equal(t, len(created.Spec.Traffic), 0)
+		assert.DeepEqual(t, created.Annotations, map[string]string{"team": "payments"})
+		assert.Equal(t, created.Status.LatestReadyRevisionName, "")
+	}, nil)
+	r.WaitForService("bar", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("bar", getServiceWithUrl("bar", "http://bar.example.com"), nil)
+
+	output, err := executeServiceCloneCommand(client, nil, "clone", "foo", "bar")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Creating service 'bar' in namespace 'default'", "http://bar.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceCloneToTargetNamespace(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getCloneService(), nil)
+
+	targetClient := knclient.NewMockKnServiceClient(t, "staging")
+	tr := targetClient.Recorder()
+	tr.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	tr.CreateService(func(t *testing.T, a interface{}) {
+		created := a.(*servingv1.Service)
+		assert.Equal(t, created.Name, "foo")
+		assert.Equal(t, created.Namespace, "staging")
+		assert.Assert(t, strings.HasPrefix(created.Spec.Template.Name, "foo-"))
+		assert.Assert(t, created.Spec.Template.Name != "foo-v1")
+		assert.Equal(t, created.Spec.Template.Spec.Containers[0].Env[0].Value, "true")
+	}, nil)
+
+	output, err := executeServiceCloneCommand(client, targetClient, "clone", "foo", "foo", "--target-namespace", "staging", "--env", "DEBUG=true", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'foo' created in namespace 'staging'"))
+
+	r.Validate()
+	tr.Validate()
+}
+
+func TestServiceCloneToTargetContextWithDigest(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getCloneService(), nil)
+	baseRevision := &servingv1.Revision{Spec: getCloneService().Spec.Template.Spec}
+	baseRevision.Status.DeprecatedImageDigest = "gcr.io/foo/bar@sha256:deadbeef"
+	r.GetRevision("foo-v1", baseRevision, nil)
+
+	targetClient := knclient.NewMockKnServiceClient(t, "prod-apps")
+	tr := targetClient.Recorder()
+	tr.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	tr.CreateService(func(t *testing.T, a interface{}) {
+		created := a.(*servingv1.Service)
+		assert.Equal(t, created.Namespace, "prod-apps")
+		assert.Equal(t, created.Spec.Template.Name, "foo-v1")
+		assert.Equal(t, created.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar@sha256:deadbeef")
+		assert.Equal(t, created.Spec.Template.Annotations["client.knative.dev/user-image"], "gcr.io/foo/bar:baz")
+	}, nil)
+
+	output, err := executeServiceCloneCommand(client, targetClient, "clone", "foo", "foo", "--target-context", "prod", "--lock-to-digest", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'foo' created in namespace 'prod-apps'"))
+
+	_, err = executeServiceCloneCommand(client, targetClient, "clone", "foo", "foo", "--target-context", "unknown")
+	assert.ErrorContains(t, err, "context 'unknown' not found")
+
+	r.Validate()
+	tr.Validate()
+}
+
+func TestServiceCloneWithRevisions(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := getServiceWithOptions(
+		getService("foo"),
+		withServiceRevisionName("foo-rev-2"),
+		withTrafficSplit([]string{"foo-rev-1", "foo-rev-2"}, []int{50, 50}, []bool{false, false}),
+		withServicePodSpecOption(withContainer()),
+	)
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), getRevisionListWithOptions(
+		withRevisions(
+			withRevisionLabels(map[string]string{apiserving.ServiceLabelKey: "foo"}),
+			withRevisionGeneration("1"),
+			withRevisionName("foo-rev-1"),
+			withRevisionPodSpecOption(withContainer()),
+		),
+		withRevisions(
+			withRevisionLabels(map[string]string{apiserving.ServiceLabelKey: "foo"}),
+			withRevisionGeneration("2"),
+			withRevisionName("foo-rev-2"),
+			withRevisionPodSpecOption(withContainer()),
+		),
+	), nil)
+
+	r.GetService("bar", nil, errors.NewNotFound(servingv1.Resource("service"), "bar"))
+	r.CreateService(func(t *testing.T, a interface{}) {
+		created := a.(*servingv1.Service)
+		assert.Equal(t, created.Name, "bar")
+		assert.Equal(t, created.Spec.Template.Name, "bar-rev-1")
+	}, nil)
+	r.WaitForService("bar", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("bar", getService("bar"), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.Equal(t, updated.Spec.Template.Name, "bar-rev-2")
+		assert.Equal(t, updated.Spec.Traffic[0].RevisionName, "bar-rev-1")
+		assert.Equal(t, updated.Spec.Traffic[1].RevisionName, "bar-rev-2")
+	}, nil)
+	r.WaitForService("bar", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("bar", getServiceWithUrl("bar", "http://bar.example.com"), nil)
+
+	output, err := executeServiceCloneCommand(client, nil, "clone", "foo", "bar", "--with-revisions")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "2 revision(s)", "bar-rev-1", "restoring traffic", "http://bar.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceCloneWithRevisionsAndEnv(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := getServiceWithOptions(
+		getService("foo"),
+		withServiceRevisionName("foo-rev-2"),
+		withTrafficSplit([]string{"foo-rev-1", "foo-rev-2"}, []int{50, 50}, []bool{false, false}),
+		withServicePodSpecOption(withContainer()),
+	)
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), getRevisionListWithOptions(
+		withRevisions(
+			withRevisionLabels(map[string]string{apiserving.ServiceLabelKey: "foo"}),
+			withRevisionGeneration("1"),
+			withRevisionName("foo-rev-1"),
+			withRevisionPodSpecOption(withContainer()),
+		),
+		withRevisions(
+			withRevisionLabels(map[string]string{apiserving.ServiceLabelKey: "foo"}),
+			withRevisionGeneration("2"),
+			withRevisionName("foo-rev-2"),
+			withRevisionPodSpecOption(withContainer()),
+		),
+	), nil)
+
+	r.GetService("bar", nil, errors.NewNotFound(servingv1.Resource("service"), "bar"))
+	r.CreateService(func(t *testing.T, a interface{}) {
+		created := a.(*servingv1.Service)
+		assert.Equal(t, created.Name, "bar")
+		assert.Equal(t, created.Spec.Template.Name, "bar-rev-1")
+	}, nil)
+	r.WaitForService("bar", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("bar", getService("bar"), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		// The changed template replaces the latest revision, also in the traffic split
+		assert.Equal(t, updated.Spec.Template.Name, "bar-v3")
+		assert.DeepEqual(t, updated.Spec.Template.Spec.Containers[0].Env, []corev1.EnvVar{{Name: "KEY", Value: "value"}})
+		assert.Equal(t, updated.Spec.Traffic[0].RevisionName, "bar-rev-1")
+		assert.Equal(t, updated.Spec.Traffic[1].RevisionName, "bar-v3")
+	}, nil)
+	r.WaitForService("bar", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("bar", getServiceWithUrl("bar", "http://bar.example.com"), nil)
+
+	output, err := executeServiceCloneCommand(client, nil, "clone", "foo", "bar", "--with-revisions",
+		"--env", "KEY=value", "--revision-name", "{{.Service}}-v3")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "2 revision(s)", "bar-rev-1", "restoring traffic", "http://bar.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceCloneErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCloneCommand(client, nil, "clone", "foo")
+	assert.ErrorContains(t, err, "requires the names of the source and the target service")
+
+	_, err = executeServiceCloneCommand(client, nil, "clone", "foo", "foo")
+	assert.ErrorContains(t, err, "cannot clone service 'foo' onto itself")
+
+	_, err = executeServiceCloneCommand(client, nil, "clone", "foo", "bar", "--with-revisions", "--no-wait")
+	assert.ErrorContains(t, err, "can't be used with --no-wait")
+
+	r := client.Recorder()
+	r.GetService("foo", getCloneService(), nil)
+	r.GetService("bar", getService("bar"), nil)
+	_, err = executeServiceCloneCommand(client, nil, "clone", "foo", "bar")
+	assert.ErrorContains(t, err, "because the service already exists")
+
+	r.Validate()
+}
+
+func TestClonedRevisionName(t *testing.T) {
+	assert.Equal(t, clonedRevisionName("foo-00001", "foo", "bar"), "bar-00001")
+	assert.Equal(t, clonedRevisionName("foo-bar-00001", "foo", "foo-bar"), "foo-bar-bar-00001")
+	assert.Equal(t, clonedRevisionName("foobar-00001", "foo", "bar"), "foobar-00001")
+	assert.Equal(t, clonedRevisionName("", "foo", "bar"), "")
+	assert.Equal(t, clonedRevisionName("foo-00001", "foo", "foo"), "foo-00001")
+}
+
+// executeServiceCloneCommand executes a command with client used for the source namespace and
+// targetClient used for any other namespace and for the kubeconfig context 'prod'
+func executeServiceCloneCommand(client knclient.KnServingClient, targetClient knclient.KnServingClient, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewServingClient = func(namespace string) (knclient.KnServingClient, error) {
+		if namespace == client.Namespace() {
+			return client, nil
+		}
+		return targetClient, nil
+	}
+	knParams.NewServingClientForContext = func(kubeContext string, namespace string) (knclient.KnServingClient, error) {
+		if kubeContext != "prod" {
+			return nil, fmt.Errorf("context '%s' not found", kubeContext)
+		}
+		return targetClient, nil
+	}
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return knflags.ReconcileBoolFlags(cmd.Flags())
+	}
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func getCloneService() *servingv1.Service {
+	service := getServiceWithOptions(
+		getService("foo"),
+		withAnnotations(map[string]string{"serving.knative.dev/creator": "joe", "team": "payments"}),
+		withServiceRevisionName("foo-v1"),
+		withTrafficSplit([]string{"foo-v1"}, []int{100}, []bool{false}),
+		withServicePodSpecOption(withContainer()),
+	)
+	service.ResourceVersion = "5"
+	service.Status.LatestReadyRevisionName = "foo-v1"
+	return service
+}
@@ -15,7 +15,9 @@
 package service
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -43,6 +45,12 @@ type ConfigurationEditFlags struct {
 	ConcurrencyLimit       int
 	ConcurrencyUtilization int
 	AutoscaleWindow        string
+	AutoscaleClass         string
+	AutoscaleMetric        string
+	AutoscaleTarget        string
+	ScaleInit              int
+	PanicWindow            float64
+	PanicThreshold         float64
 	Labels                 []string
 	LabelsService          []string
 	LabelsRevision         []string
@@ -94,6 +102,32 @@ func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
 	command.Flags().StringVar(&p.AutoscaleWindow, "autoscale-window", "", "Duration to look back for making auto-scaling decisions. The service is scaled to zero if no request was received in during that time. (eg: 10s)")
 	p.markFlagMakesRevision("autoscale-window")
 
+	command.Flags().StringVar(&p.AutoscaleClass, "autoscale-class", "",
+		"Autoscaler implementation to use for scaling the revision. One of kpa|hpa. "+
+			"'kpa' (default) scales on concurrency or rps and supports scaling to zero, 'hpa' scales on cpu.")
+	p.markFlagMakesRevision("autoscale-class")
+
+	command.Flags().StringVar(&p.AutoscaleMetric, "autoscale-metric", "",
+		"Metric to scale on. One of concurrency|rps for the 'kpa' class or cpu for the 'hpa' class. "+
+			"Scaling on memory is not supported by the Knative Serving version kn is built against.")
+	p.markFlagMakesRevision("autoscale-metric")
+
+	command.Flags().StringVar(&p.AutoscaleTarget, "autoscale-target", "",
+		"Target value of the autoscale metric, given as VALUE or METRIC=VALUE (e.g. rps=150 or cpu=80). "+
+			"The target is a number of requests for concurrency and rps and a utilization percentage for cpu.")
+	p.markFlagMakesRevision("autoscale-target")
+
+	command.Flags().IntVar(&p.ScaleInit, "scale-init", 0, "Initial number of replicas with which a service starts. Can be 0 or a positive integer.")
+	p.markFlagMakesRevision("scale-init")
+
+	command.Flags().Float64Var(&p.PanicWindow, "panic-window", 0,
+		"Panic window as percentage of the autoscale window, used for scaling up quickly on traffic bursts. Between 1 and 100 (kpa only).")
+	p.markFlagMakesRevision("panic-window")
+
+	command.Flags().Float64Var(&p.PanicThreshold, "panic-threshold", 0,
+		"Percentage of the target at which the autoscaler enters panic mode. Between 110 and 1000 (kpa only).")
+	p.markFlagMakesRevision("panic-threshold")
+
 	knflags.AddBothBoolFlagsUnhidden(command.Flags(), &p.ClusterLocal, "cluster-local", "", false,
 		"Specify that the service be private. (--no-cluster-local will make the service publicly available)")
 	//TODO: Need to also not change revision when already set (solution to issue #646)
@@ -175,14 +209,42 @@ func (p *ConfigurationEditFlags) Apply(
 	cmd *cobra.Command) error {
 
 	template := &service.Spec.Template
-	if cmd.Flags().Changed("env") {
-		envMap, err := util.MapFromArrayAllowingSingles(p.PodSpecFlags.Env, "=")
+	if cmd.Flags().Changed("sidecar") {
+		sidecarsToUpdate, sidecarsToRemove, err := util.OrderedMapAndRemovalListFromArray(p.PodSpecFlags.Sidecars, "=")
 		if err != nil {
-			return fmt.Errorf("Invalid --env: %w", err)
+			return fmt.Errorf("Invalid --sidecar: %w", err)
+		}
+		err = servinglib.UpdateSidecars(template, sidecarsToUpdate, sidecarsToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	containerName := p.PodSpecFlags.Container
+	if containerName != "" {
+		_, err := servinglib.ContainerOfRevisionTemplateByName(template, containerName)
+		if err != nil {
+			return fmt.Errorf("Invalid --container: %w", err)
+		}
+	}
+
+	if cmd.Flags().Changed("env") || cmd.Flags().Changed("env-file") {
+		envMap := util.StringMap{}
+		for _, envFile := range p.PodSpecFlags.EnvFile {
+			fileEnv, err := util.ReadEnvFile(envFile)
+			if err != nil {
+				return fmt.Errorf("Invalid --env-file: %w", err)
+			}
+			envMap.Merge(fileEnv)
 		}
 
-		envToRemove := util.ParseMinusSuffix(envMap)
-		err = servinglib.UpdateEnvVars(template, envMap, envToRemove)
+		flagEnv, err := util.MapFromArrayAllowingSingles(p.PodSpecFlags.Env, "=")
+		if err != nil {
+			return fmt.Errorf("Invalid --env: %w", err)
+		}
+		envToRemove := util.ParseMinusSuffix(flagEnv)
+		envMap.Merge(flagEnv)
+		err = servinglib.UpdateEnvVars(template, containerName, envMap, envToRemove)
 		if err != nil {
 			return err
 		}
@@ -201,13 +263,13 @@ func (p *ConfigurationEditFlags) Apply(
 			}
 		}
 
-		err := servinglib.UpdateEnvFrom(template, envFromSourceToUpdate, envFromSourceToRemove)
+		err := servinglib.UpdateEnvFrom(template, containerName, envFromSourceToUpdate, envFromSourceToRemove)
 		if err != nil {
 			return err
 		}
 	}
 
-	if cmd.Flags().Changed("mount") || cmd.Flags().Changed("volume") {
+	if cmd.Flags().Changed("mount") || cmd.Flags().Changed("volume") || cmd.Flags().Changed("volume-item") || cmd.Flags().Changed("volume-mode") {
 		mountsToUpdate, mountsToRemove, err := util.OrderedMapAndRemovalListFromArray(p.PodSpecFlags.Mount, "=")
 		if err != nil {
 			return fmt.Errorf("Invalid --mount: %w", err)
@@ -218,9 +280,31 @@ func (p *ConfigurationEditFlags) Apply(
 			return fmt.Errorf("Invalid --volume: %w", err)
 		}
 
-		err = servinglib.UpdateVolumeMountsAndVolumes(template, mountsToUpdate, mountsToRemove, volumesToUpdate, volumesToRemove)
+		if cmd.Flags().Changed("mount") || cmd.Flags().Changed("volume") {
+			err = servinglib.UpdateVolumeMountsAndVolumes(template, containerName, mountsToUpdate, mountsToRemove, volumesToUpdate, volumesToRemove)
+			if err != nil {
+				return err
+			}
+		}
+
+		itemsToUpdate, itemsToRemove, err := util.OrderedMapAndRemovalListFromArray(p.PodSpecFlags.VolumeItem, "=")
 		if err != nil {
-			return err
+			return fmt.Errorf("Invalid --volume-item: %w", err)
+		}
+		err = servinglib.UpdateVolumeItems(template, itemsToUpdate, itemsToRemove)
+		if err != nil {
+			return fmt.Errorf("Invalid --volume-item: %w", err)
+		}
+
+		for _, volumeMode := range p.PodSpecFlags.VolumeMode {
+			names, mode, err := parseVolumeMode(volumeMode, servinglib.ReferencedVolumeNames(mountsToUpdate, volumesToUpdate, itemsToUpdate))
+			if err != nil {
+				return fmt.Errorf("Invalid --volume-mode: %w", err)
+			}
+			err = servinglib.UpdateVolumeDefaultMode(template, names, mode)
+			if err != nil {
+				return fmt.Errorf("Invalid --volume-mode: %w", err)
+			}
 		}
 	}
 
@@ -287,20 +371,20 @@ func (p *ConfigurationEditFlags) Apply(
 		return err
 	}
 
-	err = servinglib.UpdateResources(template, p.PodSpecFlags.Resources.ResourceRequirements, requestsToRemove, limitsToRemove)
+	err = servinglib.UpdateResources(template, containerName, p.PodSpecFlags.Resources.ResourceRequirements, requestsToRemove, limitsToRemove)
 	if err != nil {
 		return err
 	}
 
 	if cmd.Flags().Changed("cmd") {
-		err = servinglib.UpdateContainerCommand(template, p.PodSpecFlags.Command)
+		err = servinglib.UpdateContainerCommand(template, containerName, p.PodSpecFlags.Command)
 		if err != nil {
 			return err
 		}
 	}
 
 	if cmd.Flags().Changed("arg") {
-		err = servinglib.UpdateContainerArg(template, p.PodSpecFlags.Arg)
+		err = servinglib.UpdateContainerArg(template, containerName, p.PodSpecFlags.Arg)
 		if err != nil {
 			return err
 		}
@@ -313,6 +397,20 @@ func (p *ConfigurationEditFlags) Apply(
 		}
 	}
 
+	if cmd.Flags().Changed("probe-readiness") {
+		err = servinglib.UpdateReadinessProbe(template, p.PodSpecFlags.ReadinessProbe)
+		if err != nil {
+			return fmt.Errorf("Invalid --probe-readiness: %w", err)
+		}
+	}
+
+	if cmd.Flags().Changed("probe-liveness") {
+		err = servinglib.UpdateLivenessProbe(template, p.PodSpecFlags.LivenessProbe)
+		if err != nil {
+			return fmt.Errorf("Invalid --probe-liveness: %w", err)
+		}
+	}
+
 	if cmd.Flags().Changed("scale-min") {
 		err = servinglib.UpdateMinScale(template, p.MinScale)
 		if err != nil {
@@ -372,6 +470,10 @@ func (p *ConfigurationEditFlags) Apply(
 		}
 	}
 
+	if err = p.applyAutoscaling(cmd, template); err != nil {
+		return err
+	}
+
 	if cmd.Flags().Changed("cluster-local") || cmd.Flags().Changed("no-cluster-local") {
 		if p.ClusterLocal {
 			labels := servinglib.UpdateLabels(service.ObjectMeta.Labels, map[string]string{serving.VisibilityLabelKey: serving.VisibilityClusterLocal}, []string{})
@@ -430,6 +532,33 @@ func (p *ConfigurationEditFlags) Apply(
 	return nil
 }
 
+// ApplyToConfiguration mutates the given configuration according to the flags in the command.
+// Service specific flags like --label-service and --annotation are applied to the configuration's
+// metadata, --cluster-local is rejected as visibility is a property of a route.
+func (p *ConfigurationEditFlags) ApplyToConfiguration(
+	configuration *servingv1.Configuration,
+	baseRevision *servingv1.Revision,
+	cmd *cobra.Command) error {
+
+	if cmd.Flags().Changed("cluster-local") || cmd.Flags().Changed("no-cluster-local") {
+		return errors.New("--cluster-local can not be used for a configuration, set the visibility on the route instead")
+	}
+	// Wrap into a service to reuse the revision template handling
+	service := &servingv1.Service{
+		ObjectMeta: configuration.ObjectMeta,
+		Spec: servingv1.ServiceSpec{
+			ConfigurationSpec: configuration.Spec,
+		},
+	}
+	err := p.Apply(service, baseRevision, cmd)
+	if err != nil {
+		return err
+	}
+	configuration.ObjectMeta = service.ObjectMeta
+	configuration.Spec = service.Spec.ConfigurationSpec
+	return nil
+}
+
 func (p *ConfigurationEditFlags) updateLabels(obj *metav1.ObjectMeta, flagLabels []string, labelsAllMap map[string]string) error {
 	labelFlagMap, err := util.MapFromArrayAllowingSingles(flagLabels, "=")
 	if err != nil {
@@ -480,3 +609,57 @@ func (p *ConfigurationEditFlags) AnyMutation(cmd *cobra.Command) bool {
 	}
 	return false
 }
+
+// parseVolumeMode parses a volume mode of the form [NAME=]MODE with an octal MODE. Without a name
+// the mode applies to the given default volumes.
+func parseVolumeMode(value string, defaultNames []string) ([]string, int32, error) {
+	names := defaultNames
+	modeString := value
+	if idx := strings.LastIndex(value, "="); idx >= 0 {
+		names = []string{value[:idx]}
+		modeString = value[idx+1:]
+	}
+	mode, err := strconv.ParseInt(modeString, 8, 32)
+	if err != nil || mode < 0 || mode > 0777 {
+		return nil, 0, fmt.Errorf("mode %q must be an octal number between 0000 and 0777", modeString)
+	}
+	if len(names) == 0 || names[0] == "" {
+		return nil, 0, errors.New("a volume name is required when no volume is given with --mount, --volume or --volume-item")
+	}
+	return names, int32(mode), nil
+}
+
+// applyAutoscaling updates the autoscaler class, metric, target, initial scale and panic mode
+// settings and validates that the resulting autoscaling annotations fit together
+func (p *ConfigurationEditFlags) applyAutoscaling(cmd *cobra.Command, template *servingv1.RevisionTemplateSpec) error {
+	flags := cmd.Flags()
+	if flags.Changed("autoscale-target") && flags.Changed("concurrency-target") {
+		return fmt.Errorf("only --autoscale-target or --concurrency-target can be specified")
+	}
+
+	updates := []struct {
+		flag   string
+		update func() error
+	}{
+		{"autoscale-class", func() error { return servinglib.UpdateAutoscaleClass(template, p.AutoscaleClass) }},
+		{"autoscale-metric", func() error { return servinglib.UpdateAutoscaleMetric(template, p.AutoscaleMetric) }},
+		{"autoscale-target", func() error { return servinglib.UpdateAutoscaleTarget(template, p.AutoscaleTarget) }},
+		{"scale-init", func() error { return servinglib.UpdateInitialScale(template, p.ScaleInit) }},
+		{"panic-window", func() error { return servinglib.UpdatePanicWindow(template, p.PanicWindow) }},
+		{"panic-threshold", func() error { return servinglib.UpdatePanicThreshold(template, p.PanicThreshold) }},
+	}
+	changed := false
+	for _, u := range updates {
+		if !flags.Changed(u.flag) {
+			continue
+		}
+		if err := u.update(); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if !changed && !flags.Changed("concurrency-target") {
+		return nil
+	}
+	return servinglib.ValidateAutoscaling(template)
+}
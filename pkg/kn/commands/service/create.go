@@ -70,11 +70,19 @@ var create_example = `
   # Create a service with 250MB memory, 200m CPU requests and a GPU resource limit
   # [https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/]
   # [https://kubernetes.io/docs/tasks/manage-gpus/scheduling-gpus/]
-  kn service create s4gpu --image knativesamples/hellocuda-go --request memory=250Mi,cpu=200m --limit nvidia.com/gpu=1`
+  kn service create s4gpu --image knativesamples/hellocuda-go --request memory=250Mi,cpu=200m --limit nvidia.com/gpu=1
+
+  # Validate a service on the server and show it with all defaults applied, without creating it
+  kn service create s5 --image knativesamples/helloworld --concurrency-limit 100 --dry-run server
+
+  # Print the service as YAML without connecting to a cluster
+  kn service create s6 --image knativesamples/helloworld --env TARGET=v1 --offline -o yaml`
 
 func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	var editFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
+	var dryRunFlags commands.DryRunFlags
+	var offlineFlags commands.OfflineFlags
 
 	serviceCreateCommand := &cobra.Command{
 		Use:     "create NAME --image IMAGE",
@@ -91,11 +99,24 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 			if editFlags.PodSpecFlags.Image == "" && editFlags.Filename == "" {
 				return errors.New("'service create' requires the image name to run provided with the --image option")
 			}
-
-			namespace, err := p.GetNamespace(cmd)
+			err = dryRunFlags.Validate()
 			if err != nil {
 				return err
 			}
+			err = offlineFlags.Validate(cmd)
+			if err != nil {
+				return err
+			}
+
+			var namespace string
+			if offlineFlags.Offline {
+				namespace = offlineFlags.Namespace(cmd)
+			} else {
+				namespace, err = p.GetNamespace(cmd)
+				if err != nil {
+					return err
+				}
+			}
 
 			var service *servingv1.Service
 			if editFlags.Filename == "" {
@@ -106,6 +127,9 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if offlineFlags.Offline {
+				return offlineFlags.PrintObject(cmd.OutOrStdout(), service)
+			}
 
 			client, err := p.NewServingClient(namespace)
 			if err != nil {
@@ -123,7 +147,20 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 						"cannot create service '%s' in namespace '%s' "+
 							"because the service already exists and no --force option was given", service.Name, namespace)
 				}
+				if dryRunFlags.IsServer() {
+					err = prepareAndUpdateService(client, service, dryRunFlags.UpdateOptions()...)
+					if err != nil {
+						return err
+					}
+					return commands.PrintDryRunResult(out, service)
+				}
 				err = replaceService(client, service, waitFlags, out)
+			} else if dryRunFlags.IsServer() {
+				err = client.CreateService(service, dryRunFlags.CreateOptions()...)
+				if err != nil {
+					return err
+				}
+				return commands.PrintDryRunResult(out, service)
 			} else {
 				err = createService(client, service, waitFlags, out)
 			}
@@ -136,6 +173,8 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(serviceCreateCommand.Flags(), false)
 	editFlags.AddCreateFlags(serviceCreateCommand)
 	waitFlags.AddConditionWaitFlags(serviceCreateCommand, commands.WaitDefaultTimeout, "create", "service", "ready")
+	dryRunFlags.Add(serviceCreateCommand)
+	offlineFlags.Add(serviceCreateCommand)
 	return serviceCreateCommand
 }
 
@@ -172,7 +211,7 @@ func waitIfRequested(client clientservingv1.KnServingClient, service *servingv1.
 	return waitForServiceToGetReady(client, service.Name, waitFlags.TimeoutInSeconds, verbDone, out)
 }
 
-func prepareAndUpdateService(client clientservingv1.KnServingClient, service *servingv1.Service) error {
+func prepareAndUpdateService(client clientservingv1.KnServingClient, service *servingv1.Service, opts ...metav1.UpdateOptions) error {
 	var retries = 0
 	for {
 		existingService, err := client.GetService(service.Name)
@@ -201,7 +240,7 @@ func prepareAndUpdateService(client clientservingv1.KnServingClient, service *se
 		}
 
 		service.ResourceVersion = existingService.ResourceVersion
-		err = client.UpdateService(service)
+		err = client.UpdateService(service, opts...)
 		if err != nil {
 			// Retry to update when a resource version conflict exists
 			if apierrors.IsConflict(err) && retries < MaxUpdateRetries {
@@ -276,11 +315,29 @@ func constructService(cmd *cobra.Command, editFlags ConfigurationEditFlags, name
 
 // constructServiceFromFile creates struct from provided file
 func constructServiceFromFile(cmd *cobra.Command, editFlags ConfigurationEditFlags, name, namespace string) (*servingv1.Service, error) {
+	service, err := readServiceFromFile(editFlags.Filename, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply options provided from cmdline
+	err = editFlags.Apply(service, nil, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// readServiceFromFile reads a service from the given YAML or JSON file and reconciles
+// its name with the name given on the command line
+func readServiceFromFile(filename, name, namespace string) (*servingv1.Service, error) {
 	var service servingv1.Service
-	file, err := os.Open(editFlags.Filename)
+	file, err := os.Open(filename)
 	if err != nil {
 		return nil, err
 	}
+	defer file.Close()
 	decoder := yaml.NewYAMLOrJSONDecoder(file, 512)
 
 	err = decoder.Decode(&service)
@@ -300,13 +357,10 @@ func constructServiceFromFile(cmd *cobra.Command, editFlags ConfigurationEditFla
 		return nil, fmt.Errorf("no service name provided in command parameter or file")
 	}
 
-	// Set namespace in case it's specified as --namespace
-	service.ObjectMeta.Namespace = namespace
-
-	// Apply options provided from cmdline
-	err = editFlags.Apply(&service, nil, cmd)
-	if err != nil {
-		return nil, err
+	// Set namespace in case it's specified as --namespace (or taken from the current context).
+	// It's only empty when creating the service offline without --namespace.
+	if namespace != "" {
+		service.ObjectMeta.Namespace = namespace
 	}
 
 	return &service, nil
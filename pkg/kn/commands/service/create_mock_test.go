@@ -15,6 +15,8 @@
 package service
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/kn/commands"
 	servinglib "knative.dev/client/pkg/serving"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util/mock"
@@ -308,6 +311,58 @@ func TestServiceCreateWithMountConfigMap(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceCreateWithMountConfigMapItems(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+
+	service := getService("foo")
+	template := &service.Spec.Template
+	template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: servinglib.GenerateVolumeName("/etc/cfg"),
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "app-config",
+					},
+					Items:       []corev1.KeyToPath{{Key: "settings.json", Path: "config.json"}},
+					DefaultMode: ptr.Int32(0440),
+				},
+			},
+		},
+	}
+
+	template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+		{
+			Name:      servinglib.GenerateVolumeName("/etc/cfg"),
+			MountPath: "/etc/cfg",
+			ReadOnly:  true,
+		},
+	}
+
+	template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+	template.Annotations = map[string]string{servinglib.UserImageAnnotationKey: "gcr.io/foo/bar:baz"}
+	r.CreateService(service, nil)
+
+	output, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--mount", "/etc/cfg=cm:app-config", "--volume-item", "app-config:settings.json=config.json",
+		"--volume-mode", "0440", "--no-wait", "--revision-name=")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "created", "foo", "default"))
+
+	_, err = executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--volume-item", "app-config:settings.json=config.json", "--no-wait")
+	assert.ErrorContains(t, err, "there is no ConfigMap or Secret volume \"app-config\"")
+
+	_, err = executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--mount", "/etc/cfg=cm:app-config", "--volume-mode", "0999", "--no-wait")
+	assert.ErrorContains(t, err, "must be an octal number")
+
+	r.Validate()
+}
+
 func TestServiceCreateWithVolumeAndMountSecret(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 
@@ -519,3 +574,87 @@ func getServiceWithUrl(name string, urlName string) *servingv1.Service {
 	service.Name = name
 	return &service
 }
+
+func TestServiceCreateDryRunMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	// Simulate the defaulting done by the server
+	r.CreateService(simulateServerDefaulting, nil)
+
+	output, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--dry-run", "server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "kind: Service", "name: foo", "image: gcr.io/foo/bar:baz", "timeoutSeconds: 300"))
+	assert.Assert(t, util.ContainsNone(output, "Creating", "created"))
+
+	r.Validate()
+}
+
+func TestServiceCreateDryRunForceMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", getService("foo"), nil)
+	r.GetService("foo", getService("foo"), nil)
+	r.UpdateService(simulateServerDefaulting, nil)
+
+	output, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--force", "--dry-run", "server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "kind: Service", "name: foo", "timeoutSeconds: 300"))
+	assert.Assert(t, util.ContainsNone(output, "Replacing", "replaced"))
+
+	r.Validate()
+}
+
+func TestServiceCreateDryRunInvalidMode(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--dry-run", "client")
+	assert.ErrorContains(t, err, "invalid value 'client' for --dry-run")
+}
+
+// simulateServerDefaulting modifies the given service like the API server
+// would do for a dry-run request
+func simulateServerDefaulting(t *testing.T, a interface{}) {
+	service := a.(*servingv1.Service)
+	service.TypeMeta = metav1.TypeMeta{Kind: "Service", APIVersion: servingv1.SchemeGroupVersion.String()}
+	service.Spec.Template.Spec.TimeoutSeconds = ptr.Int64(300)
+}
+
+func TestServiceCreateOffline(t *testing.T) {
+	// Neither a kubeconfig nor a client must be used
+	knParams := &commands.KnParams{KubeCfgPath: "/non/existing/kubeconfig"}
+	knParams.NewServingClient = func(namespace string) (knclient.KnServingClient, error) {
+		return nil, fmt.Errorf("no client expected")
+	}
+	output := new(bytes.Buffer)
+	cmd := NewServiceCommand(knParams)
+	cmd.SetOutput(output)
+	cmd.SetArgs([]string{"create", "foo", "--image", "gcr.io/foo/bar:baz", "--env", "TARGET=v1", "--offline", "-o", "yaml"})
+
+	err := cmd.Execute()
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output.String(), "apiVersion: serving.knative.dev/v1", "kind: Service", "name: foo", "image: gcr.io/foo/bar:baz", "value: v1"))
+	assert.Assert(t, util.ContainsNone(output.String(), "namespace:"))
+
+	cmd = NewServiceCommand(knParams)
+	cmd.SetOutput(output)
+	cmd.SetArgs([]string{"create", "foo", "--image", "gcr.io/foo/bar:baz", "--offline", "-o", "json", "-n", "bar", "--dry-run", "none"})
+	err = cmd.Execute()
+	assert.ErrorContains(t, err, "--offline and --dry-run")
+}
+
+func TestServiceCreateOfflineOptions(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	output, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--offline", "-o", "json", "-n", "bar")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `"kind": "Service"`, `"namespace": "bar"`))
+
+	_, err = executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "-o", "yaml")
+	assert.ErrorContains(t, err, "--output can only be used together with --offline")
+
+	// No API calls have been recorded and none must be done
+	client.Recorder().Validate()
+}
@@ -547,6 +547,54 @@ func TestServiceCreateMaxMinScale(t *testing.T) {
 	}
 }
 
+func TestServiceCreateAutoscaling(t *testing.T) {
+	action, created, _, err := fakeServiceCreate([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--autoscale-class", "hpa", "--autoscale-target", "cpu=80", "--scale-init", "2",
+		"--no-wait"}, false)
+	assert.NilError(t, err)
+	assert.Assert(t, action.Matches("create", "services"))
+
+	annos := created.Spec.Template.Annotations
+	assert.Equal(t, annos["autoscaling.knative.dev/class"], "hpa.autoscaling.knative.dev")
+	assert.Equal(t, annos["autoscaling.knative.dev/metric"], "cpu")
+	assert.Equal(t, annos["autoscaling.knative.dev/target"], "80")
+	assert.Equal(t, annos["autoscaling.knative.dev/initialScale"], "2")
+
+	action, created, _, err = fakeServiceCreate([]string{
+		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--autoscale-metric", "rps", "--autoscale-target", "150",
+		"--panic-window", "10", "--panic-threshold", "200",
+		"--no-wait"}, false)
+	assert.NilError(t, err)
+	assert.Assert(t, action.Matches("create", "services"))
+
+	annos = created.Spec.Template.Annotations
+	assert.Equal(t, annos["autoscaling.knative.dev/metric"], "rps")
+	assert.Equal(t, annos["autoscaling.knative.dev/target"], "150")
+	assert.Equal(t, annos["autoscaling.knative.dev/panicWindowPercentage"], "10")
+	assert.Equal(t, annos["autoscaling.knative.dev/panicThresholdPercentage"], "200")
+}
+
+func TestServiceCreateAutoscalingInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		err  string
+	}{
+		{[]string{"--autoscale-class", "hpa", "--autoscale-metric", "rps"}, "invalid value: rps: autoscaling.knative.dev/metric"},
+		{[]string{"--autoscale-target", "cpu=80"}, "invalid value: cpu: autoscaling.knative.dev/metric"},
+		{[]string{"--autoscale-class", "hpa", "--autoscale-metric", "memory"}, "invalid autoscale metric 'memory'"},
+		{[]string{"--autoscale-class", "hpa", "--autoscale-target", "cpu=150"}, "utilization percentage"},
+		{[]string{"--autoscale-class", "hpa", "--autoscale-target", "cpu=80", "--panic-window", "10"}, "panic mode"},
+		{[]string{"--autoscale-target", "100", "--concurrency-target", "10"}, "only --autoscale-target or --concurrency-target"},
+		{[]string{"--autoscale-class", "vpa"}, "invalid autoscale class"},
+	} {
+		args := append([]string{"service", "create", "foo", "--image", "gcr.io/foo/bar:baz", "--no-wait"}, tc.args...)
+		_, _, _, err := fakeServiceCreate(args, false)
+		assert.ErrorContains(t, err, tc.err)
+	}
+}
+
 func TestServiceCreateScale(t *testing.T) {
 	action, created, _, err := fakeServiceCreate([]string{
 		"service", "create", "foo", "--image", "gcr.io/foo/bar:baz",
@@ -1023,6 +1071,29 @@ func TestServiceCreateFromYAMLWithOverride(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, actualEnvVar, expectedEnvVars)
 
+	// Env vars from a file and from references, --env takes precedence
+	envFile := filepath.Join(tempDir, ".env")
+	err = ioutil.WriteFile(envFile, []byte("# settings\nTARGET=from-file\nLEVEL=debug\n"), os.FileMode(0666))
+	assert.NilError(t, err)
+	expectedEnvVars = map[string]string{
+		"TARGET":   "Go Sample v1",
+		"LEVEL":    "info",
+		"PASSWORD": "secret:db:password"}
+	action, created, _, err = fakeServiceCreate([]string{
+		"service", "create", "foo", "--filename", tempFile, "--env-file", envFile, "--env", "TARGET=Go Sample v1",
+		"--env", "LEVEL=info", "--env", "PASSWORD=secret:db:password"}, false)
+	assert.NilError(t, err)
+	assert.Assert(t, action.Matches("create", "services"))
+
+	actualEnvVar, err = servinglib.EnvToMap(created.Spec.Template.Spec.GetContainer().Env)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, actualEnvVar, expectedEnvVars)
+	assert.Equal(t, created.Spec.Template.Spec.GetContainer().Env[1].ValueFrom.SecretKeyRef.Key, "password")
+
+	_, _, _, err = fakeServiceCreate([]string{
+		"service", "create", "foo", "--filename", tempFile, "--env-file", filepath.Join(tempDir, "missing")}, false)
+	assert.ErrorContains(t, err, "Invalid --env-file")
+
 	// Multiple edit flags
 	expectedAnnotations := map[string]string{
 		"foo": "bar"}
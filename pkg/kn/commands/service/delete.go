@@ -23,12 +23,16 @@ import (
 	"github.com/spf13/cobra"
 
 	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
 )
 
 // NewServiceDeleteCommand represent 'service delete' command
 func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 	var waitFlags commands.WaitFlags
+	var dryRunFlags commands.DryRunFlags
+	selectorFilter := flags.LabelSelectorFilter{}
 
 	serviceDeleteCommand := &cobra.Command{
 		Use:   "delete NAME [NAME ...]",
@@ -41,7 +45,13 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
   kn service delete svc2 -n ns1
 
   # Delete all services in 'ns1' namespace
-  kn service delete --all -n ns1`,
+  kn service delete --all -n ns1
+
+  # Delete all services with the label 'team=payments'
+  kn service delete -l team=payments
+
+  # Check whether service 'svc1' could be deleted, without deleting it
+  kn service delete svc1 --dry-run server`,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			all, err := cmd.Flags().GetBool("all")
@@ -49,8 +59,9 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 			argsLen := len(args)
+			withSelector := selectorFilter.Selector != ""
 
-			if argsLen < 1 && !all {
+			if argsLen < 1 && !all && !withSelector {
 				return errors.New("'service delete' requires the service name(s)")
 			}
 
@@ -58,6 +69,14 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 				return errors.New("'service delete' with --all flag requires no arguments")
 			}
 
+			if argsLen > 0 && withSelector {
+				return errors.New("'service delete' with --selector flag requires no arguments")
+			}
+			err = dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
+
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
 				return err
@@ -67,8 +86,12 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			if all {
-				args, err = getServiceNames(client)
+			if all || withSelector {
+				var listConfigs []util.ListConfig
+				if withSelector {
+					listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
+				}
+				args, err = getServiceNames(client, listConfigs...)
 				if err != nil {
 					return err
 				}
@@ -84,9 +107,11 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 				if waitFlags.Wait {
 					timeout = time.Duration(waitFlags.TimeoutInSeconds) * time.Second
 				}
-				err = client.DeleteService(name, timeout)
+				err = client.DeleteService(name, timeout, dryRunFlags.DeleteOptions()...)
 				if err != nil {
 					errs = append(errs, err.Error())
+				} else if dryRunFlags.IsServer() {
+					fmt.Fprintf(cmd.OutOrStdout(), "Service '%s' would be deleted in namespace '%s' (server dry run).\n", name, namespace)
 				} else {
 					fmt.Fprintf(cmd.OutOrStdout(), "Service '%s' successfully deleted in namespace '%s'.\n", name, namespace)
 				}
@@ -101,11 +126,13 @@ func NewServiceDeleteCommand(p *commands.KnParams) *cobra.Command {
 	flags.Bool("all", false, "Delete all services in a namespace.")
 	commands.AddNamespaceFlags(serviceDeleteCommand.Flags(), false)
 	waitFlags.AddConditionWaitFlags(serviceDeleteCommand, commands.WaitDefaultTimeout, "delete", "service", "deleted")
+	dryRunFlags.Add(serviceDeleteCommand)
+	selectorFilter.Add(serviceDeleteCommand, "services")
 	return serviceDeleteCommand
 }
 
-func getServiceNames(client clientservingv1.KnServingClient) ([]string, error) {
-	serviceList, err := client.ListServices()
+func getServiceNames(client clientservingv1.KnServingClient, config ...util.ListConfig) ([]string, error) {
+	serviceList, err := client.ListServices(config...)
 	if err != nil {
 		return []string{}, err
 	}
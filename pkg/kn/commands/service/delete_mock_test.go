@@ -112,6 +112,35 @@ func TestServiceDeleteAllErrorFromArgMock(t *testing.T) {
 	assert.Error(t, err, "'service delete' with --all flag requires no arguments")
 }
 
+func TestServiceDeleteSelectorMock(t *testing.T) {
+	// New mock client
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	// Recording:
+	r := client.Recorder()
+
+	service1 := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	service2 := createMockServiceWithParams("bar", "default", "http://bar.default.example.com", "bar-xyz")
+	serviceList := &servingv1.ServiceList{Items: []servingv1.Service{*service1, *service2}}
+	r.ListServices(mock.Any(), serviceList, nil)
+	r.DeleteService("foo", mock.Any(), nil)
+	r.DeleteService("bar", mock.Any(), nil)
+
+	output, err := executeServiceCommand(client, "delete", "-l", "team=payments")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "deleted", "foo", "bar", "default"))
+
+	r.Validate()
+}
+
+func TestServiceDeleteSelectorErrorFromArgMock(t *testing.T) {
+	// New mock client
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "delete", "foo", "--selector", "team=payments")
+	assert.Error(t, err, "'service delete' with --selector flag requires no arguments")
+}
+
 func TestServiceDeleteAllNoServicesMock(t *testing.T) {
 	// New mock client
 	client := clientservingv1.NewMockKnServiceClient(t)
@@ -162,3 +191,16 @@ func TestServiceDeleteCheckErrorForNotFoundServicesMock(t *testing.T) {
 
 	r.Validate()
 }
+
+func TestServiceDeleteDryRunMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.DeleteService("foo", mock.Any(), nil)
+
+	output, err := executeServiceCommand(client, "delete", "foo", "--dry-run", "server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "would be deleted", "foo", "default", "dry run"))
+
+	r.Validate()
+}
@@ -96,6 +96,9 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return errors.New("'service describe' requires the service name given as single argument")
 			}
 			serviceName := args[0]
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents && machineReadablePrintFlags.OutputFlagSpecified() {
+				return errors.New("'service describe' can't show events with --output")
+			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -136,12 +139,26 @@ func NewServiceDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			return describe(cmd.OutOrStdout(), service, revisionDescs, printDetails)
+			out := cmd.OutOrStdout()
+			err = describe(out, service, revisionDescs, printDetails)
+			if err != nil {
+				return err
+			}
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents {
+				objects, err := serviceEventObjects(client, service)
+				if err != nil {
+					return err
+				}
+				return commands.PrintEvents(p, out, namespace, objects)
+			}
+			return nil
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.Bool("events", false, "Show the Kubernetes events of the service and of all objects created for it, "+
+		"like its configuration, route, revisions and their pods.")
 	machineReadablePrintFlags.AddFlags(command)
 	command.Flag("output").Usage = fmt.Sprintf("Output format. One of: %s.", strings.Join(append(machineReadablePrintFlags.AllowedFormats(), "url"), "|"))
 	return command
@@ -216,11 +233,13 @@ func writeRevisions(dw printers.PrefixWriter, revisions []*revisionDesc, printDe
 		revision.WriteImage(section, revisionDesc.revision)
 		if printDetails {
 			revision.WritePort(section, revisionDesc.revision)
+			revision.WriteProbes(section, revisionDesc.revision)
 			revision.WriteEnv(section, revisionDesc.revision, printDetails)
 			revision.WriteEnvFrom(section, revisionDesc.revision, printDetails)
 			revision.WriteScale(section, revisionDesc.revision)
 			revision.WriteConcurrencyOptions(section, revisionDesc.revision)
 			revision.WriteResources(section, revisionDesc.revision)
+			revision.WriteSidecars(section, revisionDesc.revision, printDetails)
 		}
 	}
 }
@@ -397,3 +416,22 @@ func extractRevisionFromTarget(client clientservingv1.KnServingClient, target se
 func extractURL(service *servingv1.Service) string {
 	return service.Status.URL.String()
 }
+
+// serviceEventObjects returns the service and its configuration, route and revisions together
+// with the objects created for running the revisions
+func serviceEventObjects(client clientservingv1.KnServingClient, service *servingv1.Service) ([]commands.EventObject, error) {
+	objects := []commands.EventObject{
+		{Kind: "Service", Name: service.Name},
+		{Kind: "Configuration", Name: service.Name},
+		{Kind: "Route", Name: service.Name},
+		{Kind: "Ingress", Name: service.Name},
+	}
+	revisions, err := client.ListRevisions(clientservingv1.WithService(service.Name))
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions.Items {
+		objects = append(objects, commands.RevisionEventObjects(revision.Name)...)
+	}
+	return objects, nil
+}
@@ -15,6 +15,7 @@
 package service
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 	"strings"
@@ -32,9 +33,12 @@ import (
 	api_serving "knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/kn/commands"
 	client_serving "knative.dev/client/pkg/serving"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
 	"knative.dev/pkg/ptr"
 )
 
@@ -75,6 +79,88 @@ func TestServiceDescribeBasic(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceDescribeEvents(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	coreClient := clientcorev1.NewMockKnCoreClient(t)
+
+	r := client.Recorder()
+	expectedService := createTestService("foo", []string{"rev1"}, goodConditions())
+	r.GetService("foo", &expectedService, nil)
+	rev1 := createTestRevision("rev1", 1, goodConditions())
+	r.GetRevision("rev1", &rev1, nil)
+	rev2 := createTestRevision("rev2", 2, goodConditions())
+	r.ListRevisions(mock.Any(), &servingv1.RevisionList{Items: []servingv1.Revision{rev1, rev2}}, nil)
+
+	now := time.Now()
+	cr := coreClient.Recorder()
+	events := map[string][]v1.Event{
+		"involvedObject.kind=Configuration,involvedObject.name=foo": {
+			{InvolvedObject: v1.ObjectReference{Kind: "Configuration", Name: "foo"}, Type: v1.EventTypeNormal,
+				Reason: "Created", Message: "Created Revision rev2", LastTimestamp: metav1.NewTime(now.Add(-2 * time.Minute))},
+		},
+		"involvedObject.kind=Pod": {
+			{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "rev2-deployment-abc-xyz"}, Type: v1.EventTypeWarning,
+				Reason: "Failed", Message: "Back-off pulling image", Count: 3, LastTimestamp: metav1.NewTime(now.Add(-1 * time.Minute))},
+			{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "other-deployment-abc-xyz"}, Type: v1.EventTypeNormal,
+				Reason: "Pulled", Message: "Not related"},
+		},
+	}
+	// Events of pods and replica sets are listed only once for all revisions
+	for _, selector := range []string{
+		"involvedObject.kind=Service,involvedObject.name=foo",
+		"involvedObject.kind=Configuration,involvedObject.name=foo",
+		"involvedObject.kind=Route,involvedObject.name=foo",
+		"involvedObject.kind=Ingress,involvedObject.name=foo",
+		"involvedObject.kind=Revision,involvedObject.name=rev1",
+		"involvedObject.kind=PodAutoscaler,involvedObject.name=rev1",
+		"involvedObject.kind=Deployment,involvedObject.name=rev1-deployment",
+		"involvedObject.kind=ReplicaSet",
+		"involvedObject.kind=Pod",
+		"involvedObject.kind=Revision,involvedObject.name=rev2",
+		"involvedObject.kind=PodAutoscaler,involvedObject.name=rev2",
+		"involvedObject.kind=Deployment,involvedObject.name=rev2-deployment",
+	} {
+		cr.ListEvents(selector, &v1.EventList{Items: events[selector]}, nil)
+	}
+
+	output, err := executeServiceDescribeCommand(client, coreClient, "foo", "--events")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Events:", "Configuration/foo", "Created Revision rev2",
+		"Pod/rev2-deployment-abc-xyz", "Back-off pulling image (x3)"))
+	assert.Assert(t, util.ContainsNone(output, "Not related"))
+	assert.Assert(t, strings.Index(output, "Created Revision rev2") < strings.Index(output, "Back-off pulling image"))
+
+	r.Validate()
+	cr.Validate()
+}
+
+func TestServiceDescribeEventsWithOutput(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	coreClient := clientcorev1.NewMockKnCoreClient(t)
+
+	_, err := executeServiceDescribeCommand(client, coreClient, "foo", "--events", "-o", "yaml")
+	assert.ErrorContains(t, err, "can't show events with --output")
+}
+
+func executeServiceDescribeCommand(client knclient.KnServingClient, coreClient clientcorev1.KnCoreClient, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewServingClient = func(namespace string) (knclient.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewCoreClient = func(namespace string) (clientcorev1.KnCoreClient, error) {
+		return coreClient, nil
+	}
+	cmd := NewServiceDescribeCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
 func TestServiceDescribeWithMultipleNames(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 	r := client.Recorder()
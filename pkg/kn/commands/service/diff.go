@@ -0,0 +1,195 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/kn/traffic"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+var diffExample = `
+  # Show the changes which 'kn service update' would apply to service 'svc'
+  kn service diff svc --image knativesamples/helloworld --env TARGET=v2
+
+  # Show the changes which 'kn service apply' would apply to service 'svc'
+  kn service diff svc -f svc.yaml
+
+  # Show the changes without colors
+  kn service diff svc --traffic @latest=100 --color never`
+
+// NewServiceDiffCommand returns a new command for showing the changes of an update to a service
+func NewServiceDiffCommand(p *commands.KnParams) *cobra.Command {
+	var editFlags ConfigurationEditFlags
+	var trafficFlags flags.Traffic
+	var filename string
+	var color string
+
+	serviceDiffCommand := &cobra.Command{
+		Use:   "diff NAME [-f FILENAME | update flags]",
+		Short: "Show the changes of an update to a service",
+		Long: `Show the changes of an update to a service as unified diff, without updating the service.
+
+The changes are either given with the same flags as for 'kn service update' or as a file which is
+merged into the live service in the same way as with 'kn service apply'.`,
+		Example: diffExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service diff' requires the service name given as single argument")
+			}
+			if filename != "" && (editFlags.AnyMutation(cmd) || trafficFlags.Changed(cmd)) {
+				return errors.New("'service diff' accepts either --filename or update flags, but not both")
+			}
+			colored, err := useColors(color, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			live, err := client.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			var desired *servingv1.Service
+			var newRevision bool
+			if filename != "" {
+				desired, err = desiredServiceFromFile(live, filename, name, namespace)
+				if err == nil {
+					newRevision = !equality.Semantic.DeepEqual(live.Spec.Template, desired.Spec.Template)
+				}
+			} else {
+				desired, err = desiredServiceFromFlags(client, live, &editFlags, &trafficFlags, cmd)
+				newRevision = editFlags.AnyMutation(cmd)
+			}
+			if err != nil {
+				return err
+			}
+
+			return printServiceDiff(cmd.OutOrStdout(), namespace, live, desired, newRevision, colored)
+		},
+	}
+	commands.AddNamespaceFlags(serviceDiffCommand.Flags(), false)
+	editFlags.AddUpdateFlags(serviceDiffCommand)
+	trafficFlags.Add(serviceDiffCommand)
+	serviceDiffCommand.Flags().StringVarP(&filename, "filename", "f", "", "Compare with the service given in a file, as it would be applied with 'kn service apply'.")
+	serviceDiffCommand.MarkFlagFilename("filename")
+	serviceDiffCommand.Flags().StringVar(&color, "color", "auto", "When to colorize the diff. One of auto|always|never.")
+	return serviceDiffCommand
+}
+
+// desiredServiceFromFile merges the service from the file into the live service
+// like 'kn service apply' does
+func desiredServiceFromFile(live *servingv1.Service, filename, name, namespace string) (*servingv1.Service, error) {
+	service, err := readServiceFromFile(filename, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	err = setLastAppliedConfiguration(service)
+	if err != nil {
+		return nil, err
+	}
+	return mergeAppliedService(live, service)
+}
+
+// desiredServiceFromFlags applies the update flags on a copy of the live service
+// like 'kn service update' does
+func desiredServiceFromFlags(client clientservingv1.KnServingClient, live *servingv1.Service, editFlags *ConfigurationEditFlags, trafficFlags *flags.Traffic, cmd *cobra.Command) (*servingv1.Service, error) {
+	desired := live.DeepCopy()
+	var baseRevision *servingv1.Revision
+	if !cmd.Flags().Changed("image") && editFlags.LockToDigest && editFlags.AnyMutation(cmd) {
+		var err error
+		baseRevision, err = client.GetBaseRevision(desired)
+		if _, ok := err.(*clientservingv1.NoBaseRevisionError); ok {
+			fmt.Fprintf(cmd.OutOrStdout(), "Warning: No revision found to update image digest\n")
+		}
+	}
+	err := editFlags.Apply(desired, baseRevision, cmd)
+	if err != nil {
+		return nil, err
+	}
+	if trafficFlags.Changed(cmd) {
+		traffic, err := traffic.Compute(cmd, desired.Spec.Traffic, trafficFlags, desired.Name)
+		if err != nil {
+			return nil, err
+		}
+		desired.Spec.Traffic = traffic
+	}
+	return desired, nil
+}
+
+func printServiceDiff(out io.Writer, namespace string, live *servingv1.Service, desired *servingv1.Service, newRevision bool, colored bool) error {
+	liveYAML, err := yaml.Marshal(exportLatestService(live.DeepCopy(), true))
+	if err != nil {
+		return err
+	}
+	desiredYAML, err := yaml.Marshal(exportLatestService(desired.DeepCopy(), true))
+	if err != nil {
+		return err
+	}
+
+	diff := util.UnifiedDiff("live/"+live.Name, "updated/"+live.Name, string(liveYAML), string(desiredYAML), colored)
+	if diff == "" {
+		fmt.Fprintf(out, "No changes for service '%s' in namespace '%s'.\n", live.Name, namespace)
+		return nil
+	}
+	fmt.Fprint(out, diff)
+	fmt.Fprintln(out, "")
+	if newRevision {
+		fmt.Fprintf(out, "Service '%s' would be updated and a new revision would be created.\n", live.Name)
+	} else {
+		fmt.Fprintf(out, "Service '%s' would be updated without creating a new revision.\n", live.Name)
+	}
+	return nil
+}
+
+// useColors decides whether to colorize the output depending on the given
+// mode and whether the output is a terminal
+func useColors(mode string, out io.Writer) (bool, error) {
+	switch mode {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		file, ok := out.(*os.File)
+		return ok && terminal.IsTerminal(int(file.Fd())), nil
+	default:
+		return false, fmt.Errorf("invalid value '%s' for --color, please specify one of auto|always|never", mode)
+	}
+}
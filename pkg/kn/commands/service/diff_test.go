@@ -0,0 +1,98 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceDiffError(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "diff")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	_, err = executeServiceCommand(client, "diff", "foo", "-f", "foo.yaml", "--env", "a=b")
+	assert.ErrorContains(t, err, "either --filename or update flags")
+
+	_, err = executeServiceCommand(client, "diff", "foo", "--color", "sometimes")
+	assert.ErrorContains(t, err, "invalid value 'sometimes' for --color")
+}
+
+func TestServiceDiffFlags(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	live := getApplyService("gcr.io/foo/bar:v1", corev1.EnvVar{Name: "a", Value: "mouse"})
+	r.GetService("foo", live, nil)
+
+	output, err := executeServiceCommand(client, "diff", "foo", "--env", "a=cat", "--revision-name=", "--no-lock-to-digest", "--color", "never")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "--- live/foo", "+++ updated/foo", "-          value: mouse", "+          value: cat", "a new revision would be created"))
+	r.Validate()
+}
+
+func TestServiceDiffTrafficOnly(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	live := getApplyService("gcr.io/foo/bar:v1")
+	live.Status.LatestReadyRevisionName = "foo-v1"
+	r.GetService("foo", live, nil)
+
+	output, err := executeServiceCommand(client, "diff", "foo", "--tag", "@latest=current")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "+    tag: current", "without creating a new revision"))
+	assert.Assert(t, util.ContainsNone(output, "\x1b["))
+	r.Validate()
+}
+
+func TestServiceDiffNoChanges(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", getApplyService("gcr.io/foo/bar:v1"), nil)
+
+	output, err := executeServiceCommand(client, "diff", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No changes", "foo"))
+	r.Validate()
+}
+
+func TestServiceDiffFile(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	live := getApplyService("gcr.io/foo/bar:v1", corev1.EnvVar{Name: "a", Value: "mouse"})
+	assert.NilError(t, setLastAppliedConfiguration(live))
+	r.GetService("foo", live, nil)
+
+	svcFile := writeTempYAMLFile(t, getApplyService("gcr.io/foo/bar:v2", corev1.EnvVar{Name: "a", Value: "mouse"}))
+	defer os.RemoveAll(filepath.Dir(svcFile))
+
+	output, err := executeServiceCommand(client, "diff", "foo", "-f", svcFile, "--color", "always")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "image: gcr.io/foo/bar:v1\x1b[0m", "image: gcr.io/foo/bar:v2\x1b[0m", "a new revision would be created"))
+	assert.Assert(t, util.ContainsNone(output, "last-applied-configuration"))
+	r.Validate()
+}
@@ -0,0 +1,116 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var editExample = `
+  # Edit the service 'svc' in the editor given with $KUBE_EDITOR or $EDITOR
+  kn service edit svc
+
+  # Edit the service 'svc' in Visual Studio Code and don't wait for it to become ready
+  KUBE_EDITOR="code --wait" kn service edit svc --no-wait`
+
+// NewServiceEditCommand returns a new command for editing a service in an editor
+func NewServiceEditCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+
+	serviceEditCommand := &cobra.Command{
+		Use:   "edit NAME",
+		Short: "Edit a service in an editor",
+		Long: `Edit a service in an editor.
+
+The service is opened as YAML in the editor given with the KUBE_EDITOR or EDITOR environment
+variable, falling back to 'vi'. Its status and runtime metadata are left out like with
+'kn service export'. The edited service is validated before the service gets updated. If the
+validation or the update fails, the editor is reopened with the error shown on top of the file.`,
+		Example: editExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service edit' requires the service name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			changed, err := commands.EditInEditor(cmd, "service", name, exportLatestService(service.DeepCopy(), true), func(edited []byte) error {
+				return updateEditedService(client, name, edited)
+			})
+			if err != nil || !changed {
+				return err
+			}
+			return waitIfRequested(client, service, waitFlags, "Updating", "updated", cmd.OutOrStdout())
+		},
+	}
+	commands.AddNamespaceFlags(serviceEditCommand.Flags(), false)
+	waitFlags.AddConditionWaitFlags(serviceEditCommand, commands.WaitDefaultTimeout, "edit", "service", "ready")
+	return serviceEditCommand
+}
+
+// updateEditedService validates the service given as edited YAML and updates the service with it
+func updateEditedService(client clientservingv1.KnServingClient, name string, edited []byte) error {
+	editedService := &servingv1.Service{}
+	err := commands.ReadEdited(edited, "service", name, client.Namespace(), editedService)
+	if err != nil {
+		return err
+	}
+
+	return client.UpdateServiceWithRetry(name, func(current *servingv1.Service) (*servingv1.Service, error) {
+		return mergeEditedService(current, editedService), nil
+	}, MaxUpdateRetries)
+}
+
+// mergeEditedService takes over the labels, annotations and spec of the edited service.
+// The annotations which have been left out for editing are kept.
+func mergeEditedService(current *servingv1.Service, edited *servingv1.Service) *servingv1.Service {
+	annotations := map[string]string{}
+	for key, value := range edited.Annotations {
+		annotations[key] = value
+	}
+	for _, key := range IGNORED_SERVICE_ANNOTATIONS {
+		if value, ok := current.Annotations[key]; ok {
+			annotations[key] = value
+		}
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+
+	current.Labels = edited.Labels
+	current.Annotations = annotations
+	current.Spec = edited.Spec
+	return current
+}
@@ -0,0 +1,125 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceEdit(t *testing.T) {
+	editor, err := commands.NewTestEditor(`s|gcr.io/foo/bar:v1|gcr.io/foo/bar:v2|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getEditService(), nil)
+	r.GetService("foo", getEditService(), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+		assert.DeepEqual(t, updated.Annotations, map[string]string{"serving.knative.dev/creator": "joe", "team": "payments"})
+		assert.Equal(t, updated.ResourceVersion, "5")
+	}, nil)
+
+	output, err := executeServiceCommand(client, "edit", "foo", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'foo' updated in namespace"))
+	assert.Assert(t, util.ContainsAll(editor.Input(1), "# Please edit the service below", "name: foo", "team: payments", "image: gcr.io/foo/bar:v1"))
+	assert.Assert(t, util.ContainsNone(editor.Input(1), "creator", "resourceVersion", "foo-rev1"))
+
+	r.Validate()
+}
+
+func TestServiceEditReopenOnError(t *testing.T) {
+	editor, err := commands.NewTestEditor(
+		`s|image: gcr.io/foo/bar:v1|image: ""|`,
+		`s|image: ""|image: gcr.io/foo/bar:v2|`,
+		`s|gcr.io/foo/bar:v2|gcr.io/foo/bar:v3|`,
+	)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getEditService(), nil)
+	// The invalid image is detected locally, the rejected update is retried with the next edit
+	r.GetService("foo", getEditService(), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		assert.Equal(t, a.(*servingv1.Service).Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+	}, errors.New("admission webhook denied the request"))
+	r.GetService("foo", getEditService(), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		assert.Equal(t, a.(*servingv1.Service).Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v3")
+	}, nil)
+
+	_, err = executeServiceCommand(client, "edit", "foo", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(editor.Input(2), "# service 'foo' could not be updated:", "spec.template.spec.containers[0].image"))
+	assert.Assert(t, util.ContainsAll(editor.Input(3), "# service 'foo' could not be updated:", "admission webhook denied the request"))
+
+	r.Validate()
+}
+
+func TestServiceEditErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "edit")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	// Renaming is rejected, saving without further changes gives up
+	editor, err := commands.NewTestEditor(`s|name: foo|name: bar|`, `s|name: bar|name: bar|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+	r := client.Recorder()
+	r.GetService("foo", getEditService(), nil)
+	_, err = executeServiceCommand(client, "edit", "foo")
+	assert.ErrorContains(t, err, "the name of service 'foo' can't be changed")
+
+	r.Validate()
+}
+
+func TestServiceEditNoChanges(t *testing.T) {
+	editor, err := commands.NewTestEditor(`s|foo|foo|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getEditService(), nil)
+
+	output, err := executeServiceCommand(client, "edit", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Edit cancelled, no changes made"))
+
+	r.Validate()
+}
+
+func getEditService() *servingv1.Service {
+	service := getService("foo")
+	service.ResourceVersion = "5"
+	service.Annotations = map[string]string{"serving.knative.dev/creator": "joe", "team": "payments"}
+	service.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v1"
+	service.Status.LatestReadyRevisionName = "foo-rev1"
+	return service
+}
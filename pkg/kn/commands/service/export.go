@@ -29,6 +29,7 @@ import (
 
 	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
 	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/revision"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -261,7 +262,7 @@ func exportForKNImport(latestSvc *servingv1.Service, client clientservingv1.KnSe
 
 func getRevisionsToExport(latestSvc *servingv1.Service, client clientservingv1.KnServingClient) (*servingv1.RevisionList, map[string]bool, error) {
 	//get revisions to export from traffic
-	revsMap := getRoutedRevisions(latestSvc)
+	revsMap := revision.RoutedRevisions(latestSvc)
 
 	// Query for list with filters
 	revisionList, err := client.ListRevisions(clientservingv1.WithService(latestSvc.ObjectMeta.Name))
@@ -276,18 +277,6 @@ func getRevisionsToExport(latestSvc *servingv1.Service, client clientservingv1.K
 	return revisionList, revsMap, nil
 }
 
-func getRoutedRevisions(latestSvc *servingv1.Service) map[string]bool {
-	trafficList := latestSvc.Spec.RouteSpec.Traffic
-	revsMap := make(map[string]bool)
-
-	for _, traffic := range trafficList {
-		if traffic.RevisionName != "" {
-			revsMap[traffic.RevisionName] = true
-		}
-	}
-	return revsMap
-}
-
 // sortRevisions sorts revisions by generation and name (in this order)
 func sortRevisions(revisionList *servingv1.RevisionList) {
 	// sort revisionList by configuration generation key
@@ -15,6 +15,9 @@
 package service
 
 import (
+	"strconv"
+	"strings"
+
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
@@ -34,6 +37,9 @@ func ServiceListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Conditions describing statuses of service components.", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready condition status of the service.", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the service.", Priority: 1},
+		{Name: "Image", Type: "string", Description: "Container image of the service's template.", Priority: 2},
+		{Name: "Concurrency", Type: "string", Description: "Container concurrency of the service's template.", Priority: 2},
+		{Name: "Tags", Type: "string", Description: "Tags of the service's traffic targets.", Priority: 2},
 	}
 
 	h.TableHandler(kServiceColumnDefinitions, printKService)
@@ -82,5 +88,40 @@ func printKService(kService *servingv1.Service, options hprinters.PrintOptions)
 		conditions,
 		ready,
 		reason)
+	if options.Wide {
+		row.Cells = append(row.Cells,
+			serviceImage(kService),
+			serviceConcurrency(kService),
+			serviceTags(kService))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
+
+// serviceImage returns the image of the first container of the service's template
+func serviceImage(service *servingv1.Service) string {
+	containers := service.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		return ""
+	}
+	return containers[0].Image
+}
+
+// serviceConcurrency returns the container concurrency of the service's template, if set
+func serviceConcurrency(service *servingv1.Service) string {
+	concurrency := service.Spec.Template.Spec.ContainerConcurrency
+	if concurrency == nil {
+		return ""
+	}
+	return strconv.FormatInt(*concurrency, 10)
+}
+
+// serviceTags returns the comma separated tags of the service's traffic targets
+func serviceTags(service *servingv1.Service) string {
+	var tags []string
+	for _, target := range service.Status.Traffic {
+		if target.Tag != "" {
+			tags = append(tags, target.Tag)
+		}
+	}
+	return strings.Join(tags, ",")
+}
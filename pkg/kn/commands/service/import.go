@@ -0,0 +1,169 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
+	"knative.dev/client/pkg/kn/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// NewServiceImportCommand returns a new command for importing a service and its revisions
+// which have been exported with 'kn service export --with-revisions --mode=export'
+func NewServiceImportCommand(p *commands.KnParams) *cobra.Command {
+	var filename string
+	var waitTimeout int
+
+	command := &cobra.Command{
+		Use:   "import -f FILENAME",
+		Short: "Import a service and its revisions (experimental)",
+		Example: `
+  # Import a service with its revisions exported by 'kn service export --with-revisions --mode=export'
+  kn service import -f export.yaml
+
+  # Import a service into namespace 'bar'
+  kn service import -f export.json -n bar`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'kn service import' takes no arguments, please provide the export file with --filename")
+			}
+			if filename == "" {
+				return errors.New("'kn service import' requires the export file given with --filename")
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			export, err := readExportFromFile(filename)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			return importService(client, export, waitTimeout, cmd.OutOrStdout())
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVarP(&filename, "filename", "f", "", "Export file in YAML or JSON format as created by 'kn service export --with-revisions --mode=export'.")
+	command.MarkFlagFilename("filename")
+	flags.IntVar(&waitTimeout, "wait-timeout", commands.WaitDefaultTimeout, "Seconds to wait before giving up on waiting for each imported revision to be ready.")
+	return command
+}
+
+// readExportFromFile reads a kn export from the given YAML or JSON file
+func readExportFromFile(filename string) (*clientv1alpha1.Export, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var export clientv1alpha1.Export
+	err = yaml.NewYAMLOrJSONDecoder(file, 512).Decode(&export)
+	if err != nil {
+		return nil, err
+	}
+	if export.Kind != "Export" || export.APIVersion != clientv1alpha1.SchemeGroupVersion.String() {
+		return nil, fmt.Errorf("file '%s' does not contain a kn export of kind 'Export' in version '%s', "+
+			"please create it with 'kn service export --with-revisions --mode=export'", filename, clientv1alpha1.SchemeGroupVersion.String())
+	}
+	if export.Spec.Service.Name == "" {
+		return nil, fmt.Errorf("no service name found in export file '%s'", filename)
+	}
+	return &export, nil
+}
+
+// importService replays the exported revisions in the order of their generations,
+// keeping their names, and finally applies the exported service with its traffic split.
+// Each step waits for the service to become ready so that the revision is created before
+// the template gets overwritten by the next one.
+func importService(client clientservingv1.KnServingClient, export *clientv1alpha1.Export, timeout int, out io.Writer) error {
+	service := export.Spec.Service.DeepCopy()
+	service.Namespace = client.Namespace()
+	service.ResourceVersion = ""
+
+	exists, err := serviceExists(client, service.Name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("cannot import service '%s' in namespace '%s' because the service already exists",
+			service.Name, client.Namespace())
+	}
+
+	revisionList := &servingv1.RevisionList{Items: export.Spec.Revisions}
+	sortRevisions(revisionList)
+
+	fmt.Fprintf(out, "Importing service '%s' with %d revision(s) in namespace '%s':\n", service.Name, len(revisionList.Items)+1, client.Namespace())
+	created := false
+	for _, revision := range revisionList.Items {
+		revisionService := constructServiceFromRevision(service, revision.DeepCopy())
+		fmt.Fprintf(out, "\nCreating revision '%s':\n", revision.Name)
+		if !created {
+			revisionService.Namespace = client.Namespace()
+			err = client.CreateService(&revisionService)
+			created = true
+		} else {
+			err = client.UpdateServiceWithRetry(service.Name, func(existing *servingv1.Service) (*servingv1.Service, error) {
+				existing.Spec.Template = revisionService.Spec.Template
+				return existing, nil
+			}, MaxUpdateRetries)
+		}
+		if err != nil {
+			return err
+		}
+		err = waitForService(client, service.Name, out, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "\nCreating latest revision and restoring traffic:\n")
+	if !created {
+		err = client.CreateService(service)
+	} else {
+		err = client.UpdateServiceWithRetry(service.Name, func(existing *servingv1.Service) (*servingv1.Service, error) {
+			existing.ObjectMeta.Labels = service.ObjectMeta.Labels
+			existing.ObjectMeta.Annotations = service.ObjectMeta.Annotations
+			existing.Spec = service.Spec
+			return existing, nil
+		}, MaxUpdateRetries)
+	}
+	if err != nil {
+		return err
+	}
+	err = waitForService(client, service.Name, out, timeout)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "")
+	return showUrl(client, service.Name, "", "imported", out)
+}
@@ -0,0 +1,170 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apiserving "knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceImportError(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "import")
+	assert.ErrorContains(t, err, "requires the export file")
+
+	_, err = executeServiceCommand(client, "import", "foo")
+	assert.ErrorContains(t, err, "takes no arguments")
+
+	_, err = executeServiceCommand(client, "import", "-f", "/no/such/file.yaml")
+	assert.ErrorContains(t, err, "no such file")
+
+	svcFile := writeTempYAMLFile(t, getServiceWithOptions(getService("foo"), withServicePodSpecOption(withContainer())))
+	defer os.RemoveAll(filepath.Dir(svcFile))
+	_, err = executeServiceCommand(client, "import", "-f", svcFile)
+	assert.ErrorContains(t, err, "kind 'Export'")
+}
+
+func TestServiceImportExistingService(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getService("foo"), nil)
+
+	exportFile := writeTempYAMLFile(t, getKNExportWithOptions(withKNService(getServiceWithOptions(getService("foo"), withServicePodSpecOption(withContainer())))))
+	defer os.RemoveAll(filepath.Dir(exportFile))
+
+	_, err := executeServiceCommand(client, "import", "-f", exportFile)
+	assert.ErrorContains(t, err, "already exists")
+	r.Validate()
+}
+
+func TestServiceImportNoRevisions(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	svc := getServiceWithOptions(getService("foo"), withUnwantedFieldsStripped(), withServicePodSpecOption(withContainer()))
+	exportFile := writeTempYAMLFile(t, getKNExportWithOptions(withKNService(svc)))
+	defer os.RemoveAll(filepath.Dir(exportFile))
+
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(func(t *testing.T, a interface{}) {
+		created := a.(*servingv1.Service)
+		assert.Equal(t, created.Namespace, "default")
+		assert.Equal(t, created.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:baz")
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "import", "-f", exportFile)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Importing", "foo", "imported", "http://foo.example.com"))
+	r.Validate()
+}
+
+func TestServiceImportWithRevisions(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	svc := getServiceWithOptions(
+		getService("foo"),
+		withUnwantedFieldsStripped(),
+		withServiceRevisionName("foo-rev-3"),
+		withTrafficSplit([]string{"foo-rev-1", "foo-rev-2", "foo-rev-3"}, []int{25, 50, 25}, []bool{false, false, false}),
+		withServicePodSpecOption(withContainer(), withEnv([]v1.EnvVar{{Name: "a", Value: "mouse"}})),
+	)
+	// Revisions are given in reverse order to verify that they are replayed by generation
+	export := getKNExportWithOptions(
+		withKNService(svc),
+		withKNRevisions(
+			withRevisionLabels(map[string]string{apiserving.ServiceLabelKey: "foo"}),
+			withRevisionGeneration("2"),
+			withRevisionName("foo-rev-2"),
+			withRevisionPodSpecOption(withContainer(), withEnv([]v1.EnvVar{{Name: "a", Value: "dog"}})),
+		),
+		withKNRevisions(
+			withRevisionLabels(map[string]string{apiserving.ServiceLabelKey: "foo"}),
+			withRevisionGeneration("1"),
+			withRevisionName("foo-rev-1"),
+			withRevisionPodSpecOption(withContainer(), withEnv([]v1.EnvVar{{Name: "a", Value: "cat"}})),
+		),
+	)
+	exportFile := writeTempYAMLFile(t, export)
+	defer os.RemoveAll(filepath.Dir(exportFile))
+
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.CreateService(func(t *testing.T, a interface{}) {
+		created := a.(*servingv1.Service)
+		assert.Equal(t, created.Spec.Template.Name, "foo-rev-1")
+		assert.Equal(t, created.Spec.Template.Spec.Containers[0].Env[0].Value, "cat")
+		assert.Equal(t, len(created.Spec.Traffic), 0)
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+
+	r.GetService("foo", getService("foo"), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.Equal(t, updated.Spec.Template.Name, "foo-rev-2")
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Env[0].Value, "dog")
+		assert.Equal(t, len(updated.Spec.Traffic), 0)
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+
+	r.GetService("foo", getService("foo"), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.Equal(t, updated.Spec.Template.Name, "foo-rev-3")
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Env[0].Value, "mouse")
+		assert.DeepEqual(t, updated.Spec.Traffic, svc.Spec.Traffic)
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "import", "-f", exportFile)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Importing", "3 revision(s)", "foo-rev-1", "foo-rev-2", "restoring traffic", "http://foo.example.com"))
+	r.Validate()
+}
+
+func withKNService(svc *servingv1.Service) expectedKNExportOption {
+	return func(export *clientv1alpha1.Export) {
+		export.Spec.Service = *svc
+	}
+}
+
+func writeTempYAMLFile(t *testing.T, obj interface{}) string {
+	tempDir, err := ioutil.TempDir("", "kn-file")
+	assert.NilError(t, err)
+	data, err := yaml.Marshal(obj)
+	assert.NilError(t, err)
+	tempFile := filepath.Join(tempDir, "object.yaml")
+	err = ioutil.WriteFile(tempFile, data, os.FileMode(0666))
+	assert.NilError(t, err)
+	return tempFile
+}
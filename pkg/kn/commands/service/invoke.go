@@ -0,0 +1,232 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+var invokeExample = `
+  # Send a GET request to service 'svc' and print the response
+  kn service invoke svc
+
+  # Send a POST request with the content of 'payload.json' to path '/api' of the revision tagged 'canary'
+  kn service invoke svc --tag canary --path /api --data @payload.json -H "Content-Type: application/json"
+
+  # Send the request via the ingress gateway when DNS is not configured for the cluster's domain
+  kn service invoke svc --ingress-address 192.168.39.10:31380`
+
+type invokeFlags struct {
+	tag            string
+	revision       string
+	path           string
+	method         string
+	data           string
+	headers        []string
+	ingressAddress string
+	timeout        time.Duration
+}
+
+// NewServiceInvokeCommand returns a new command for sending an HTTP request to a service
+func NewServiceInvokeCommand(p *commands.KnParams) *cobra.Command {
+	var flags invokeFlags
+
+	command := &cobra.Command{
+		Use:     "invoke NAME",
+		Short:   "Send an HTTP request to a service",
+		Example: invokeExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service invoke' requires the service name given as single argument")
+			}
+			if flags.tag != "" && flags.revision != "" {
+				return errors.New("--tag and --revision can not be used together")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(name)
+			if err != nil {
+				return err
+			}
+			target, err := invokeURL(service, flags.tag, flags.revision)
+			if err != nil {
+				return err
+			}
+			request, err := flags.newRequest(target, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			return flags.invoke(request, cmd.OutOrStdout())
+		},
+	}
+	f := command.Flags()
+	commands.AddNamespaceFlags(f, false)
+	f.StringVar(&flags.tag, "tag", "", "Send the request to the URL of the given traffic tag.")
+	f.StringVar(&flags.revision, "revision", "", "Send the request to the tagged URL of the given revision.")
+	f.StringVar(&flags.path, "path", "", "Path (and query) to append to the URL, e.g. '/api?q=1'.")
+	f.StringVarP(&flags.method, "request", "X", "", "HTTP method to use. Defaults to POST when --data is given, GET otherwise.")
+	f.StringVarP(&flags.data, "data", "d", "",
+		"Body of the request. Prefix with '@' to read the body from a file, e.g. '@payload.json', or use '@-' to read it from stdin.")
+	f.StringArrayVarP(&flags.headers, "header", "H", []string{},
+		"Header to add to the request in the format 'NAME: VALUE'. You can use this flag multiple times.")
+	f.StringVar(&flags.ingressAddress, "ingress-address", "",
+		"Address (HOST[:PORT]) of the ingress gateway to send the request to, with the service's host set as Host header. "+
+			"Use this for cluster-local services or when DNS is not set up for the cluster's domain.")
+	f.DurationVar(&flags.timeout, "timeout", 30*time.Second, "Time to wait for the response.")
+	return command
+}
+
+// invokeURL returns the URL of the service, or the URL of a traffic tag or a tagged revision
+func invokeURL(service *servingv1.Service, tag string, revision string) (string, error) {
+	if tag == "" && revision == "" {
+		if service.Status.URL == nil {
+			return "", fmt.Errorf("service '%s' has no URL yet, please check that it is ready", service.Name)
+		}
+		return extractURL(service), nil
+	}
+	for _, target := range service.Status.Traffic {
+		if target.Tag == "" || target.URL == nil {
+			continue
+		}
+		if (tag != "" && target.Tag == tag) || (revision != "" && target.RevisionName == revision) {
+			return target.URL.String(), nil
+		}
+	}
+	if tag != "" {
+		return "", fmt.Errorf("no URL found for tag '%s' of service '%s'", tag, service.Name)
+	}
+	return "", fmt.Errorf("no tagged URL found for revision '%s' of service '%s', add a tag with 'kn service update %s --tag %s=TAG'",
+		revision, service.Name, service.Name, revision)
+}
+
+func (f *invokeFlags) newRequest(target string, in io.Reader) (*http.Request, error) {
+	targetURL, err := url.Parse(target + f.path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --path '%s': %v", f.path, err)
+	}
+
+	var body []byte
+	switch {
+	case f.data == "@-":
+		body, err = ioutil.ReadAll(in)
+	case strings.HasPrefix(f.data, "@"):
+		body, err = ioutil.ReadFile(f.data[1:])
+	default:
+		body = []byte(f.data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read --data: %v", err)
+	}
+
+	method := strings.ToUpper(f.method)
+	if method == "" {
+		method = http.MethodGet
+		if f.data != "" {
+			method = http.MethodPost
+		}
+	}
+
+	host := targetURL.Host
+	if f.ingressAddress != "" {
+		targetURL.Host = f.ingressAddress
+	}
+	request, err := http.NewRequest(method, targetURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Host = host
+
+	for _, header := range f.headers {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid --header '%s', please use the format 'NAME: VALUE'", header)
+		}
+		name, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if strings.EqualFold(name, "Host") {
+			request.Host = value
+			continue
+		}
+		request.Header.Add(name, value)
+	}
+	return request, nil
+}
+
+// invoke sends the request and prints the status, the headers and the body of the response.
+// An error is returned for responses with a status code of 400 and above.
+func (f *invokeFlags) invoke(request *http.Request, out io.Writer) error {
+	client := &http.Client{
+		Timeout: f.timeout,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			// Verify the certificate against the service's host, also when connecting to the ingress
+			TLSClientConfig: &tls.Config{ServerName: strings.Split(request.Host, ":")[0]},
+		},
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		if f.ingressAddress == "" {
+			return fmt.Errorf("cannot send request to %s: %v\n"+
+				"If the service is cluster-local or DNS is not set up, specify the address of the ingress gateway with --ingress-address", request.URL, err)
+		}
+		return fmt.Errorf("cannot send request to %s via ingress %s: %v", request.Host, f.ingressAddress, err)
+	}
+	defer response.Body.Close()
+
+	fmt.Fprintf(out, "%s %s\n", response.Proto, response.Status)
+	names := make([]string, 0, len(response.Header))
+	for name := range response.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range response.Header[name] {
+			fmt.Fprintf(out, "%s: %s\n", name, value)
+		}
+	}
+	fmt.Fprintln(out)
+	_, err = io.Copy(out, response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("request to service returned status %s", response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceInvokeErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "invoke")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	_, err = executeServiceCommand(client, "invoke", "foo", "--tag", "canary", "--revision", "foo-rev1")
+	assert.ErrorContains(t, err, "can not be used together")
+
+	r := client.Recorder()
+	service := getInvokeService("http://foo.default.example.com")
+	r.GetService("foo", service, nil)
+	_, err = executeServiceCommand(client, "invoke", "foo", "--tag", "bogus")
+	assert.ErrorContains(t, err, "no URL found for tag 'bogus'")
+
+	r.GetService("foo", service, nil)
+	_, err = executeServiceCommand(client, "invoke", "foo", "--revision", "foo-rev1")
+	assert.ErrorContains(t, err, "no tagged URL found for revision 'foo-rev1'")
+
+	r.GetService("foo", service, nil)
+	_, err = executeServiceCommand(client, "invoke", "foo", "-H", "no-colon")
+	assert.ErrorContains(t, err, "invalid --header 'no-colon'")
+
+	r.Validate()
+}
+
+func TestServiceInvoke(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodGet)
+		assert.Equal(t, r.URL.Path, "/api")
+		assert.Equal(t, r.URL.Query().Get("q"), "1")
+		assert.Equal(t, r.Header.Get("X-Test"), "yes")
+		w.Header().Set("X-Reply", "pong")
+		w.Write([]byte("Hello World!\n"))
+	}))
+	defer server.Close()
+
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getInvokeService(server.URL), nil)
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--path", "/api?q=1", "-H", "X-Test: yes")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "HTTP/1.1 200 OK", "X-Reply: pong", "Hello World!"))
+
+	r.Validate()
+}
+
+func TestServiceInvokeTagWithData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, string(body), `{"msg":"hi"}`)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	tempDir, err := ioutil.TempDir("", "kn-invoke")
+	assert.NilError(t, err)
+	defer os.RemoveAll(tempDir)
+	dataFile := filepath.Join(tempDir, "payload.json")
+	assert.NilError(t, ioutil.WriteFile(dataFile, []byte(`{"msg":"hi"}`), 0644))
+
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := getInvokeService("http://foo.default.example.com")
+	tagURL, _ := apis.ParseURL(server.URL)
+	service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: "foo-rev2", Tag: "canary", URL: tagURL}}
+	r.GetService("foo", service, nil)
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--tag", "canary", "--data", "@"+dataFile)
+	assert.ErrorContains(t, err, "returned status 500")
+	assert.Assert(t, util.ContainsAll(output, "500 Internal Server Error"))
+
+	r.Validate()
+}
+
+func TestServiceInvokeIngressAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Host, "foo.default.svc.cluster.local")
+		w.Write([]byte("via ingress"))
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", getInvokeService("http://foo.default.svc.cluster.local"), nil)
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--ingress-address", serverURL.Host)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "200 OK", "via ingress"))
+
+	r.Validate()
+}
+
+func getInvokeService(serviceURL string) *servingv1.Service {
+	service := getService("foo")
+	service.Status.URL, _ = apis.ParseURL(serviceURL)
+	return service
+}
@@ -19,16 +19,19 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
 )
 
 // NewServiceListCommand represents 'kn service list' command
 func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 	serviceListFlags := flags.NewListPrintFlags(ServiceListHandlers)
+	selectorFilter := flags.LabelSelectorFilter{}
 
 	serviceListCommand := &cobra.Command{
 		Use:   "list",
@@ -41,7 +44,19 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
   kn service list -o json
 
   # List service 'web'
-  kn service list web`,
+  kn service list web
+
+  # List all services with the label 'team=payments'
+  kn service list -l team=payments
+
+  # List all services with additional columns like the image and the traffic tags
+  kn service list -o wide
+
+  # List the name and the image of all services, sorted by their creation time
+  kn service list -o custom-columns=NAME:.metadata.name,IMAGE:.spec.template.spec.containers[0].image --sort-by=.metadata.creationTimestamp
+
+  # List all services and watch for changes
+  kn service list --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -51,13 +66,12 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			serviceList, err := getServiceInfo(args, client)
+			listConfigs, err := serviceListConfigs(args)
 			if err != nil {
 				return err
 			}
-			if len(serviceList.Items) == 0 {
-				fmt.Fprintf(cmd.OutOrStdout(), "No services found.\n")
-				return nil
+			if selectorFilter.Selector != "" {
+				listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
 			}
 
 			// empty namespace indicates all-namespaces flag is specified
@@ -65,37 +79,63 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 				serviceListFlags.EnsureWithNamespace()
 			}
 
-			// Sort serviceList by namespace and name (in this order)
-			sort.SliceStable(serviceList.Items, func(i, j int) bool {
-				a := serviceList.Items[i]
-				b := serviceList.Items[j]
+			listConfigs = append(listConfigs, util.WithChunkSize(serviceListFlags.ChunkSize))
+			if serviceListFlags.Streaming() {
+				listConfigs = append(listConfigs, util.WithPageHandler(func(page runtime.Object) error {
+					serviceList := page.(*servingv1.ServiceList)
+					sortServices(serviceList)
+					return serviceListFlags.PrintPage(serviceList, cmd.OutOrStdout())
+				}))
+			}
+			serviceList, err := client.ListServices(listConfigs...)
+			if err != nil {
+				return err
+			}
+			if len(serviceList.Items) == 0 && !serviceListFlags.Watch {
+				fmt.Fprintf(cmd.OutOrStdout(), "No services found.\n")
+				return nil
+			}
 
-				if a.Namespace != b.Namespace {
-					return a.Namespace < b.Namespace
-				}
-				return a.ObjectMeta.Name < b.ObjectMeta.Name
-			})
+			sortServices(serviceList)
 
+			if serviceListFlags.Watch {
+				watcher, err := client.WatchServices(listConfigs...)
+				if err != nil {
+					return err
+				}
+				return serviceListFlags.PrintWatch(serviceList, watcher, cmd.OutOrStdout())
+			}
 			return serviceListFlags.Print(serviceList, cmd.OutOrStdout())
 		},
 	}
 	commands.AddNamespaceFlags(serviceListCommand.Flags(), true)
 	serviceListFlags.AddFlags(serviceListCommand)
+	serviceListFlags.AddWatchFlag(serviceListCommand)
+	serviceListFlags.AddChunkSizeFlag(serviceListCommand)
+	selectorFilter.Add(serviceListCommand, "services")
 	return serviceListCommand
 }
 
-func getServiceInfo(args []string, client clientservingv1.KnServingClient) (*servingv1.ServiceList, error) {
-	var (
-		serviceList *servingv1.ServiceList
-		err         error
-	)
+// Sort serviceList by namespace and name (in this order)
+func sortServices(serviceList *servingv1.ServiceList) {
+	sort.SliceStable(serviceList.Items, func(i, j int) bool {
+		a := serviceList.Items[i]
+		b := serviceList.Items[j]
+
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.ObjectMeta.Name < b.ObjectMeta.Name
+	})
+}
+
+func serviceListConfigs(args []string) ([]util.ListConfig, error) {
 	switch len(args) {
 	case 0:
-		serviceList, err = client.ListServices()
+		return nil, nil
 	case 1:
-		serviceList, err = client.ListServices(clientservingv1.WithName(args[0]))
+		return []util.ListConfig{clientservingv1.WithName(args[0])}, nil
 	default:
 		return nil, fmt.Errorf("'kn service list' accepts maximum 1 argument")
 	}
-	return serviceList, err
 }
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/watch"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
@@ -168,6 +169,33 @@ func TestServiceListWithTwoSrvNameMock(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceListWatchMock(t *testing.T) {
+	// New mock client
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	// Recording:
+	r := client.Recorder()
+
+	service := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	r.ListServices(mock.Any(), &servingv1.ServiceList{}, nil)
+	watcher := watch.NewFakeWithChanSize(2, false)
+	watcher.Add(service)
+	watcher.Delete(service)
+	watcher.Stop()
+	r.WatchServices(mock.Any(), watcher, nil)
+
+	output, err := executeServiceCommand(client, "list", "--watch")
+	assert.NilError(t, err)
+
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsNone(output, "No services found"))
+	assert.Check(t, util.ContainsAll(outputLines[0], "NAME", "URL", "LATEST", "AGE", "CONDITIONS", "READY", "REASON"))
+	assert.Check(t, util.ContainsAll(outputLines[1], "foo", "foo.default.example.com", "foo-xyz"))
+	assert.Check(t, util.ContainsAll(outputLines[2], "foo", "foo.default.example.com", "foo-xyz"))
+
+	r.Validate()
+}
+
 func getServiceWithNamespace(name, namespace string) *servingv1.Service {
 	service := servingv1.Service{}
 	service.Name = name
@@ -19,10 +19,12 @@ import (
 	"testing"
 
 	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clienttesting "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/kn/commands"
@@ -140,6 +142,54 @@ func TestServiceGetOneOutput(t *testing.T) {
 	assert.Check(t, util.ContainsAll(output[1], "foo", "foo.default.example.com", "foo-xyz"))
 }
 
+func TestServiceListWithSelector(t *testing.T) {
+	service := createMockServiceWithParams("foo", "default", "foo.default.example.com", "foo-xyz")
+	service.Labels = map[string]string{"team": "payments"}
+	serviceList := &servingv1.ServiceList{Items: []servingv1.Service{*service}}
+	action, output, err := fakeServiceList([]string{"service", "list", "-l", "team=payments"}, serviceList)
+	assert.NilError(t, err)
+	assert.Assert(t, action != nil && action.Matches("list", "services"))
+	restrictions := action.(clienttesting.ListAction).GetListRestrictions()
+	assert.Equal(t, restrictions.Labels.String(), "team=payments")
+	assert.Check(t, util.ContainsAll(output[1], "foo", "foo.default.example.com", "foo-xyz"))
+}
+
+func TestServiceListWideOutput(t *testing.T) {
+	service := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	service.Spec.Template.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/bar:v1"}}
+	service.Spec.Template.Spec.ContainerConcurrency = ptr.Int64(10)
+	service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: "foo-xyz", Tag: "current", Percent: ptr.Int64(100)}}
+	serviceList := &servingv1.ServiceList{Items: []servingv1.Service{*service}}
+
+	_, output, err := fakeServiceList([]string{"service", "list", "-o", "wide"}, serviceList)
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output[0], "NAME", "URL", "READY", "IMAGE", "CONCURRENCY", "TAGS"))
+	assert.Check(t, util.ContainsAll(output[1], "foo", "gcr.io/foo/bar:v1", "10", "current"))
+
+	_, output, err = fakeServiceList([]string{"service", "list"}, serviceList)
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsNone(output[0], "IMAGE", "CONCURRENCY", "TAGS"))
+	assert.Check(t, util.ContainsNone(output[1], "gcr.io/foo/bar:v1", "current"))
+}
+
+func TestServiceListCustomColumnsSortBy(t *testing.T) {
+	service1 := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	service2 := createMockServiceWithParams("bar", "default", "http://bar.default.example.com", "bar-xyz")
+	service1.Spec.Template.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/a:v1"}}
+	service2.Spec.Template.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/b:v1"}}
+	serviceList := &servingv1.ServiceList{Items: []servingv1.Service{*service1, *service2}}
+
+	_, output, err := fakeServiceList([]string{"service", "list", "-o", "custom-columns=NAME:.metadata.name,IMAGE:.spec.template.spec.containers[0].image",
+		"--sort-by", ".spec.template.spec.containers[0].image"}, serviceList)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, strings.Fields(output[0]), []string{"NAME", "IMAGE"})
+	assert.DeepEqual(t, strings.Fields(output[1]), []string{"foo", "gcr.io/foo/a:v1"})
+	assert.DeepEqual(t, strings.Fields(output[2]), []string{"bar", "gcr.io/foo/b:v1"})
+
+	_, _, err = fakeServiceList([]string{"service", "list", "-o", "custom-columns=NAME"}, serviceList)
+	assert.ErrorContains(t, err, "expected <header>:<json-path-expr>")
+}
+
 func TestServiceGetWithTwoSrvName(t *testing.T) {
 	service := createMockServiceWithParams("foo", "default", "foo.default.example.com", "foo-xyz")
 	serviceList := &servingv1.ServiceList{Items: []servingv1.Service{*service}}
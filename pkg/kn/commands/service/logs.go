@@ -0,0 +1,122 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+)
+
+var logsExample = `
+  # Show the logs of all revisions of service 'svc' which receive traffic
+  kn service logs svc
+
+  # Follow the logs of the revision tagged 'canary' of service 'svc'
+  kn service logs svc --tag canary -f
+
+  # Show the last 10 lines of the queue-proxy logs of revision 'svc-xyzab-1' written in the last 5 minutes
+  kn service logs svc --revision svc-xyzab-1 --container queue-proxy --tail 10 --since 5m`
+
+// NewServiceLogsCommand returns a new command for showing the logs of a service
+func NewServiceLogsCommand(p *commands.KnParams) *cobra.Command {
+	var logsFlags commands.LogsFlags
+	var revisionName string
+	var tag string
+
+	command := &cobra.Command{
+		Use:     "logs NAME",
+		Short:   "Show the logs of a service",
+		Example: logsExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service logs' requires the service name given as single argument")
+			}
+			if revisionName != "" && tag != "" {
+				return errors.New("--revision and --tag can not be used together")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			coreClient, err := p.NewCoreClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(name)
+			if err != nil {
+				return err
+			}
+			revisions, err := logRevisions(service, revisionName, tag)
+			if err != nil {
+				return err
+			}
+			selector, err := commands.RevisionLabelSelector(revisions...)
+			if err != nil {
+				return err
+			}
+			return logsFlags.StreamLogs(coreClient, selector, fmt.Sprintf("service '%s'", name), cmd.OutOrStdout())
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&revisionName, "revision", "", "Name of the revision whose logs to show.")
+	flags.StringVar(&tag, "tag", "", "Traffic tag of the revision whose logs to show.")
+	logsFlags.AddLogsFlags(command)
+	return command
+}
+
+// logRevisions returns the revisions whose logs to show. Without a revision or tag these are all
+// revisions which are referenced by the service's traffic or the latest ready revision.
+func logRevisions(service *servingv1.Service, revisionName string, tag string) ([]string, error) {
+	if revisionName != "" {
+		return []string{revisionName}, nil
+	}
+	if tag != "" {
+		for _, target := range service.Status.Traffic {
+			if target.Tag == tag && target.RevisionName != "" {
+				return []string{target.RevisionName}, nil
+			}
+		}
+		return nil, fmt.Errorf("no revision with tag '%s' found for service '%s'", tag, service.Name)
+	}
+
+	var revisions []string
+	seen := make(map[string]bool)
+	for _, target := range service.Status.Traffic {
+		if target.RevisionName != "" && !seen[target.RevisionName] {
+			seen[target.RevisionName] = true
+			revisions = append(revisions, target.RevisionName)
+		}
+	}
+	if len(revisions) == 0 && service.Status.LatestReadyRevisionName != "" {
+		revisions = append(revisions, service.Status.LatestReadyRevisionName)
+	}
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("service '%s' has no ready revision", service.Name)
+	}
+	return revisions, nil
+}
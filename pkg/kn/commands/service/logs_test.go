@@ -0,0 +1,138 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientcorev1 "knative.dev/client/pkg/core/v1"
+	"knative.dev/client/pkg/kn/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceLogsErrors(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	coreClient := clientcorev1.NewMockKnCoreClient(t)
+
+	_, err := executeServiceLogsCommand(client, coreClient)
+	assert.ErrorContains(t, err, "requires the service name")
+
+	_, err = executeServiceLogsCommand(client, coreClient, "foo", "--revision", "foo-rev1", "--tag", "canary")
+	assert.ErrorContains(t, err, "can not be used together")
+
+	r := client.Recorder()
+	r.GetService("foo", getLogsService(), nil)
+	_, err = executeServiceLogsCommand(client, coreClient, "foo", "--tag", "bogus")
+	assert.ErrorContains(t, err, "no revision with tag 'bogus' found")
+	r.Validate()
+}
+
+func TestServiceLogs(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	coreClient := clientcorev1.NewMockKnCoreClient(t)
+
+	r := client.Recorder()
+	cr := coreClient.Recorder()
+	r.GetService("foo", getLogsService(), nil)
+	cr.ListPods("serving.knative.dev/revision in (foo-rev1,foo-rev2)", getLogsPodList("foo-rev2-deployment-abc"), nil)
+	cr.GetPodLogs("foo-rev2-deployment-abc", &corev1.PodLogOptions{Container: "user-container"}, ioutil.NopCloser(strings.NewReader("Listening on :8080\n")), nil)
+
+	output, err := executeServiceLogsCommand(client, coreClient, "foo")
+	assert.NilError(t, err)
+	assert.Equal(t, output, "[foo-rev2-deployment-abc] Listening on :8080\n")
+
+	r.Validate()
+	cr.Validate()
+}
+
+func TestServiceLogsTag(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	coreClient := clientcorev1.NewMockKnCoreClient(t)
+
+	r := client.Recorder()
+	cr := coreClient.Recorder()
+	r.GetService("foo", getLogsService(), nil)
+	cr.ListPods("serving.knative.dev/revision in (foo-rev2)", getLogsPodList("foo-rev2-deployment-abc"), nil)
+	cr.GetPodLogs(mock.Any(), func(t *testing.T, a interface{}) {
+		options := a.(*corev1.PodLogOptions)
+		assert.Equal(t, options.Container, "queue-proxy")
+		assert.Equal(t, *options.TailLines, int64(5))
+	}, ioutil.NopCloser(strings.NewReader("probe ok\n")), nil)
+
+	output, err := executeServiceLogsCommand(client, coreClient, "foo", "--tag", "canary", "--container", "queue-proxy", "--tail", "5")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "probe ok"))
+
+	// Scaled to zero
+	r.GetService("foo", getLogsService(), nil)
+	cr.ListPods("serving.knative.dev/revision in (foo-rev3)", &corev1.PodList{}, nil)
+	_, err = executeServiceLogsCommand(client, coreClient, "foo", "--revision", "foo-rev3")
+	assert.ErrorContains(t, err, "no running pods found for service 'foo'")
+
+	r.Validate()
+	cr.Validate()
+}
+
+func executeServiceLogsCommand(client clientservingv1.KnServingClient, coreClient clientcorev1.KnCoreClient, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewServingClient = func(namespace string) (clientservingv1.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewCoreClient = func(namespace string) (clientcorev1.KnCoreClient, error) {
+		return coreClient, nil
+	}
+	cmd := NewServiceLogsCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func getLogsService() *servingv1.Service {
+	service := getService("foo")
+	service.Status.LatestReadyRevisionName = "foo-rev2"
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-rev1", Percent: ptr.Int64(50)},
+		{RevisionName: "foo-rev2", Percent: ptr.Int64(50)},
+		{RevisionName: "foo-rev2", Tag: "canary", Percent: ptr.Int64(0)},
+	}
+	return service
+}
+
+func getLogsPodList(names ...string) *corev1.PodList {
+	podList := &corev1.PodList{}
+	for _, name := range names {
+		podList.Items = append(podList.Items, corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		})
+	}
+	return podList
+}
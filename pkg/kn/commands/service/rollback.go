@@ -0,0 +1,213 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	servinglib "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+const (
+	// RollbackStrategyTraffic routes all traffic to the existing revision
+	RollbackStrategyTraffic = "traffic"
+	// RollbackStrategyTemplate creates a new revision from the spec of the old revision
+	RollbackStrategyTemplate = "template"
+)
+
+var rollbackExample = `
+  # Roll back service 'svc' to the previous ready revision by routing all traffic to it
+  kn service rollback svc
+
+  # Roll back service 'svc' to revision 'svc-xyzab-1'
+  kn service rollback svc --to-revision svc-xyzab-1
+
+  # Roll back service 'svc' by creating a new revision with the spec (including the image digest) of the previous revision
+  kn service rollback svc --strategy template`
+
+// NewServiceRollbackCommand returns a new command for rolling back a service to a previous revision
+func NewServiceRollbackCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var toRevision string
+	var strategy string
+
+	command := &cobra.Command{
+		Use:     "rollback NAME",
+		Short:   "Roll back a service to a previous revision",
+		Example: rollbackExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service rollback' requires the service name given as single argument")
+			}
+			if strategy != RollbackStrategyTraffic && strategy != RollbackStrategyTemplate {
+				return fmt.Errorf("invalid value '%s' for --strategy, please specify one of %s|%s", strategy, RollbackStrategyTraffic, RollbackStrategyTemplate)
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			current, target, err := findRollbackRevisions(client, service, toRevision)
+			if err != nil {
+				return err
+			}
+
+			err = client.UpdateServiceWithRetry(name, func(service *servingv1.Service) (*servingv1.Service, error) {
+				return rollbackService(service, target, strategy)
+			}, MaxUpdateRetries)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Service '%s' rolled back from revision '%s' to revision '%s' in namespace '%s'.\n", name, current, target.Name, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Rolling back Service '%s' from revision '%s' to revision '%s' in namespace '%s':\n", name, current, target.Name, namespace)
+			fmt.Fprintln(out, "")
+			err = waitForService(client, name, out, waitFlags.TimeoutInSeconds)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "")
+			return showRollbackResult(client, name, target.Name, strategy, out)
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&toRevision, "to-revision", "",
+		"Name of the revision to roll back to. Defaults to the latest ready revision created before the revision currently receiving traffic.")
+	flags.StringVar(&strategy, "strategy", RollbackStrategyTraffic,
+		"How to roll back. One of traffic|template. "+
+			"'traffic' routes 100% of the traffic to the existing revision, "+
+			"'template' creates a new revision from the revision's spec with its image frozen to the digest.")
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "rollback", "service", "ready")
+	return command
+}
+
+// findRollbackRevisions returns the name of the revision currently receiving traffic and
+// the revision to roll back to. If no target is given, the latest ready revision
+// with a generation lower than the current revision is picked.
+func findRollbackRevisions(client clientservingv1.KnServingClient, service *servingv1.Service, toRevision string) (string, *servingv1.Revision, error) {
+	revisionList, err := client.ListRevisions(clientservingv1.WithService(service.Name))
+	if err != nil {
+		return "", nil, err
+	}
+	sortRevisions(revisionList)
+
+	current := currentRevisionName(service)
+	if toRevision != "" {
+		if toRevision == current {
+			return "", nil, fmt.Errorf("revision '%s' of service '%s' is already receiving all traffic", toRevision, service.Name)
+		}
+		for i := range revisionList.Items {
+			if revisionList.Items[i].Name == toRevision {
+				return current, &revisionList.Items[i], nil
+			}
+		}
+		return "", nil, fmt.Errorf("no revision '%s' found for service '%s' in namespace '%s'", toRevision, service.Name, client.Namespace())
+	}
+
+	currentIdx := len(revisionList.Items)
+	for i := range revisionList.Items {
+		if revisionList.Items[i].Name == current {
+			currentIdx = i
+			break
+		}
+	}
+	for i := currentIdx - 1; i >= 0; i-- {
+		if revisionList.Items[i].IsReady() {
+			return current, &revisionList.Items[i], nil
+		}
+	}
+	return "", nil, fmt.Errorf("no ready revision found for service '%s' to roll back to from revision '%s'", service.Name, current)
+}
+
+// currentRevisionName returns the name of the revision receiving the highest share of traffic
+// or the latest ready revision if the service has no traffic status yet
+func currentRevisionName(service *servingv1.Service) string {
+	current := service.Status.LatestReadyRevisionName
+	var percent int64 = -1
+	for _, target := range service.Status.Traffic {
+		if target.RevisionName != "" && target.Percent != nil && *target.Percent > percent {
+			current = target.RevisionName
+			percent = *target.Percent
+		}
+	}
+	return current
+}
+
+// rollbackService modifies the service so that the target revision receives all traffic.
+// Tagged traffic targets are kept with zero percent so that their URLs continue to work.
+func rollbackService(service *servingv1.Service, target *servingv1.Revision, strategy string) (*servingv1.Service, error) {
+	var traffic []servingv1.TrafficTarget
+	for _, t := range service.Spec.Traffic {
+		if t.Tag != "" {
+			t.Percent = ptr.Int64(0)
+			traffic = append(traffic, t)
+		}
+	}
+
+	switch strategy {
+	case RollbackStrategyTemplate:
+		revisionService := constructServiceFromRevision(service, target.DeepCopy())
+		template := revisionService.Spec.Template
+		// Let the server generate a new revision name, the old one is still in use
+		template.Name = ""
+		err := servinglib.FreezeImageToDigest(&template, target)
+		if err != nil {
+			return nil, err
+		}
+		service.Spec.Template = template
+		traffic = append(traffic, servingv1.TrafficTarget{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)})
+	default:
+		traffic = append(traffic, servingv1.TrafficTarget{RevisionName: target.Name, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)})
+	}
+	service.Spec.Traffic = traffic
+	return service, nil
+}
+
+func showRollbackResult(client clientservingv1.KnServingClient, serviceName string, targetRevision string, strategy string, out io.Writer) error {
+	if strategy == RollbackStrategyTemplate {
+		return showUrl(client, serviceName, "", "rolled back", out)
+	}
+	service, err := client.GetService(serviceName)
+	if err != nil {
+		return fmt.Errorf("cannot fetch service '%s' in namespace '%s' for extracting the URL: %v", serviceName, client.Namespace(), err)
+	}
+	fmt.Fprintf(out, "Service '%s' rolled back to revision '%s' is available at URL:\n%s\n", serviceName, targetRevision, service.Status.URL.String())
+	return nil
+}
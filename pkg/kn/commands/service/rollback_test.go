@@ -0,0 +1,171 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	apiserving "knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceRollbackErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "rollback")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	_, err = executeServiceCommand(client, "rollback", "foo", "--strategy", "bogus")
+	assert.ErrorContains(t, err, "invalid value 'bogus' for --strategy")
+}
+
+func TestServiceRollbackTraffic(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := getRollbackService("foo-rev3")
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		{RevisionName: "foo-rev1", Tag: "stable", Percent: ptr.Int64(0)},
+	}
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-rev1", Tag: "stable", Percent: ptr.Int64(0)},
+			{RevisionName: "foo-rev1", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v3")
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", service, nil)
+
+	// foo-rev2 is skipped as it is not ready
+	output, err := executeServiceCommand(client, "rollback", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Rolling back", "from revision 'foo-rev3' to revision 'foo-rev1'", "rolled back to revision 'foo-rev1'", "http://foo.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceRollbackTemplate(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := getRollbackService("foo-rev3")
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		template := updated.Spec.Template
+		assert.Equal(t, template.Name, "")
+		assert.Equal(t, template.Spec.Containers[0].Image, "gcr.io/foo/bar@sha256:2")
+		assert.DeepEqual(t, template.Spec.Containers[0].Env, []corev1.EnvVar{{Name: "REV", Value: "2"}})
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		})
+	}, nil)
+
+	output, err := executeServiceCommand(client, "rollback", "foo", "--to-revision", "foo-rev2", "--strategy", "template", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "rolled back from revision 'foo-rev3' to revision 'foo-rev2'"))
+
+	r.Validate()
+}
+
+func TestServiceRollbackNoTarget(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := getRollbackService("foo-rev1")
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	_, err := executeServiceCommand(client, "rollback", "foo")
+	assert.ErrorContains(t, err, "no ready revision found")
+
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	_, err = executeServiceCommand(client, "rollback", "foo", "--to-revision", "bar-rev1")
+	assert.ErrorContains(t, err, "no revision 'bar-rev1' found")
+
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), getRollbackRevisionList(), nil)
+	_, err = executeServiceCommand(client, "rollback", "foo", "--to-revision", "foo-rev1")
+	assert.ErrorContains(t, err, "already receiving all traffic")
+
+	r.Validate()
+}
+
+func getRollbackService(current string) *servingv1.Service {
+	service := getService("foo")
+	service.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v3"
+	service.Status.LatestReadyRevisionName = "foo-rev3"
+	service.Status.URL = &apis.URL{Scheme: "http", Host: "foo.example.com"}
+	service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: current, Percent: ptr.Int64(100)}}
+	return service
+}
+
+func getRollbackRevisionList() *servingv1.RevisionList {
+	list := &servingv1.RevisionList{}
+	// Deliberately unordered to verify the sorting by generation
+	for _, gen := range []int{3, 1, 2} {
+		name := "foo-rev" + strconv.Itoa(gen)
+		readyStatus := corev1.ConditionTrue
+		if gen == 2 {
+			readyStatus = corev1.ConditionFalse
+		}
+		revision := servingv1.Revision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					apiserving.ServiceLabelKey:                 "foo",
+					apiserving.ConfigurationGenerationLabelKey: strconv.Itoa(gen),
+				},
+			},
+			Spec: servingv1.RevisionSpec{
+				PodSpec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Image: "gcr.io/foo/bar:v" + strconv.Itoa(gen),
+						Env:   []corev1.EnvVar{{Name: "REV", Value: strconv.Itoa(gen)}},
+					}},
+				},
+			},
+			Status: servingv1.RevisionStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionReady, Status: readyStatus}},
+				},
+				DeprecatedImageDigest: "gcr.io/foo/bar@sha256:" + strconv.Itoa(gen),
+			},
+		}
+		list.Items = append(list.Items, revision)
+	}
+	return list
+}
@@ -0,0 +1,412 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/traffic"
+	servinglib "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+const (
+	// Annotation on the service holding the state of a rollout in progress
+	rolloutAnnotationKey = "client.knative.dev/rollout"
+
+	rolloutRevisionNameTemplate = "{{.Service}}-{{.Random 5}}-{{.Generation}}"
+)
+
+var rolloutExample = `
+  # Roll out image 'gcr.io/foo/bar:v2' to service 'svc', shifting 10%, 25%, 50% and finally 100% of the traffic
+  # to the new revision with two minutes in between
+  kn service rollout svc --image gcr.io/foo/bar:v2 --steps 10,25,50,100 --interval 2m
+
+  # Roll out a new image and abort if the new revision is not healthy when probed via its tag 'canary'
+  kn service rollout svc --image gcr.io/foo/bar:v2 --health-url http://canary-svc.default.example.com/healthz
+
+  # Continue an interrupted rollout of service 'svc'
+  kn service rollout svc --resume`
+
+// rolloutState is the state of a rollout which is stored as annotation on the service
+type rolloutState struct {
+	// Revision which is rolled out
+	Revision string `json:"revision"`
+	// Tag pointing to the revision while rolling out
+	Tag string `json:"tag"`
+	// Steps are the traffic percentages for the new revision
+	Steps []int `json:"steps"`
+	// NextStep is the index of the step to apply next
+	NextStep int `json:"nextStep"`
+	// Interval to wait between two steps
+	Interval string `json:"interval"`
+	// HealthURL is probed before each step if given
+	HealthURL string `json:"healthURL,omitempty"`
+	// OriginalTraffic is the traffic split before the rollout, pinned to revisions
+	OriginalTraffic []servingv1.TrafficTarget `json:"originalTraffic"`
+}
+
+// Checks the health URL given with --health-url, can be replaced in tests
+var rolloutHealthCheck = func(url string) error {
+	client := http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("health URL %s returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// NewServiceRolloutCommand returns a new command for gradually shifting traffic to a new image
+func NewServiceRolloutCommand(p *commands.KnParams) *cobra.Command {
+	var image string
+	var steps []int
+	var interval time.Duration
+	var tag string
+	var healthURL string
+	var resume bool
+	var waitTimeout int
+
+	command := &cobra.Command{
+		Use:     "rollout NAME --image IMAGE",
+		Short:   "Roll out a new image by gradually shifting traffic to it",
+		Example: rolloutExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service rollout' requires the service name given as single argument")
+			}
+			name := args[0]
+			if resume {
+				for _, flag := range []string{"image", "steps", "interval", "tag", "health-url"} {
+					if cmd.Flags().Changed(flag) {
+						return fmt.Errorf("flag --%s can not be used together with --resume, the settings of the interrupted rollout are used", flag)
+					}
+				}
+			} else {
+				if image == "" {
+					return errors.New("'service rollout' requires the image to roll out given with --image")
+				}
+				err := validateRolloutSteps(steps)
+				if err != nil {
+					return err
+				}
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(name)
+			if err != nil {
+				return err
+			}
+			state, err := getRolloutState(service)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if resume {
+				if state == nil {
+					return fmt.Errorf("no rollout in progress for service '%s' in namespace '%s'", name, namespace)
+				}
+				fmt.Fprintf(out, "Resuming rollout of revision '%s' for service '%s' in namespace '%s':\n", state.Revision, name, namespace)
+			} else {
+				if state != nil {
+					return fmt.Errorf("rollout of revision '%s' is already in progress for service '%s', use --resume to continue it", state.Revision, name)
+				}
+				state = &rolloutState{
+					Tag:       tag,
+					Steps:     steps,
+					Interval:  interval.String(),
+					HealthURL: healthURL,
+				}
+				err = startRollout(client, name, image, state)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "Rolling out revision '%s' with image '%s' for service '%s' in namespace '%s':\n", state.Revision, image, name, namespace)
+			}
+			return runRollout(client, name, state, waitTimeout, out)
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&image, "image", "", "Image to roll out.")
+	flags.IntSliceVar(&steps, "steps", []int{10, 25, 50, 100},
+		"Comma separated percentages of traffic for the new revision, increasing and ending with 100.")
+	flags.DurationVar(&interval, "interval", time.Minute, "Time to wait between two steps, e.g. 30s or 2m.")
+	flags.StringVar(&tag, "tag", "canary", "Tag pointing to the new revision during the rollout.")
+	flags.StringVar(&healthURL, "health-url", "",
+		"URL which is requested before each step. The rollout is aborted if the request fails or returns an error status.")
+	flags.BoolVar(&resume, "resume", false, "Resume an interrupted rollout with the settings stored on the service.")
+	flags.IntVar(&waitTimeout, "wait-timeout", commands.WaitDefaultTimeout,
+		"Seconds to wait for the service to become ready after each step before aborting the rollout.")
+	return command
+}
+
+func validateRolloutSteps(steps []int) error {
+	if len(steps) == 0 {
+		return errors.New("flag --steps requires at least one step")
+	}
+	previous := 0
+	for _, step := range steps {
+		if step <= previous || step > 100 {
+			return fmt.Errorf("invalid --steps %v, steps must be increasing percentages between 1 and 100", steps)
+		}
+		previous = step
+	}
+	if previous != 100 {
+		return fmt.Errorf("invalid --steps %v, the last step must be 100", steps)
+	}
+	return nil
+}
+
+// startRollout creates the new revision with the given image without any traffic.
+// The current traffic split is pinned to revisions so that the new revision
+// does not get traffic by becoming the latest ready revision.
+func startRollout(client clientservingv1.KnServingClient, name string, image string, state *rolloutState) error {
+	return client.UpdateServiceWithRetry(name, func(service *servingv1.Service) (*servingv1.Service, error) {
+		original, err := pinTrafficToRevisions(service)
+		if err != nil {
+			return nil, err
+		}
+		revisionName, err := servinglib.GenerateRevisionName(rolloutRevisionNameTemplate, service)
+		if err != nil {
+			return nil, err
+		}
+
+		template := &service.Spec.Template
+		err = servinglib.UpdateImage(template, image)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := template.Annotations[servinglib.UserImageAnnotationKey]; ok {
+			servinglib.SetUserImageAnnot(template)
+		}
+		template.Name = revisionName
+
+		serviceTraffic := traffic.ServiceTraffic(copyTraffic(original))
+		for _, target := range serviceTraffic {
+			if target.Tag == state.Tag {
+				return nil, fmt.Errorf("tag '%s' is already used by revision '%s', please choose another one with --tag", state.Tag, target.RevisionName)
+			}
+		}
+		service.Spec.Traffic = serviceTraffic.TagRevision(state.Tag, revisionName)
+
+		state.Revision = revisionName
+		state.NextStep = 0
+		state.OriginalTraffic = original
+		return service, setRolloutState(service, state)
+	}, MaxUpdateRetries)
+}
+
+// runRollout shifts the traffic step by step to the new revision and promotes it at the end.
+// Before each step the revision's readiness and the optional health URL are checked, and the
+// original traffic split is restored if any of these checks fails.
+func runRollout(client clientservingv1.KnServingClient, name string, state *rolloutState, timeout int, out io.Writer) error {
+	interval, err := time.ParseDuration(state.Interval)
+	if err != nil {
+		return err
+	}
+
+	if state.NextStep == 0 {
+		fmt.Fprintln(out, "")
+		err = waitForService(client, name, out, timeout)
+		if err != nil {
+			return abortRollout(client, name, state, err.Error(), out)
+		}
+	}
+
+	for state.NextStep < len(state.Steps) {
+		revision, err := client.GetRevision(state.Revision)
+		if err != nil {
+			return err
+		}
+		if revision.IsFailed() {
+			return abortRollout(client, name, state, fmt.Sprintf("revision '%s' is not ready", state.Revision), out)
+		}
+		if state.HealthURL != "" {
+			err = rolloutHealthCheck(state.HealthURL)
+			if err != nil {
+				return abortRollout(client, name, state, err.Error(), out)
+			}
+		}
+
+		percent := state.Steps[state.NextStep]
+		next := state.NextStep + 1
+		err = client.UpdateServiceWithRetry(name, func(service *servingv1.Service) (*servingv1.Service, error) {
+			service.Spec.Traffic = rolloutTraffic(state, percent)
+			// The update function is called again on conflicts, so it must not advance the state itself
+			saved := *state
+			saved.NextStep = next
+			return service, setRolloutState(service, &saved)
+		}, MaxUpdateRetries)
+		if err != nil {
+			return err
+		}
+		state.NextStep = next
+
+		fmt.Fprintf(out, "\nShifting %d%% of traffic to revision '%s':\n", percent, state.Revision)
+		err = waitForService(client, name, out, timeout)
+		if err != nil {
+			return abortRollout(client, name, state, err.Error(), out)
+		}
+		if state.NextStep < len(state.Steps) && interval > 0 {
+			fmt.Fprintf(out, "Waiting %s before the next step.\n", interval)
+			time.Sleep(interval)
+		}
+	}
+
+	// Promote the new revision, which is the latest ready revision now
+	err = client.UpdateServiceWithRetry(name, func(service *servingv1.Service) (*servingv1.Service, error) {
+		var promoted []servingv1.TrafficTarget
+		for _, target := range state.OriginalTraffic {
+			if target.Tag != "" {
+				target.Percent = ptr.Int64(0)
+				promoted = append(promoted, target)
+			}
+		}
+		service.Spec.Traffic = append(promoted, servingv1.TrafficTarget{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)})
+		delete(service.Annotations, rolloutAnnotationKey)
+		return service, nil
+	}, MaxUpdateRetries)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "")
+	err = waitForService(client, name, out, timeout)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "")
+	return showUrl(client, name, "", "rolled out", out)
+}
+
+// abortRollout restores the original traffic split and removes the rollout state
+func abortRollout(client clientservingv1.KnServingClient, name string, state *rolloutState, reason string, out io.Writer) error {
+	fmt.Fprintf(out, "\nAborting rollout of revision '%s': %s\n", state.Revision, reason)
+	err := client.UpdateServiceWithRetry(name, func(service *servingv1.Service) (*servingv1.Service, error) {
+		service.Spec.Traffic = copyTraffic(state.OriginalTraffic)
+		delete(service.Annotations, rolloutAnnotationKey)
+		return service, nil
+	}, MaxUpdateRetries)
+	if err != nil {
+		return fmt.Errorf("cannot restore the original traffic split of service '%s' after aborting the rollout: %v", name, err)
+	}
+	return fmt.Errorf("rollout of revision '%s' aborted and original traffic split of service '%s' restored: %s", state.Revision, name, reason)
+}
+
+// rolloutTraffic returns the original traffic split scaled down to the remaining percentage
+// plus the given percentage for the new revision
+func rolloutTraffic(state *rolloutState, percent int) []servingv1.TrafficTarget {
+	serviceTraffic := traffic.ServiceTraffic(copyTraffic(state.OriginalTraffic))
+	remaining := int64(100 - percent)
+	var assigned int64
+	first := -1
+	for i, target := range serviceTraffic {
+		if target.Percent == nil || *target.Percent == 0 {
+			continue
+		}
+		if first == -1 {
+			first = i
+		}
+		scaled := *target.Percent * remaining / 100
+		serviceTraffic[i].Percent = ptr.Int64(scaled)
+		assigned += scaled
+	}
+	// Assign what's lost by rounding to the first target
+	if first != -1 {
+		serviceTraffic[first].Percent = ptr.Int64(*serviceTraffic[first].Percent + remaining - assigned)
+	}
+	serviceTraffic = serviceTraffic.TagRevision(state.Tag, state.Revision)
+	serviceTraffic.SetTrafficByTag(state.Tag, int64(percent))
+	return serviceTraffic.RemoveNullTargets()
+}
+
+// pinTrafficToRevisions returns the service's traffic with the latest revision
+// replaced by the name of the current latest ready revision
+func pinTrafficToRevisions(service *servingv1.Service) ([]servingv1.TrafficTarget, error) {
+	latestReady := service.Status.LatestReadyRevisionName
+	if latestReady == "" {
+		return nil, fmt.Errorf("service '%s' has no ready revision to roll out from", service.Name)
+	}
+	if len(service.Spec.Traffic) == 0 {
+		return []servingv1.TrafficTarget{{RevisionName: latestReady, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)}}, nil
+	}
+	pinned := copyTraffic(service.Spec.Traffic)
+	for i, target := range pinned {
+		if target.LatestRevision != nil && *target.LatestRevision {
+			pinned[i].RevisionName = latestReady
+		}
+		pinned[i].LatestRevision = ptr.Bool(false)
+		if target.Percent == nil {
+			pinned[i].Percent = ptr.Int64(0)
+		}
+	}
+	return pinned, nil
+}
+
+func copyTraffic(targets []servingv1.TrafficTarget) []servingv1.TrafficTarget {
+	ret := make([]servingv1.TrafficTarget, len(targets))
+	for i, target := range targets {
+		ret[i] = *target.DeepCopy()
+	}
+	return ret
+}
+
+func getRolloutState(service *servingv1.Service) (*rolloutState, error) {
+	value, ok := service.Annotations[rolloutAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	state := &rolloutState{}
+	err := json.Unmarshal([]byte(value), state)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read rollout state from annotation %s of service '%s': %v", rolloutAnnotationKey, service.Name, err)
+	}
+	return state, nil
+}
+
+func setRolloutState(service *servingv1.Service, state *rolloutState) error {
+	value, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if service.Annotations == nil {
+		service.Annotations = map[string]string{}
+	}
+	service.Annotations[rolloutAnnotationKey] = string(value)
+	return nil
+}
@@ -0,0 +1,249 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestServiceRolloutErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "rollout", "foo")
+	assert.ErrorContains(t, err, "requires the image")
+
+	for _, steps := range []string{"10,5,100", "10,50", "0,100", "50,120"} {
+		_, err = executeServiceCommand(client, "rollout", "foo", "--image", "gcr.io/foo/bar:v2", "--steps", steps)
+		assert.ErrorContains(t, err, "invalid --steps")
+	}
+
+	_, err = executeServiceCommand(client, "rollout", "foo", "--resume", "--image", "gcr.io/foo/bar:v2")
+	assert.ErrorContains(t, err, "--image can not be used together with --resume")
+
+	r := client.Recorder()
+	r.GetService("foo", getRolloutService(), nil)
+	_, err = executeServiceCommand(client, "rollout", "foo", "--resume")
+	assert.ErrorContains(t, err, "no rollout in progress")
+	r.Validate()
+}
+
+func TestServiceRollout(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := getRolloutService()
+	var newRevision string
+	r.GetService("foo", service, nil)
+
+	// Start: create the new revision without traffic
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		newRevision = updated.Spec.Template.Name
+		assert.Assert(t, strings.HasPrefix(newRevision, "foo-") && strings.HasSuffix(newRevision, "-3"))
+		assert.Equal(t, updated.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:v2")
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-rev2", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+			{RevisionName: newRevision, Tag: "canary", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+		})
+		assert.Assert(t, util.ContainsAll(updated.Annotations[rolloutAnnotationKey], `"revision":"`+newRevision+`"`, `"nextStep":0`))
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+
+	// Step 1: 30%, the first update conflicts and is retried without skipping a step
+	r.GetRevision(mock.Any(), getReadyRevision(), nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.Assert(t, util.ContainsAll(updated.Annotations[rolloutAnnotationKey], `"nextStep":1`))
+	}, apierrors.NewConflict(servingv1.Resource("service"), "foo", errors.New("modified")))
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-rev2", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(70)},
+			{RevisionName: newRevision, Tag: "canary", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(30)},
+		})
+		assert.Assert(t, util.ContainsAll(updated.Annotations[rolloutAnnotationKey], `"nextStep":1`))
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+
+	// Step 2: 100%
+	r.GetRevision(mock.Any(), getReadyRevision(), nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: newRevision, Tag: "canary", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+
+	// Promotion
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		})
+		_, ok := updated.Annotations[rolloutAnnotationKey]
+		assert.Assert(t, !ok)
+	}, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetService("foo", service, nil)
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--image", "gcr.io/foo/bar:v2", "--steps", "30,100", "--interval", "0s")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Rolling out revision", "Shifting 30% of traffic", "Shifting 100% of traffic", "rolled out"))
+
+	r.Validate()
+}
+
+func TestServiceRolloutAbortOnHealthCheck(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	oldHealthCheck := rolloutHealthCheck
+	defer func() { rolloutHealthCheck = oldHealthCheck }()
+	rolloutHealthCheck = func(url string) error {
+		assert.Equal(t, url, "http://canary.example.com/healthz")
+		return errors.New("health URL returned status 500")
+	}
+
+	service := getRolloutService()
+	r.GetService("foo", service, nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(mock.Any(), nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	r.GetRevision(mock.Any(), getReadyRevision(), nil)
+	// Abort
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.DeepEqual(t, updated.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-rev2", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+		_, ok := updated.Annotations[rolloutAnnotationKey]
+		assert.Assert(t, !ok)
+	}, nil)
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--image", "gcr.io/foo/bar:v2", "--health-url", "http://canary.example.com/healthz")
+	assert.ErrorContains(t, err, "aborted and original traffic split of service 'foo' restored")
+	assert.ErrorContains(t, err, "status 500")
+	assert.Assert(t, util.ContainsAll(output, "Aborting rollout"))
+
+	r.Validate()
+}
+
+func TestServiceRolloutAbortOnFailedRevision(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := getRolloutService()
+	state := &rolloutState{
+		Revision: "foo-abcde-3",
+		Tag:      "canary",
+		Steps:    []int{50, 100},
+		NextStep: 1,
+		Interval: "1m0s",
+		OriginalTraffic: []servingv1.TrafficTarget{
+			{RevisionName: "foo-rev2", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		},
+	}
+	assert.NilError(t, setRolloutState(service, state))
+
+	failed := getReadyRevision()
+	failed.Status.Conditions[0].Status = corev1.ConditionFalse
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-abcde-3", failed, nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		updated := a.(*servingv1.Service)
+		assert.DeepEqual(t, updated.Spec.Traffic, state.OriginalTraffic)
+	}, nil)
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--resume")
+	assert.ErrorContains(t, err, "revision 'foo-abcde-3' is not ready")
+	assert.Assert(t, util.ContainsAll(output, "Resuming rollout of revision 'foo-abcde-3'"))
+
+	r.Validate()
+}
+
+func TestServiceRolloutAlreadyInProgress(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := getRolloutService()
+	assert.NilError(t, setRolloutState(service, &rolloutState{Revision: "foo-abcde-3"}))
+	r.GetService("foo", service, nil)
+
+	_, err := executeServiceCommand(client, "rollout", "foo", "--image", "gcr.io/foo/bar:v2")
+	assert.ErrorContains(t, err, "already in progress")
+
+	r.Validate()
+}
+
+func TestRolloutTraffic(t *testing.T) {
+	state := &rolloutState{
+		Revision: "foo-new",
+		Tag:      "canary",
+		OriginalTraffic: []servingv1.TrafficTarget{
+			{RevisionName: "foo-v1", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(70)},
+			{RevisionName: "foo-v2", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(30)},
+			{RevisionName: "foo-v0", Tag: "old", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+		},
+	}
+	assert.DeepEqual(t, rolloutTraffic(state, 25), []servingv1.TrafficTarget{
+		{RevisionName: "foo-v1", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(53)},
+		{RevisionName: "foo-v2", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(22)},
+		{RevisionName: "foo-v0", Tag: "old", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+		{RevisionName: "foo-new", Tag: "canary", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(25)},
+	})
+	// The original state must not be modified
+	assert.Equal(t, *state.OriginalTraffic[0].Percent, int64(70))
+}
+
+func getRolloutService() *servingv1.Service {
+	service := getService("foo")
+	service.Generation = 2
+	service.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:v1"
+	service.Spec.Traffic = []servingv1.TrafficTarget{{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+	service.Status.LatestReadyRevisionName = "foo-rev2"
+	service.Status.URL = &apis.URL{Scheme: "http", Host: "foo.example.com"}
+	return service
+}
+
+func getReadyRevision() *servingv1.Revision {
+	revision := &servingv1.Revision{}
+	revision.Status.Status = duckv1.Status{
+		Conditions: duckv1.Conditions{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}},
+	}
+	return revision
+}
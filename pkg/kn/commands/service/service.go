@@ -41,7 +41,17 @@ func NewServiceCommand(p *commands.KnParams) *cobra.Command {
 	serviceCmd.AddCommand(NewServiceCreateCommand(p))
 	serviceCmd.AddCommand(NewServiceDeleteCommand(p))
 	serviceCmd.AddCommand(NewServiceUpdateCommand(p))
+	serviceCmd.AddCommand(NewServiceApplyCommand(p))
+	serviceCmd.AddCommand(NewServiceEditCommand(p))
+	serviceCmd.AddCommand(NewServiceCloneCommand(p))
+	serviceCmd.AddCommand(NewServiceDiffCommand(p))
 	serviceCmd.AddCommand(NewServiceExportCommand(p))
+	serviceCmd.AddCommand(NewServiceImportCommand(p))
+	serviceCmd.AddCommand(NewServiceRollbackCommand(p))
+	serviceCmd.AddCommand(NewServiceRolloutCommand(p))
+	serviceCmd.AddCommand(NewServiceLogsCommand(p))
+	serviceCmd.AddCommand(NewServiceInvokeCommand(p))
+	serviceCmd.AddCommand(NewServiceWaitCommand(p))
 	return serviceCmd
 }
 
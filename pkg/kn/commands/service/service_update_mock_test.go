@@ -1473,3 +1473,84 @@ func TestServiceUpdateUser(t *testing.T) {
 
 	r.Validate()
 }
+
+func TestServiceUpdateDryRunMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", getService("foo"), nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		simulateServerDefaulting(t, a)
+		assert.Equal(t, a.(*servingv1.Service).Spec.Template.Spec.Containers[0].Env[0].Value, "rabbit")
+	}, nil)
+
+	output, err := executeServiceCommand(client, "update", "foo", "-e", "a=rabbit", "--dry-run", "server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "kind: Service", "value: rabbit", "timeoutSeconds: 300"))
+	assert.Assert(t, util.ContainsNone(output, "Updating", "updated"))
+
+	r.Validate()
+}
+
+func TestServiceUpdateSidecarMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	service := getService("foo")
+	service.Spec.Template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+
+	r := client.Recorder()
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		containers := a.(*servingv1.Service).Spec.Template.Spec.Containers
+		assert.Equal(t, len(containers), 2)
+		assert.Equal(t, containers[0].Image, "gcr.io/foo/bar:baz")
+		assert.DeepEqual(t, containers[0].Ports, []corev1.ContainerPort{{ContainerPort: 8080}})
+		assert.Equal(t, len(containers[0].Env), 0)
+		assert.Equal(t, containers[1].Name, "proxy")
+		assert.Equal(t, containers[1].Image, "gcr.io/foo/proxy")
+		assert.DeepEqual(t, containers[1].Env, []corev1.EnvVar{{Name: "UPSTREAM", Value: "localhost:8080"}})
+	}, nil)
+
+	output, err := executeServiceCommand(client, "update", "foo",
+		"--sidecar", "proxy=gcr.io/foo/proxy", "--container", "proxy", "--env", "UPSTREAM=localhost:8080",
+		"--no-wait", "--revision-name=")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "updated", "foo", "default"))
+
+	r.GetService("foo", service, nil)
+	_, err = executeServiceCommand(client, "update", "foo", "--container", "bogus", "--env", "A=B", "--no-wait")
+	assert.ErrorContains(t, err, "Invalid --container")
+	assert.ErrorContains(t, err, "no container 'bogus' found")
+
+	r.Validate()
+}
+
+func TestServiceUpdateProbesMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+
+	service := getService("foo")
+	service.Spec.Template.Spec.Containers[0].LivenessProbe = &corev1.Probe{
+		Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}},
+	}
+
+	r := client.Recorder()
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, a interface{}) {
+		container := a.(*servingv1.Service).Spec.Template.Spec.Containers[0]
+		assert.Assert(t, container.LivenessProbe == nil)
+		assert.Equal(t, container.ReadinessProbe.HTTPGet.Path, "/healthz")
+		assert.Equal(t, container.ReadinessProbe.PeriodSeconds, int32(5))
+	}, nil)
+
+	output, err := executeServiceCommand(client, "update", "foo",
+		"--probe-readiness", "http:/healthz:8080,period=5", "--probe-liveness", "-",
+		"--no-wait", "--revision-name=")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "updated", "foo", "default"))
+
+	r.GetService("foo", service, nil)
+	_, err = executeServiceCommand(client, "update", "foo", "--probe-readiness", "grpc:8080", "--no-wait")
+	assert.ErrorContains(t, err, "Invalid --probe-readiness")
+
+	r.Validate()
+}
@@ -48,12 +48,16 @@ var updateExample = `
   kn service update svc --untag testing --tag @latest=staging
 
   # Add tag 'test' to echo-v3 revision with 10% traffic and rest to latest ready revision of service
-  kn service update svc --tag echo-v3=test --traffic test=10,@latest=90`
+  kn service update svc --tag echo-v3=test --traffic test=10,@latest=90
+
+  # Validate an update on the server and show the resulting service, without updating it
+  kn service update svc --concurrency-limit 100 --dry-run server`
 
 func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	var editFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
 	var trafficFlags flags.Traffic
+	var dryRunFlags commands.DryRunFlags
 	serviceUpdateCommand := &cobra.Command{
 		Use:     "update NAME",
 		Short:   "Update a service",
@@ -62,6 +66,10 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 			if len(args) != 1 {
 				return errors.New("'service update' requires the service name given as single argument")
 			}
+			err = dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -75,6 +83,8 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 
 			// Use to store the latest revision name
 			var latestRevisionBeforeUpdate string
+			// The updated service, which holds the server's response for a dry-run
+			var updatedService *servingv1.Service
 			name := args[0]
 
 			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
@@ -99,16 +109,20 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 
 					service.Spec.Traffic = traffic
 				}
+				updatedService = service
 				return service, nil
 			}
 
 			// Do the actual update with retry in case of conflicts
-			err = client.UpdateServiceWithRetry(name, updateFunc, MaxUpdateRetries)
+			err = client.UpdateServiceWithRetry(name, updateFunc, MaxUpdateRetries, dryRunFlags.UpdateOptions()...)
 			if err != nil {
 				return err
 			}
 
 			out := cmd.OutOrStdout()
+			if dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(out, updatedService)
+			}
 			//TODO: deprecated condition should be once --async is gone
 			if !waitFlags.Async && waitFlags.Wait {
 				fmt.Fprintf(out, "Updating Service '%s' in namespace '%s':\n", args[0], namespace)
@@ -138,6 +152,7 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	editFlags.AddUpdateFlags(serviceUpdateCommand)
 	waitFlags.AddConditionWaitFlags(serviceUpdateCommand, commands.WaitDefaultTimeout, "update", "service", "ready")
 	trafficFlags.Add(serviceUpdateCommand)
+	dryRunFlags.Add(serviceUpdateCommand)
 	return serviceUpdateCommand
 }
 
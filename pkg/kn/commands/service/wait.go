@@ -0,0 +1,85 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/wait"
+)
+
+var waitExample = `
+  # Wait until service 'svc' is ready
+  kn service wait svc
+
+  # Wait at most 5 minutes for the routes of service 'svc' to be ready
+  kn service wait svc --for condition=RoutesReady --timeout 5m
+
+  # Wait until service 'svc' has failed
+  kn service wait svc --for condition=Ready=False`
+
+// NewServiceWaitCommand returns a new command for waiting on a condition of a service
+func NewServiceWaitCommand(p *commands.KnParams) *cobra.Command {
+	var waitForFlags commands.WaitForFlags
+
+	command := &cobra.Command{
+		Use:     "wait NAME",
+		Short:   "Wait for a service to reach a condition",
+		Example: waitExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service wait' requires the service name given as single argument")
+			}
+			name := args[0]
+			condition, err := waitForFlags.Condition()
+			if err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			// Fail early if the service does not exist
+			_, err = client.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Waiting for Service '%s' in namespace '%s' to reach condition %s:\n", name, namespace, condition)
+			fmt.Fprintln(out, "")
+			err, duration := client.WaitForServiceCondition(name, condition, waitForFlags.Timeout, wait.SimpleMessageCallback(out))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%7.3fs Service '%s' reached condition %s.\n", float64(duration.Round(time.Millisecond))/float64(time.Second), name, condition)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	waitForFlags.AddFlags(command, "service")
+	return command
+}
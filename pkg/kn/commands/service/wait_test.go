@@ -0,0 +1,65 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestServiceWait(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", getService("foo"), nil)
+	r.WaitForServiceCondition("foo", wait.ReadyCondition, 10*time.Minute, mock.Any(), nil, time.Second)
+	output, err := executeServiceCommand(client, "wait", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Waiting for Service 'foo'", "condition Ready=True", "Service 'foo' reached condition Ready=True"))
+
+	condition := wait.Condition{Type: "RoutesReady", Status: corev1.ConditionFalse}
+	r.GetService("foo", getService("foo"), nil)
+	r.WaitForServiceCondition("foo", condition, 5*time.Minute, mock.Any(), errors.New("timeout: service 'foo' has not reached condition"), time.Second)
+	_, err = executeServiceCommand(client, "wait", "foo", "--for", "condition=RoutesReady=False", "--timeout", "5m")
+	assert.ErrorContains(t, err, "timeout")
+
+	r.Validate()
+}
+
+func TestServiceWaitErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	_, err := executeServiceCommand(client, "wait")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	_, err = executeServiceCommand(client, "wait", "foo", "--for", "delete")
+	assert.ErrorContains(t, err, "invalid condition")
+
+	r.GetService("foo", nil, errors.New("services.serving.knative.dev \"foo\" not found"))
+	_, err = executeServiceCommand(client, "wait", "foo")
+	assert.ErrorContains(t, err, "not found")
+
+	r.Validate()
+}
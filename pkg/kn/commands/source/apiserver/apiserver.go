@@ -31,6 +31,7 @@ func NewAPIServerCommand(p *commands.KnParams) *cobra.Command {
 	}
 	apiServerSourceCmd.AddCommand(NewAPIServerCreateCommand(p))
 	apiServerSourceCmd.AddCommand(NewAPIServerUpdateCommand(p))
+	apiServerSourceCmd.AddCommand(NewAPIServerEditCommand(p))
 	apiServerSourceCmd.AddCommand(NewAPIServerDescribeCommand(p))
 	apiServerSourceCmd.AddCommand(NewAPIServerDeleteCommand(p))
 	apiServerSourceCmd.AddCommand(NewAPIServerListCommand(p))
@@ -19,7 +19,9 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	clientdynamic "knative.dev/client/pkg/dynamic"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	"knative.dev/client/pkg/sources/v1alpha2"
@@ -30,33 +32,57 @@ import (
 func NewAPIServerCreateCommand(p *commands.KnParams) *cobra.Command {
 	var updateFlags APIServerSourceUpdateFlags
 	var sinkFlags flags.SinkFlags
+	var dryRunFlags commands.DryRunFlags
+	var offlineFlags commands.OfflineFlags
 
 	cmd := &cobra.Command{
 		Use:   "create NAME --resource RESOURCE --sink SINK",
 		Short: "Create an api-server source",
 		Example: `
   # Create an ApiServerSource 'k8sevents' which consumes Kubernetes events and sends message to service 'mysvc' as a cloudevent
-  kn source apiserver create k8sevents --resource Event:v1 --service-account myaccountname --sink ksvc:mysvc`,
+  kn source apiserver create k8sevents --resource Event:v1 --service-account myaccountname --sink ksvc:mysvc
+
+  # Validate an ApiServerSource on the server and show it with all defaults applied, without creating it
+  kn source apiserver create k8sevents --resource Event:v1 --service-account myaccountname --sink ksvc:mysvc --dry-run server
+
+  # Print the ApiServerSource as YAML without connecting to a cluster
+  kn source apiserver create k8sevents --resource Event:v1 --service-account myaccountname --sink ksvc:mysvc --offline -o yaml`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
 				return errors.New("requires the name of the source to create as single argument")
 			}
 			name := args[0]
-
-			// get client
-			apiSourceClient, err := newAPIServerSourceClient(p, cmd)
+			err = dryRunFlags.Validate()
 			if err != nil {
 				return err
 			}
-
-			namespace := apiSourceClient.Namespace()
-
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			err = offlineFlags.Validate(cmd)
 			if err != nil {
 				return err
 			}
-			objectRef, err := sinkFlags.ResolveSink(dynamicClient, namespace)
+
+			var apiSourceClient v1alpha2.KnAPIServerSourcesClient
+			var namespace string
+			var objectRef *duckv1.Destination
+			if offlineFlags.Offline {
+				namespace = offlineFlags.Namespace(cmd)
+				objectRef, err = sinkFlags.ResolveSinkOffline(namespace)
+			} else {
+				// get client
+				apiSourceClient, err = newAPIServerSourceClient(p, cmd)
+				if err != nil {
+					return err
+				}
+				namespace = apiSourceClient.Namespace()
+
+				var dynamicClient clientdynamic.KnDynamicClient
+				dynamicClient, err = p.NewDynamicClient(namespace)
+				if err != nil {
+					return err
+				}
+				objectRef, err = sinkFlags.ResolveSink(dynamicClient, namespace)
+			}
 			if err != nil {
 				return fmt.Errorf(
 					"cannot create ApiServerSource '%s' in namespace '%s' "+
@@ -80,8 +106,12 @@ func NewAPIServerCreateCommand(p *commands.KnParams) *cobra.Command {
 				Sink(*objectRef).
 				Resources(resources).
 				CloudEventOverrides(ceOverridesMap, ceOverridesToRemove)
+			if offlineFlags.Offline {
+				return offlineFlags.PrintObject(cmd.OutOrStdout(), b.Namespace(namespace).Build())
+			}
 
-			err = apiSourceClient.CreateAPIServerSource(b.Build())
+			apiSource := b.Build()
+			err = apiSourceClient.CreateAPIServerSource(apiSource, dryRunFlags.CreateOptions()...)
 
 			if err != nil {
 				return fmt.Errorf(
@@ -89,6 +119,10 @@ func NewAPIServerCreateCommand(p *commands.KnParams) *cobra.Command {
 						"because: %s", name, namespace, err)
 			}
 
+			if dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(cmd.OutOrStdout(), apiSource)
+			}
+
 			if err == nil {
 				fmt.Fprintf(cmd.OutOrStdout(), "ApiServer source '%s' created in namespace '%s'.\n", args[0], namespace)
 			}
@@ -99,6 +133,8 @@ func NewAPIServerCreateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	updateFlags.Add(cmd)
 	sinkFlags.Add(cmd)
+	dryRunFlags.Add(cmd)
+	offlineFlags.Add(cmd)
 	cmd.MarkFlagRequired("resource")
 	cmd.MarkFlagRequired("sink")
 	return cmd
@@ -87,12 +87,16 @@ func NewAPIServerDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents {
+				return commands.PrintEvents(p, out, apiSource.Namespace, apiServerSourceEventObjects(apiSource.Name))
+			}
 			return nil
 		},
 	}
 	flags := apiServerDescribe.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.Bool("events", false, "Show the Kubernetes events of the ApiServer source and its adapter.")
 
 	return apiServerDescribe
 }
@@ -138,3 +142,14 @@ func writeCeOverrides(dw printers.PrefixWriter, ceOverrides map[string]string) {
 		subDw.WriteAttribute(k, ceOverrides[k])
 	}
 }
+
+// apiServerSourceEventObjects returns the source together with the deployment and pods of its
+// receive adapter, which are named after the source
+func apiServerSourceEventObjects(name string) []commands.EventObject {
+	prefix := "apiserversource-" + name + "-"
+	return []commands.EventObject{
+		{Kind: "ApiServerSource", Name: name},
+		{Kind: "Deployment", Name: prefix, NamePrefix: true},
+		{Kind: "Pod", Name: prefix, NamePrefix: true},
+	}
+}
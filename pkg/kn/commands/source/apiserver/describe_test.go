@@ -77,3 +77,13 @@ func TestDescribeWithSinkURI(t *testing.T) {
 
 	apiServerRecorder.Validate()
 }
+
+func TestAPIServerSourceEventObjects(t *testing.T) {
+	objects := apiServerSourceEventObjects("testsource")
+	assert.Equal(t, len(objects), 3)
+	assert.Equal(t, objects[0].Kind, "ApiServerSource")
+	assert.Equal(t, objects[0].Name, "testsource")
+	assert.Equal(t, objects[2].Kind, "Pod")
+	assert.Equal(t, objects[2].Name, "apiserversource-testsource-")
+	assert.Assert(t, objects[2].NamePrefix)
+}
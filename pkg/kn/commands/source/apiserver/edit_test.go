@@ -0,0 +1,64 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiserver
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
+)
+
+func TestApiServerSourceEdit(t *testing.T) {
+	// The invalid mode is detected locally and the editor is reopened
+	editor, err := commands.NewTestEditor(`s|mode: Reference|mode: Ref|`, `s|mode: Ref|mode: Resource|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	apiServerClient := clientv1alpha2.NewMockKnAPIServerSourceClient(t)
+	apiServerRecorder := apiServerClient.Recorder()
+	present := createAPIServerSource("testsource", "Event", "v1", "testsa1", "Reference", nil, createSinkv1("svc1", "default"))
+	apiServerRecorder.GetAPIServerSource("testsource", present, nil)
+	apiServerRecorder.GetAPIServerSource("testsource", present, nil)
+	apiServerRecorder.UpdateAPIServerSource(func(t *testing.T, a interface{}) {
+		updated := a.(*v1alpha2.ApiServerSource)
+		assert.Equal(t, updated.Spec.EventMode, "Resource")
+		assert.Equal(t, updated.Spec.ServiceAccountName, "testsa1")
+	}, nil)
+
+	out, err := executeAPIServerSourceCommand(apiServerClient, nil, "edit", "testsource")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "ApiServer source", "updated", "default", "testsource"))
+	assert.Assert(t, util.ContainsAll(editor.Input(1), "# Please edit the apiserver source below", "kind: ApiServerSource", "serviceAccountName: testsa1"))
+	assert.Assert(t, util.ContainsAll(editor.Input(2), "# apiserver source 'testsource' could not be updated:", "invalid value: Ref: spec.mode"))
+
+	apiServerRecorder.Validate()
+}
+
+func TestApiServerSourceEditError(t *testing.T) {
+	apiServerClient := clientv1alpha2.NewMockKnAPIServerSourceClient(t)
+	apiServerRecorder := apiServerClient.Recorder()
+	apiServerRecorder.GetAPIServerSource("testsource", nil, errors.New("no ApiServerSource found"))
+
+	_, err := executeAPIServerSourceCommand(apiServerClient, nil, "edit", "testsource")
+	assert.ErrorContains(t, err, "no ApiServerSource found")
+
+	apiServerRecorder.Validate()
+}
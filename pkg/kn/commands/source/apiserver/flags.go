@@ -182,6 +182,7 @@ func APIServerSourceListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Ready state conditions", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready state of the ApiServer source", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+		{Name: "Sink URI", Type: "string", Description: "Resolved URI of the sink", Priority: 2},
 	}
 	h.TableHandler(sourceColumnDefinitions, printSource)
 	h.TableHandler(sourceColumnDefinitions, printSourceList)
@@ -213,6 +214,9 @@ func printSource(source *v1alpha2.ApiServerSource, options hprinters.PrintOption
 	}
 
 	row.Cells = append(row.Cells, name, strings.Join(resources[:], ","), sink, age, conditions, ready, reason)
+	if options.Wide {
+		row.Cells = append(row.Cells, flags.SinkURIToString(source.Status.SinkURI))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
@@ -250,15 +254,17 @@ func labelSelectorToString(labelSelector *v1.LabelSelector) string {
 
 // printSourceList populates the source apiserver list table rows
 func printSourceList(sourceList *v1alpha2.ApiServerSourceList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
-	if options.AllNamespaces {
+	if options.AllNamespaces && !options.Sorted {
 		return printSourceListWithNamespace(sourceList, options)
 	}
 
 	rows := make([]metav1beta1.TableRow, 0, len(sourceList.Items))
 
-	sort.SliceStable(sourceList.Items, func(i, j int) bool {
-		return sourceList.Items[i].GetName() < sourceList.Items[j].GetName()
-	})
+	if !options.Sorted {
+		sort.SliceStable(sourceList.Items, func(i, j int) bool {
+			return sourceList.Items[i].GetName() < sourceList.Items[j].GetName()
+		})
+	}
 
 	for _, item := range sourceList.Items {
 		row, err := printSource(&item, options)
@@ -18,13 +18,16 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
 )
 
 // NewAPIServerListCommand is for listing ApiServer source COs
 func NewAPIServerListCommand(p *commands.KnParams) *cobra.Command {
+	selectorFilter := flags.LabelSelectorFilter{}
 	listFlags := flags.NewListPrintFlags(APIServerSourceListHandlers)
 
 	listCommand := &cobra.Command{
@@ -35,7 +38,10 @@ func NewAPIServerListCommand(p *commands.KnParams) *cobra.Command {
   kn source apiserver list
 
   # List all ApiServer sources in YAML format
-  kn source apiserver list -o yaml`,
+  kn source apiserver list -o yaml
+
+  # List all ApiServer sources with the label 'team=payments'
+  kn source apiserver list -l team=payments`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// TODO: filter list by given source name
@@ -45,7 +51,22 @@ func NewAPIServerListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			sourceList, err := apiSourceClient.ListAPIServerSource()
+			var listConfigs []util.ListConfig
+			if selectorFilter.Selector != "" {
+				listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
+			}
+
+			if apiSourceClient.Namespace() == "" {
+				listFlags.EnsureWithNamespace()
+			}
+
+			listConfigs = append(listConfigs, util.WithChunkSize(listFlags.ChunkSize))
+			if listFlags.Streaming() {
+				listConfigs = append(listConfigs, util.WithPageHandler(func(page runtime.Object) error {
+					return listFlags.PrintPage(page, cmd.OutOrStdout())
+				}))
+			}
+			sourceList, err := apiSourceClient.ListAPIServerSource(listConfigs...)
 			if err != nil {
 				return err
 			}
@@ -55,10 +76,6 @@ func NewAPIServerListCommand(p *commands.KnParams) *cobra.Command {
 				return nil
 			}
 
-			if apiSourceClient.Namespace() == "" {
-				listFlags.EnsureWithNamespace()
-			}
-
 			err = listFlags.Print(sourceList, cmd.OutOrStdout())
 			if err != nil {
 				return err
@@ -69,5 +86,7 @@ func NewAPIServerListCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
+	listFlags.AddChunkSizeFlag(listCommand)
+	selectorFilter.Add(listCommand, "ApiServer sources")
 	return listCommand
 }
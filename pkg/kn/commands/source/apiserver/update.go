@@ -30,19 +30,27 @@ import (
 func NewAPIServerUpdateCommand(p *commands.KnParams) *cobra.Command {
 	var updateFlags APIServerSourceUpdateFlags
 	var sinkFlags flags.SinkFlags
+	var dryRunFlags commands.DryRunFlags
 
 	cmd := &cobra.Command{
 		Use:   "update NAME",
 		Short: "Update an api-server source",
 		Example: `
   # Update an ApiServerSource 'k8sevents' with different service account and sink service
-  kn source apiserver update k8sevents --service-account newsa --sink ksvc:newsvc`,
+  kn source apiserver update k8sevents --service-account newsa --sink ksvc:newsvc
+
+  # Validate an update on the server and show the resulting ApiServerSource, without updating it
+  kn source apiserver update k8sevents --service-account newsa --dry-run server`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
 				return errors.New("requires the name of the source as single argument")
 			}
 			name := args[0]
+			err = dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
 
 			// get namespace
 			namespace, err := p.GetNamespace(cmd)
@@ -102,7 +110,11 @@ func NewAPIServerUpdateCommand(p *commands.KnParams) *cobra.Command {
 				b.CloudEventOverrides(ceOverridesMap, ceOverridesToRemove)
 			}
 
-			err = sourcesClient.UpdateAPIServerSource(b.Build())
+			apiSource := b.Build()
+			err = sourcesClient.UpdateAPIServerSource(apiSource, dryRunFlags.UpdateOptions()...)
+			if err == nil && dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(cmd.OutOrStdout(), apiSource)
+			}
 			if err == nil {
 				fmt.Fprintf(cmd.OutOrStdout(), "ApiServer source '%s' updated in namespace '%s'.\n", args[0], namespace)
 			}
@@ -113,5 +125,6 @@ func NewAPIServerUpdateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	updateFlags.Add(cmd)
 	sinkFlags.Add(cmd)
+	dryRunFlags.Add(cmd)
 	return cmd
 }
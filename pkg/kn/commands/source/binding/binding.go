@@ -37,6 +37,7 @@ func NewBindingCommand(p *commands.KnParams) *cobra.Command {
 	}
 	bindingCmd.AddCommand(NewBindingCreateCommand(p))
 	bindingCmd.AddCommand(NewBindingUpdateCommand(p))
+	bindingCmd.AddCommand(NewBindingEditCommand(p))
 	bindingCmd.AddCommand(NewBindingDeleteCommand(p))
 	bindingCmd.AddCommand(NewBindingListCommand(p))
 	bindingCmd.AddCommand(NewBindingDescribeCommand(p))
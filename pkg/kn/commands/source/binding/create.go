@@ -19,7 +19,9 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	clientdynamic "knative.dev/client/pkg/dynamic"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	v1alpha12 "knative.dev/client/pkg/sources/v1alpha2"
@@ -30,13 +32,21 @@ import (
 func NewBindingCreateCommand(p *commands.KnParams) *cobra.Command {
 	var bindingFlags bindingUpdateFlags
 	var sinkFlags flags.SinkFlags
+	var dryRunFlags commands.DryRunFlags
+	var offlineFlags commands.OfflineFlags
 
 	cmd := &cobra.Command{
 		Use:   "create NAME --subject SUBJECT --sink SINK",
 		Short: "Create a sink binding",
 		Example: `
   # Create a sink binding which connects a deployment 'myapp' with a Knative service 'mysvc'
-  kn source binding create my-binding --subject Deployment:apps/v1:myapp --sink ksvc:mysvc`,
+  kn source binding create my-binding --subject Deployment:apps/v1:myapp --sink ksvc:mysvc
+
+  # Validate a sink binding on the server and show it with all defaults applied, without creating it
+  kn source binding create my-binding --subject Deployment:apps/v1:myapp --sink ksvc:mysvc --dry-run server
+
+  # Print the sink binding as YAML without connecting to a cluster
+  kn source binding create my-binding --subject Deployment:apps/v1:myapp --sink ksvc:mysvc --offline -o yaml`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
@@ -44,22 +54,37 @@ func NewBindingCreateCommand(p *commands.KnParams) *cobra.Command {
 
 			}
 			name := args[0]
-
-			sinkBindingClient, err := newSinkBindingClient(p, cmd)
-			if err != nil {
-				return err
-			}
-
-			namespace, err := p.GetNamespace(cmd)
+			err = dryRunFlags.Validate()
 			if err != nil {
 				return err
 			}
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			err = offlineFlags.Validate(cmd)
 			if err != nil {
 				return err
 			}
 
-			destination, err := sinkFlags.ResolveSink(dynamicClient, namespace)
+			var sinkBindingClient v1alpha12.KnSinkBindingClient
+			var namespace string
+			var destination *duckv1.Destination
+			if offlineFlags.Offline {
+				namespace = offlineFlags.Namespace(cmd)
+				destination, err = sinkFlags.ResolveSinkOffline(namespace)
+			} else {
+				sinkBindingClient, err = newSinkBindingClient(p, cmd)
+				if err != nil {
+					return err
+				}
+				namespace, err = p.GetNamespace(cmd)
+				if err != nil {
+					return err
+				}
+				var dynamicClient clientdynamic.KnDynamicClient
+				dynamicClient, err = p.NewDynamicClient(namespace)
+				if err != nil {
+					return err
+				}
+				destination, err = sinkFlags.ResolveSink(dynamicClient, namespace)
+			}
 			if err != nil {
 				return err
 			}
@@ -85,7 +110,13 @@ func NewBindingCreateCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			err = sinkBindingClient.CreateSinkBinding(binding)
+			if offlineFlags.Offline {
+				return offlineFlags.PrintObject(cmd.OutOrStdout(), binding)
+			}
+			err = sinkBindingClient.CreateSinkBinding(binding, dryRunFlags.CreateOptions()...)
+			if err == nil && dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(cmd.OutOrStdout(), binding)
+			}
 			if err == nil {
 				fmt.Fprintf(cmd.OutOrStdout(), "Sink binding '%s' created in namespace '%s'.\n", args[0], sinkBindingClient.Namespace())
 			}
@@ -95,6 +126,8 @@ func NewBindingCreateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	bindingFlags.addBindingFlags(cmd)
 	sinkFlags.Add(cmd)
+	dryRunFlags.Add(cmd)
+	offlineFlags.Add(cmd)
 	cmd.MarkFlagRequired("subject")
 	cmd.MarkFlagRequired("sink")
 
@@ -72,12 +72,16 @@ func NewBindingDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents {
+				return commands.PrintEvents(p, out, binding.Namespace, []commands.EventObject{{Kind: "SinkBinding", Name: binding.Name}})
+			}
 			return nil
 		},
 	}
 	flags := cmd.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.Bool("events", false, "Show the Kubernetes events of the sink binding.")
 
 	return cmd
 }
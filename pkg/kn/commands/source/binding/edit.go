@@ -0,0 +1,100 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+)
+
+// NewBindingEditCommand prepares the command for editing a sink binding in an editor
+func NewBindingEditCommand(p *commands.KnParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit NAME",
+		Short: "Edit a sink binding in an editor",
+		Long: `Edit a sink binding in an editor.
+
+The sink binding is opened as YAML in the editor given with the KUBE_EDITOR or EDITOR environment
+variable, falling back to 'vi'. Its status and runtime metadata are left out. The edited
+sink binding is validated before it gets updated. If the validation or the update fails, the
+editor is reopened with the error shown on top of the file.`,
+		Example: `
+  # Edit the sink binding 'my-binding' in the editor given with $KUBE_EDITOR or $EDITOR
+  kn source binding edit my-binding`,
+
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("requires the name of the sink binding to edit as single argument")
+			}
+			name := args[0]
+
+			sinkBindingClient, err := newSinkBindingClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			source, err := sinkBindingClient.GetSinkBinding(name)
+			if err != nil {
+				return err
+			}
+			if source.GetDeletionTimestamp() != nil {
+				return fmt.Errorf("can't edit binding %s because it has been marked for deletion", name)
+			}
+
+			editable := &v1alpha2.SinkBinding{
+				TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha2.SchemeGroupVersion.String(), Kind: "SinkBinding"},
+				ObjectMeta: commands.EditableObjectMeta(source.ObjectMeta),
+				Spec:       source.Spec,
+			}
+			changed, err := commands.EditInEditor(cmd, "sink binding", name, editable, func(edited []byte) error {
+				return updateEditedSinkBinding(sinkBindingClient, name, edited)
+			})
+			if err != nil || !changed {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Sink binding '%s' updated in namespace '%s'.\n", name, sinkBindingClient.Namespace())
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	return cmd
+}
+
+// updateEditedSinkBinding validates the sink binding given as edited YAML and updates the sink binding with it
+func updateEditedSinkBinding(client clientv1alpha2.KnSinkBindingClient, name string, edited []byte) error {
+	editedSource := &v1alpha2.SinkBinding{}
+	err := commands.ReadEdited(edited, "sink binding", name, client.Namespace(), editedSource)
+	if err != nil {
+		return err
+	}
+
+	return commands.UpdateEditedWithRetry(func() error {
+		source, err := client.GetSinkBinding(name)
+		if err != nil {
+			return err
+		}
+		commands.MergeEditedObjectMeta(&source.ObjectMeta, editedSource.ObjectMeta)
+		source.Spec = editedSource.Spec
+
+		return client.UpdateSinkBinding(source)
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientsourcesv1alpha1 "knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
+)
+
+func TestBindingEdit(t *testing.T) {
+	editor, err := commands.NewTestEditor(`s|name: mydeploy|name: otherdeploy|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	sinkBindingClient := clientsourcesv1alpha1.NewMockKnSinkBindingClient(t)
+	bindingRecorder := sinkBindingClient.Recorder()
+	present := createSinkBinding("testbinding", "mysvc", deploymentGvk, "mydeploy", "default", nil)
+	bindingRecorder.GetSinkBinding("testbinding", present, nil)
+	bindingRecorder.GetSinkBinding("testbinding", present, nil)
+	bindingRecorder.UpdateSinkBinding(nil, nil)
+
+	out, err := executeSinkBindingCommand(sinkBindingClient, nil, "edit", "testbinding")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Sink binding", "updated", "default", "testbinding"))
+	assert.Assert(t, util.ContainsAll(editor.Input(1), "# Please edit the sink binding below", "kind: SinkBinding", "name: mydeploy"))
+	assert.Equal(t, present.Spec.Subject.Name, "otherdeploy")
+	assert.Equal(t, present.Spec.Sink.Ref.Name, "mysvc")
+
+	bindingRecorder.Validate()
+}
+
+func TestBindingEditError(t *testing.T) {
+	sinkBindingClient := clientsourcesv1alpha1.NewMockKnSinkBindingClient(t)
+	bindingRecorder := sinkBindingClient.Recorder()
+	bindingRecorder.GetSinkBinding("testbinding", nil, errors.New("no sink binding testbinding found"))
+
+	_, err := executeSinkBindingCommand(sinkBindingClient, nil, "edit", "testbinding")
+	assert.ErrorContains(t, err, "testbinding")
+
+	_, err = executeSinkBindingCommand(sinkBindingClient, nil, "edit")
+	assert.ErrorContains(t, err, "requires the name of the sink binding")
+
+	bindingRecorder.Validate()
+}
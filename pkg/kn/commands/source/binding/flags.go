@@ -55,6 +55,7 @@ func BindingListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Ready state conditions", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready state of the sink binding", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+		{Name: "Sink URI", Type: "string", Description: "Resolved URI of the sink", Priority: 2},
 	}
 	h.TableHandler(sourceColumnDefinitions, printSinkBinding)
 	h.TableHandler(sourceColumnDefinitions, printSinkBindingList)
@@ -79,6 +80,9 @@ func printSinkBinding(binding *v1alpha2.SinkBinding, options hprinters.PrintOpti
 	}
 
 	row.Cells = append(row.Cells, name, subject, sink, age, conditions, ready, reason)
+	if options.Wide {
+		row.Cells = append(row.Cells, flags.SinkURIToString(binding.Status.SinkURI))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
@@ -18,12 +18,15 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
 )
 
 // NewBindingListCommand is for listing sink bindings
 func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
+	selectorFilter := flags.LabelSelectorFilter{}
 	listFlags := flags.NewListPrintFlags(BindingListHandlers)
 
 	cmd := &cobra.Command{
@@ -34,7 +37,10 @@ func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
   kn source binding list
 
   # List all sink bindings in YAML format
-  kn source binding list -o yaml`,
+  kn source binding list -o yaml
+
+  # List all sink bindings with the label 'team=payments'
+  kn source binding list -l team=payments`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// TODO: filter list by given source name
@@ -44,7 +50,22 @@ func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			sourceList, err := bindingClient.ListSinkBindings()
+			var listConfigs []util.ListConfig
+			if selectorFilter.Selector != "" {
+				listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
+			}
+
+			if bindingClient.Namespace() == "" {
+				listFlags.EnsureWithNamespace()
+			}
+
+			listConfigs = append(listConfigs, util.WithChunkSize(listFlags.ChunkSize))
+			if listFlags.Streaming() {
+				listConfigs = append(listConfigs, util.WithPageHandler(func(page runtime.Object) error {
+					return listFlags.PrintPage(page, cmd.OutOrStdout())
+				}))
+			}
+			sourceList, err := bindingClient.ListSinkBindings(listConfigs...)
 			if err != nil {
 				return err
 			}
@@ -54,10 +75,6 @@ func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
 				return nil
 			}
 
-			if bindingClient.Namespace() == "" {
-				listFlags.EnsureWithNamespace()
-			}
-
 			err = listFlags.Print(sourceList, cmd.OutOrStdout())
 			if err != nil {
 				return err
@@ -68,5 +85,7 @@ func NewBindingListCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(cmd.Flags(), true)
 	listFlags.AddFlags(cmd)
+	listFlags.AddChunkSizeFlag(cmd)
+	selectorFilter.Add(cmd, "sink bindings")
 	return cmd
 }
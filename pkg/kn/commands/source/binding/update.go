@@ -30,19 +30,27 @@ import (
 func NewBindingUpdateCommand(p *commands.KnParams) *cobra.Command {
 	var bindingFlags bindingUpdateFlags
 	var sinkFlags flags.SinkFlags
+	var dryRunFlags commands.DryRunFlags
 
 	cmd := &cobra.Command{
 		Use:   "update NAME",
 		Short: "Update a sink binding",
 		Example: `
   # Update the subject of a sink binding 'my-binding' to a new cronjob with label selector 'app=ping'  
-  kn source binding update my-binding --subject cronjob:batch/v1beta1:app=ping"`,
+  kn source binding update my-binding --subject cronjob:batch/v1beta1:app=ping"
+
+  # Validate an update on the server and show the resulting sink binding, without updating it
+  kn source binding update my-binding --subject cronjob:batch/v1beta1:app=ping --dry-run server`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
 				return errors.New("requires the name of the sink binding to update as single argument")
 			}
 			name := args[0]
+			err = dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
 
 			sinkBindingClient, err := newSinkBindingClient(p, cmd)
 			if err != nil {
@@ -93,7 +101,10 @@ func NewBindingUpdateCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			err = sinkBindingClient.UpdateSinkBinding(binding)
+			err = sinkBindingClient.UpdateSinkBinding(binding, dryRunFlags.UpdateOptions()...)
+			if err == nil && dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(cmd.OutOrStdout(), binding)
+			}
 			if err == nil {
 				fmt.Fprintf(cmd.OutOrStdout(), "Sink binding '%s' updated in namespace '%s'.\n", name, sinkBindingClient.Namespace())
 			}
@@ -103,6 +114,7 @@ func NewBindingUpdateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	bindingFlags.addBindingFlags(cmd)
 	sinkFlags.Add(cmd)
+	dryRunFlags.Add(cmd)
 
 	return cmd
 }
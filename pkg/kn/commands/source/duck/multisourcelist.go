@@ -47,6 +47,8 @@ type Source struct {
 	Resource string
 	// Sink configured for this source object
 	Sink string
+	// Resolved URI of the sink
+	SinkURI string
 	// String representation if source is ready
 	Ready string
 }
@@ -78,12 +80,20 @@ func toSource(u *unstructured.Unstructured) Source {
 	ds.SourceKind = u.GetKind()
 	ds.Resource = getSourceTypeName(u)
 	ds.Sink = findSink(u)
+	ds.SinkURI, _, _ = unstructured.NestedString(u.UnstructuredContent(), "status", "sinkUri")
 	ds.Ready = isReady(u)
 	// set empty GVK
 	ds.APIVersion, ds.Kind = schema.GroupVersionKind{}.ToAPIVersionAndKind()
 	return ds
 }
 
+// ToSource transforms a single eventing source object received as Unstructured
+// object into Source object
+func ToSource(u *unstructured.Unstructured) *Source {
+	ds := toSource(u)
+	return &ds
+}
+
 // ToSourceList transforms list of eventing sources objects received as
 // UnstructuredList object into SourceList object
 func ToSourceList(uList *unstructured.UnstructuredList) *SourceList {
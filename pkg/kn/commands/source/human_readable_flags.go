@@ -53,6 +53,7 @@ func ListHandlers(h printers.PrintHandler) {
 		{Name: "Resource", Type: "string", Description: "Source type name", Priority: 1},
 		{Name: "Sink", Type: "string", Description: "Sink of the source", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready condition status", Priority: 1},
+		{Name: "Sink URI", Type: "string", Description: "Resolved URI of the sink", Priority: 2},
 	}
 	h.TableHandler(sourceListColumnDefinitions, printSource)
 	h.TableHandler(sourceListColumnDefinitions, printSourceList)
@@ -82,9 +83,11 @@ func printSourceTypes(sourceType unstructured.Unstructured, options printers.Pri
 func printSourceTypesList(sourceTypesList *unstructured.UnstructuredList, options printers.PrintOptions) ([]metav1beta1.TableRow, error) {
 	rows := make([]metav1beta1.TableRow, 0, len(sourceTypesList.Items))
 
-	sort.SliceStable(sourceTypesList.Items, func(i, j int) bool {
-		return sourceTypesList.Items[i].GetName() < sourceTypesList.Items[j].GetName()
-	})
+	if !options.Sorted {
+		sort.SliceStable(sourceTypesList.Items, func(i, j int) bool {
+			return sourceTypesList.Items[i].GetName() < sourceTypesList.Items[j].GetName()
+		})
+	}
 	for _, item := range sourceTypesList.Items {
 		row, err := printSourceTypes(item, options)
 		if err != nil {
@@ -113,6 +116,9 @@ func printSource(source *clientduck.Source, options printers.PrintOptions) ([]me
 		source.Sink,
 		source.Ready,
 	)
+	if options.Wide {
+		row.Cells = append(row.Cells, source.SinkURI)
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
@@ -120,9 +126,11 @@ func printSource(source *clientduck.Source, options printers.PrintOptions) ([]me
 func printSourceList(sourceList *clientduck.SourceList, options printers.PrintOptions) ([]metav1beta1.TableRow, error) {
 	rows := make([]metav1beta1.TableRow, 0, len(sourceList.Items))
 
-	sort.SliceStable(sourceList.Items, func(i, j int) bool {
-		return sourceList.Items[i].Name < sourceList.Items[j].Name
-	})
+	if !options.Sorted {
+		sort.SliceStable(sourceList.Items, func(i, j int) bool {
+			return sourceList.Items[i].Name < sourceList.Items[j].Name
+		})
+	}
 	for _, source := range sourceList.Items {
 		row, err := printSource(&source, options)
 		if err != nil {
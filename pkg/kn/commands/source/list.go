@@ -18,6 +18,9 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"knative.dev/client/pkg/dynamic"
 	knerrors "knative.dev/client/pkg/errors"
@@ -25,6 +28,7 @@ import (
 	"knative.dev/client/pkg/kn/commands/flags"
 	"knative.dev/client/pkg/kn/commands/source/duck"
 	sourcesv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
 )
 
 var listExample = `
@@ -35,12 +39,21 @@ var listExample = `
   kn source list --type=PingSource
 
   # List PingSource and ApiServerSource types sources
-  kn source list --type=PingSource --type=apiserversource`
+  kn source list --type=PingSource --type=apiserversource
+
+  # List all sources with the label 'team=payments'
+  kn source list -l team=payments
+
+  # List all sources and watch for changes
+  kn source list --watch`
 
 // NewListCommand defines and processes `kn source list`
 func NewListCommand(p *commands.KnParams) *cobra.Command {
 	filterFlags := &flags.SourceTypeFilters{}
+	selectorFilter := &flags.LabelSelectorFilter{}
 	listFlags := flags.NewListPrintFlags(ListHandlers)
+	// Convert the sources to DuckSources only if human readable table printing is requested
+	listFlags.HumanReadableConverter = toDuckSource
 	listCommand := &cobra.Command{
 		Use:     "list",
 		Short:   "List event sources",
@@ -59,11 +72,23 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 			for _, filter := range filterFlags.Filters {
 				filters = append(filters, dynamic.WithTypeFilter(filter))
 			}
+			if selectorFilter.Selector != "" {
+				filters = append(filters, dynamic.WithListConfig(util.WithLabelSelector(selectorFilter.Selector)))
+			}
+			// empty namespace indicates all namespaces flag is specified
+			if namespace == "" {
+				listFlags.EnsureWithNamespace()
+			}
+			// Sources are fetched in chunks, but not printed page by page as the
+			// sources of all types are sorted by name in the table output
+			filters = append(filters, dynamic.WithListConfig(util.WithChunkSize(listFlags.ChunkSize)))
 
 			sourceList, err := dynamicClient.ListSources(filters...)
 
+			builtInOnly := false
 			switch {
 			case knerrors.IsForbiddenError(err):
+				builtInOnly = true
 				gvks := sourcesv1alpha2.BuiltInSourcesGVKs()
 				if sourceList, err = dynamicClient.ListSourcesUsingGVKs(&gvks, filters...); err != nil {
 					return knerrors.GetError(err)
@@ -72,32 +97,45 @@ func NewListCommand(p *commands.KnParams) *cobra.Command {
 				return knerrors.GetError(err)
 			}
 
-			if sourceList == nil || len(sourceList.Items) == 0 {
+			if (sourceList == nil || len(sourceList.Items) == 0) && !listFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No sources found.\n")
 				return nil
 			}
-			// empty namespace indicates all namespaces flag is specified
-			if namespace == "" {
-				listFlags.EnsureWithNamespace()
-			}
-			printer, err := listFlags.ToPrinter()
-			if err != nil {
-				return nil
-			}
-			if listFlags.GenericPrintFlags.OutputFlagSpecified() {
-				return printer.PrintObj(sourceList, cmd.OutOrStdout())
-			}
-			// Convert the source list to DuckSourceList only if human readable table printing requested
-			sourceDuckList := duck.ToSourceList(sourceList)
-			err = printer.PrintObj(sourceDuckList, cmd.OutOrStdout())
-			if err != nil {
-				return err
+			if listFlags.Watch {
+				var watcher watch.Interface
+				if builtInOnly {
+					gvks := sourcesv1alpha2.BuiltInSourcesGVKs()
+					watcher, err = dynamicClient.WatchSourcesUsingGVKs(&gvks, filters...)
+				} else {
+					watcher, err = dynamicClient.WatchSources(filters...)
+				}
+				if err != nil {
+					return knerrors.GetError(err)
+				}
+				if sourceList == nil {
+					sourceList = &unstructured.UnstructuredList{}
+				}
+				return listFlags.PrintWatch(sourceList, watcher, cmd.OutOrStdout())
 			}
-			return nil
+			return listFlags.Print(sourceList, cmd.OutOrStdout())
 		},
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
+	listFlags.AddWatchFlag(listCommand)
+	listFlags.AddChunkSizeFlag(listCommand)
 	filterFlags.Add(listCommand, "source type")
+	selectorFilter.Add(listCommand, "sources")
 	return listCommand
 }
+
+// toDuckSource converts sources received as unstructured objects for printing them in a table
+func toDuckSource(obj runtime.Object) (runtime.Object, error) {
+	switch u := obj.(type) {
+	case *unstructured.UnstructuredList:
+		return duck.ToSourceList(u), nil
+	case *unstructured.Unstructured:
+		return duck.ToSource(u), nil
+	}
+	return obj, nil
+}
@@ -22,8 +22,10 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 
 	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
 	clientdynamic "knative.dev/client/pkg/dynamic"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/util"
@@ -79,6 +81,16 @@ func TestSourceListTypesNoHeaders(t *testing.T) {
 	assert.Check(t, util.ContainsAll(output[0], "PingSource"))
 }
 
+func TestSourceListTypesSortBy(t *testing.T) {
+	output, err := sourceFakeCmd([]string{"source", "list-types", "--sort-by={.spec.names.kind}"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+		newSourceCRDObjWithSpec("zsources", "sources.knative.dev", "v1alpha1", "ASource"),
+	)
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output[1], "ASource", "zsources"))
+	assert.Check(t, util.ContainsAll(output[2], "PingSource", "pingsources"))
+}
+
 func TestListBuiltInSourceTypes(t *testing.T) {
 	fakeDynamic := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
 	sources, err := listBuiltInSourceTypes(clientdynamic.NewKnDynamicClient(fakeDynamic, "current"))
@@ -109,6 +121,55 @@ func TestSourceList(t *testing.T) {
 	assert.Check(t, util.ContainsAll(output[3], "s1", "SinkBinding", "sinkbindings.sources.knative.dev", "ksvc:foo", "True"))
 }
 
+func TestSourceListWide(t *testing.T) {
+	source := newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource")
+	assert.NilError(t, unstructured.SetNestedField(source.Object, "http://foo.current.example.com", "status", "sinkUri"))
+	output, err := sourceFakeCmd([]string{"source", "list", "-o", "wide"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+		source,
+	)
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output[0], "NAME", "TYPE", "RESOURCE", "SINK", "READY", "SINK URI"))
+	assert.Check(t, util.ContainsAll(output[1], "p1", "PingSource", "ksvc:foo", "True", "http://foo.current.example.com"))
+}
+
+func TestSourceListWatch(t *testing.T) {
+	knParams := &commands.KnParams{}
+	cmd, fakeDynamic, buf := commands.CreateDynamicTestKnCommand(NewSourceCommand(knParams), knParams,
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+		newSourceCRDObjWithSpec("apiserversources", "sources.knative.dev", "v1alpha1", "ApiServerSource"),
+		newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource"),
+	)
+	fakeDynamic.PrependWatchReactor("*", func(a clienttesting.Action) (bool, watch.Interface, error) {
+		watcher := watch.NewFakeWithChanSize(1, false)
+		if a.GetResource().Resource == "pingsources" {
+			watcher.Add(newSourceUnstructuredObj("p2", "sources.knative.dev/v1alpha1", "PingSource"))
+		}
+		watcher.Stop()
+		return true, watcher, nil
+	})
+	cmd.SetArgs([]string{"source", "list", "--watch"})
+	assert.NilError(t, cmd.Execute())
+
+	output := strings.Split(buf.String(), "\n")
+	assert.Check(t, util.ContainsAll(output[0], "NAME", "TYPE", "RESOURCE", "SINK", "READY"))
+	assert.Check(t, util.ContainsAll(output[1], "p1", "PingSource", "pingsources.sources.knative.dev", "ksvc:foo"))
+	assert.Check(t, util.ContainsAll(output[2], "p2", "PingSource", "pingsources.sources.knative.dev", "ksvc:foo"))
+}
+
+func TestSourceListWithSelector(t *testing.T) {
+	labeled := newSourceUnstructuredObj("p2", "sources.knative.dev/v1alpha1", "PingSource")
+	labeled.SetLabels(map[string]string{"team": "payments"})
+	output, err := sourceFakeCmd([]string{"source", "list", "--selector", "team=payments"},
+		newSourceCRDObjWithSpec("pingsources", "sources.knative.dev", "v1alpha1", "PingSource"),
+		newSourceUnstructuredObj("p1", "sources.knative.dev/v1alpha1", "PingSource"),
+		labeled,
+	)
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output[1], "p2", "PingSource"))
+	assert.Check(t, util.ContainsNone(strings.Join(output, "\n"), "p1"))
+}
+
 func TestSourceListUntyped(t *testing.T) {
 	output, err := sourceFakeCmd([]string{"source", "list"},
 		newSourceCRDObjWithSpec("kafkasources", "sources.knative.dev", "v1alpha1", "KafkaSource"),
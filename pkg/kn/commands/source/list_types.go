@@ -65,17 +65,7 @@ func NewListTypesCommand(p *commands.KnParams) *cobra.Command {
 				return fmt.Errorf("no sources found on the backend, please verify the installation")
 			}
 
-			printer, err := listTypesFlags.ToPrinter()
-			if err != nil {
-				return nil
-			}
-
-			err = printer.PrintObj(sourceListTypes, cmd.OutOrStdout())
-			if err != nil {
-				return err
-			}
-
-			return nil
+			return listTypesFlags.Print(sourceListTypes, cmd.OutOrStdout())
 		},
 	}
 	commands.AddNamespaceFlags(listTypesCommand.Flags(), false)
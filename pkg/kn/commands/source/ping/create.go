@@ -19,7 +19,9 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	clientdynamic "knative.dev/client/pkg/dynamic"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
 	"knative.dev/client/pkg/sources/v1alpha2"
@@ -30,13 +32,21 @@ import (
 func NewPingCreateCommand(p *commands.KnParams) *cobra.Command {
 	var updateFlags pingUpdateFlags
 	var sinkFlags flags.SinkFlags
+	var dryRunFlags commands.DryRunFlags
+	var offlineFlags commands.OfflineFlags
 
 	cmd := &cobra.Command{
 		Use:   "create NAME --sink SINK",
 		Short: "Create a ping source",
 		Example: `
   # Create a Ping source 'my-ping' which fires every two minutes and sends '{ value: "hello" }' to service 'mysvc' as a cloudevent
-  kn source ping create my-ping --schedule "*/2 * * * *" --data '{ value: "hello" }' --sink ksvc:mysvc`,
+  kn source ping create my-ping --schedule "*/2 * * * *" --data '{ value: "hello" }' --sink ksvc:mysvc
+
+  # Validate a Ping source on the server and show it with all defaults applied, without creating it
+  kn source ping create my-ping --schedule "*/2 * * * *" --sink ksvc:mysvc --dry-run server
+
+  # Print the Ping source as YAML without connecting to a cluster
+  kn source ping create my-ping --schedule "*/2 * * * *" --sink ksvc:mysvc --offline -o yaml`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
@@ -44,22 +54,37 @@ func NewPingCreateCommand(p *commands.KnParams) *cobra.Command {
 
 			}
 			name := args[0]
-
-			pingSourceClient, err := newPingSourceClient(p, cmd)
+			err = dryRunFlags.Validate()
 			if err != nil {
 				return err
 			}
-
-			namespace, err := p.GetNamespace(cmd)
-			if err != nil {
-				return err
-			}
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			err = offlineFlags.Validate(cmd)
 			if err != nil {
 				return err
 			}
 
-			destination, err := sinkFlags.ResolveSink(dynamicClient, namespace)
+			var pingSourceClient v1alpha2.KnPingSourcesClient
+			var namespace string
+			var destination *duckv1.Destination
+			if offlineFlags.Offline {
+				namespace = offlineFlags.Namespace(cmd)
+				destination, err = sinkFlags.ResolveSinkOffline(namespace)
+			} else {
+				pingSourceClient, err = newPingSourceClient(p, cmd)
+				if err != nil {
+					return err
+				}
+				namespace, err = p.GetNamespace(cmd)
+				if err != nil {
+					return err
+				}
+				var dynamicClient clientdynamic.KnDynamicClient
+				dynamicClient, err = p.NewDynamicClient(namespace)
+				if err != nil {
+					return err
+				}
+				destination, err = sinkFlags.ResolveSink(dynamicClient, namespace)
+			}
 			if err != nil {
 				return err
 			}
@@ -70,13 +95,20 @@ func NewPingCreateCommand(p *commands.KnParams) *cobra.Command {
 			}
 			ceOverridesToRemove := util.ParseMinusSuffix(ceOverridesMap)
 
-			err = pingSourceClient.CreatePingSource(
-				v1alpha2.NewPingSourceBuilder(name).
-					Schedule(updateFlags.schedule).
-					JsonData(updateFlags.data).
-					Sink(*destination).
-					CloudEventOverrides(ceOverridesMap, ceOverridesToRemove).
-					Build())
+			b := v1alpha2.NewPingSourceBuilder(name).
+				Schedule(updateFlags.schedule).
+				JsonData(updateFlags.data).
+				Sink(*destination).
+				CloudEventOverrides(ceOverridesMap, ceOverridesToRemove)
+			if offlineFlags.Offline {
+				return offlineFlags.PrintObject(cmd.OutOrStdout(), b.Namespace(namespace).Build())
+			}
+
+			pingSource := b.Build()
+			err = pingSourceClient.CreatePingSource(pingSource, dryRunFlags.CreateOptions()...)
+			if err == nil && dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(cmd.OutOrStdout(), pingSource)
+			}
 			if err == nil {
 				fmt.Fprintf(cmd.OutOrStdout(), "Ping source '%s' created in namespace '%s'.\n", args[0], pingSourceClient.Namespace())
 			}
@@ -86,6 +118,8 @@ func NewPingCreateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	updateFlags.addFlags(cmd)
 	sinkFlags.Add(cmd)
+	dryRunFlags.Add(cmd)
+	offlineFlags.Add(cmd)
 	cmd.MarkFlagRequired("sink")
 
 	return cmd
@@ -19,6 +19,7 @@ import (
 
 	"gotest.tools/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sourcesv1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	dynamicfake "knative.dev/client/pkg/dynamic/fake"
@@ -69,3 +70,32 @@ func TestNoNameGivenError(t *testing.T) {
 	assert.ErrorContains(t, err, "require")
 	assert.Assert(t, util.ContainsAll(out, "Usage", "require", "name"))
 }
+
+func TestCreatePingSourceDryRun(t *testing.T) {
+	mysvc := &servingv1.Service{
+		TypeMeta:   v1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: v1.ObjectMeta{Name: "mysvc", Namespace: "default"},
+	}
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", mysvc)
+
+	pingClient := v1alpha2.NewMockKnPingSourceClient(t)
+	pingRecorder := pingClient.Recorder()
+	pingRecorder.CreatePingSource(func(t *testing.T, a interface{}) {
+		// Simulate the type information set by the server
+		a.(*sourcesv1alpha2.PingSource).TypeMeta = v1.TypeMeta{Kind: "PingSource", APIVersion: sourcesv1alpha2.SchemeGroupVersion.String()}
+	}, nil)
+
+	out, err := executePingSourceCommand(pingClient, dynamicClient, "create", "--sink", "ksvc:mysvc", "--schedule", "* * * * */2", "testsource", "--dry-run", "server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: PingSource", "name: testsource", "schedule: '* * * * */2'"))
+	assert.Assert(t, util.ContainsNone(out, "created"))
+
+	pingRecorder.Validate()
+}
+
+func TestCreatePingSourceOffline(t *testing.T) {
+	out, err := executePingSourceCommand(nil, nil, "create", "--sink", "ksvc:mysvc", "--schedule", "* * * * */2", "testsource", "--offline", "-o", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, `"kind": "PingSource"`, `"name": "testsource"`, `"schedule": "* * * * */2"`, `"kind": "Service"`))
+	assert.Assert(t, util.ContainsNone(out, "created"))
+}
@@ -87,12 +87,16 @@ func NewPingDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents {
+				return commands.PrintEvents(p, out, pingSource.Namespace, []commands.EventObject{{Kind: "PingSource", Name: pingSource.Name}})
+			}
 			return nil
 		},
 	}
 	flags := pingDescribe.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.Bool("events", false, "Show the Kubernetes events of the ping source.")
 
 	return pingDescribe
 }
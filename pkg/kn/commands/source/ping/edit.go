@@ -0,0 +1,100 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ping
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+)
+
+// NewPingEditCommand prepares the command for editing a ping source in an editor
+func NewPingEditCommand(p *commands.KnParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit NAME",
+		Short: "Edit a ping source in an editor",
+		Long: `Edit a ping source in an editor.
+
+The ping source is opened as YAML in the editor given with the KUBE_EDITOR or EDITOR environment
+variable, falling back to 'vi'. Its status and runtime metadata are left out. The edited
+ping source is validated before it gets updated. If the validation or the update fails, the
+editor is reopened with the error shown on top of the file.`,
+		Example: `
+  # Edit the ping source 'my-ping' in the editor given with $KUBE_EDITOR or $EDITOR
+  kn source ping edit my-ping`,
+
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 {
+				return errors.New("name of Ping source required")
+			}
+			name := args[0]
+
+			pingSourceClient, err := newPingSourceClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			source, err := pingSourceClient.GetPingSource(name)
+			if err != nil {
+				return err
+			}
+			if source.GetDeletionTimestamp() != nil {
+				return fmt.Errorf("can't edit ping source %s because it has been marked for deletion", name)
+			}
+
+			editable := &v1alpha2.PingSource{
+				TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha2.SchemeGroupVersion.String(), Kind: "PingSource"},
+				ObjectMeta: commands.EditableObjectMeta(source.ObjectMeta),
+				Spec:       source.Spec,
+			}
+			changed, err := commands.EditInEditor(cmd, "ping source", name, editable, func(edited []byte) error {
+				return updateEditedPingSource(pingSourceClient, name, edited)
+			})
+			if err != nil || !changed {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Ping source '%s' updated in namespace '%s'.\n", name, pingSourceClient.Namespace())
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	return cmd
+}
+
+// updateEditedPingSource validates the ping source given as edited YAML and updates the ping source with it
+func updateEditedPingSource(client clientv1alpha2.KnPingSourcesClient, name string, edited []byte) error {
+	editedSource := &v1alpha2.PingSource{}
+	err := commands.ReadEdited(edited, "ping source", name, client.Namespace(), editedSource)
+	if err != nil {
+		return err
+	}
+
+	return commands.UpdateEditedWithRetry(func() error {
+		source, err := client.GetPingSource(name)
+		if err != nil {
+			return err
+		}
+		commands.MergeEditedObjectMeta(&source.ObjectMeta, editedSource.ObjectMeta)
+		source.Spec = editedSource.Spec
+
+		return client.UpdatePingSource(source)
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ping
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/assert"
+	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+
+	"knative.dev/client/pkg/kn/commands"
+	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
+	"knative.dev/client/pkg/util"
+)
+
+func TestPingEdit(t *testing.T) {
+	// The invalid schedule is detected locally and the editor is reopened
+	editor, err := commands.NewTestEditor(`s|\* \* \* \* \*/1|every minute|`, `s|every minute|* * * * */3|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	pingSourceClient := clientv1alpha2.NewMockKnPingSourceClient(t)
+	pingRecorder := pingSourceClient.Recorder()
+	pingRecorder.GetPingSource("testsource", createPingSource("testsource", "* * * * */1", "maxwell", "mysvc", nil), nil)
+	pingRecorder.GetPingSource("testsource", createPingSource("testsource", "* * * * */1", "maxwell", "mysvc", nil), nil)
+	pingRecorder.UpdatePingSource(func(t *testing.T, a interface{}) {
+		updated := a.(*v1alpha2.PingSource)
+		assert.Equal(t, updated.Spec.Schedule, "* * * * */3")
+		assert.Equal(t, updated.Spec.JsonData, "maxwell")
+	}, nil)
+
+	out, err := executePingSourceCommand(pingSourceClient, nil, "edit", "testsource")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Ping source", "updated", "default", "testsource"))
+	assert.Assert(t, util.ContainsAll(editor.Input(1), "# Please edit the ping source below", "kind: PingSource", "jsonData: maxwell"))
+	assert.Assert(t, util.ContainsAll(editor.Input(2), "# ping source 'testsource' could not be updated:", "schedule"))
+
+	pingRecorder.Validate()
+}
+
+func TestPingEditError(t *testing.T) {
+	pingSourceClient := clientv1alpha2.NewMockKnPingSourceClient(t)
+	pingRecorder := pingSourceClient.Recorder()
+	pingRecorder.GetPingSource("testsource", nil, errors.New("no Ping source testsource found"))
+
+	_, err := executePingSourceCommand(pingSourceClient, nil, "edit", "testsource")
+	assert.ErrorContains(t, err, "testsource")
+
+	_, err = executePingSourceCommand(pingSourceClient, nil, "edit")
+	assert.ErrorContains(t, err, "name of Ping source required")
+
+	pingRecorder.Validate()
+}
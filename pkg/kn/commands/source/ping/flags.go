@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/flags"
 	hprinters "knative.dev/client/pkg/printers"
 
 	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
@@ -62,6 +63,7 @@ func PingSourceListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Ready state conditions", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready state of the Ping source", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason if state is not Ready", Priority: 1},
+		{Name: "Sink URI", Type: "string", Description: "Resolved URI of the sink", Priority: 2},
 	}
 	h.TableHandler(sourceColumnDefinitions, printSource)
 	h.TableHandler(sourceColumnDefinitions, printSourceList)
@@ -97,20 +99,25 @@ func printSource(source *v1alpha2.PingSource, options hprinters.PrintOptions) ([
 	}
 
 	row.Cells = append(row.Cells, name, schedule, sink, age, conditions, ready, reason)
+	if options.Wide {
+		row.Cells = append(row.Cells, flags.SinkURIToString(source.Status.SinkURI))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
 // printSourceList populates the Ping source list table rows
 func printSourceList(sourceList *v1alpha2.PingSourceList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
-	if options.AllNamespaces {
+	if options.AllNamespaces && !options.Sorted {
 		return printSourceListWithNamespace(sourceList, options)
 	}
 
 	rows := make([]metav1beta1.TableRow, 0, len(sourceList.Items))
 
-	sort.SliceStable(sourceList.Items, func(i, j int) bool {
-		return sourceList.Items[i].GetName() < sourceList.Items[j].GetName()
-	})
+	if !options.Sorted {
+		sort.SliceStable(sourceList.Items, func(i, j int) bool {
+			return sourceList.Items[i].GetName() < sourceList.Items[j].GetName()
+		})
+	}
 
 	for _, item := range sourceList.Items {
 		row, err := printSource(&item, options)
@@ -18,13 +18,16 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
 )
 
 // NewPingListCommand is for listing Ping source COs
 func NewPingListCommand(p *commands.KnParams) *cobra.Command {
+	selectorFilter := flags.LabelSelectorFilter{}
 	listFlags := flags.NewListPrintFlags(PingSourceListHandlers)
 
 	listCommand := &cobra.Command{
@@ -35,7 +38,10 @@ func NewPingListCommand(p *commands.KnParams) *cobra.Command {
   kn source ping list
 
   # List all Ping sources in YAML format
-  kn source ping list -o yaml`,
+  kn source ping list -o yaml
+
+  # List all Ping sources with the label 'team=payments'
+  kn source ping list -l team=payments`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// TODO: filter list by given source name
@@ -45,7 +51,22 @@ func NewPingListCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			sourceList, err := pingClient.ListPingSource()
+			var listConfigs []util.ListConfig
+			if selectorFilter.Selector != "" {
+				listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
+			}
+
+			if pingClient.Namespace() == "" {
+				listFlags.EnsureWithNamespace()
+			}
+
+			listConfigs = append(listConfigs, util.WithChunkSize(listFlags.ChunkSize))
+			if listFlags.Streaming() {
+				listConfigs = append(listConfigs, util.WithPageHandler(func(page runtime.Object) error {
+					return listFlags.PrintPage(page, cmd.OutOrStdout())
+				}))
+			}
+			sourceList, err := pingClient.ListPingSource(listConfigs...)
 			if err != nil {
 				return err
 			}
@@ -55,10 +76,6 @@ func NewPingListCommand(p *commands.KnParams) *cobra.Command {
 				return nil
 			}
 
-			if pingClient.Namespace() == "" {
-				listFlags.EnsureWithNamespace()
-			}
-
 			err = listFlags.Print(sourceList, cmd.OutOrStdout())
 			if err != nil {
 				return err
@@ -69,5 +86,7 @@ func NewPingListCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(listCommand.Flags(), true)
 	listFlags.AddFlags(listCommand)
+	listFlags.AddChunkSizeFlag(listCommand)
+	selectorFilter.Add(listCommand, "Ping sources")
 	return listCommand
 }
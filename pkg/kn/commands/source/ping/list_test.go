@@ -20,6 +20,7 @@ import (
 	"gotest.tools/assert"
 
 	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+	"knative.dev/pkg/apis"
 
 	clientv1alpha2 "knative.dev/client/pkg/sources/v1alpha2"
 	"knative.dev/client/pkg/util"
@@ -43,6 +44,22 @@ func TestListPingSource(t *testing.T) {
 	pingRecorder.Validate()
 }
 
+func TestListPingSourceWide(t *testing.T) {
+	pingClient := clientv1alpha2.NewMockKnPingSourceClient(t)
+
+	pingRecorder := pingClient.Recorder()
+	cJSource := createPingSource("testsource", "* * * * */2", "maxwell", "mysvc", nil)
+	cJSource.Status.SinkURI = apis.HTTP("mysvc.default.example.com")
+	pingRecorder.ListPingSource(&v1alpha2.PingSourceList{Items: []v1alpha2.PingSource{*cJSource}}, nil)
+
+	out, err := executePingSourceCommand(pingClient, nil, "list", "-o", "wide")
+	assert.NilError(t, err, "Sources should be listed")
+	assert.Assert(t, util.ContainsAll(out, "NAME", "SCHEDULE", "SINK", "READY", "SINK URI"))
+	assert.Assert(t, util.ContainsAll(out, "testsource", "mysvc", "http://mysvc.default.example.com"))
+
+	pingRecorder.Validate()
+}
+
 func TestListPingJobSourceEmpty(t *testing.T) {
 	pingClient := clientv1alpha2.NewMockKnPingSourceClient(t)
 
@@ -33,6 +33,7 @@ func NewPingCommand(p *commands.KnParams) *cobra.Command {
 	pingImporterCmd.AddCommand(NewPingDeleteCommand(p))
 	pingImporterCmd.AddCommand(NewPingDescribeCommand(p))
 	pingImporterCmd.AddCommand(NewPingUpdateCommand(p))
+	pingImporterCmd.AddCommand(NewPingEditCommand(p))
 	pingImporterCmd.AddCommand(NewPingListCommand(p))
 	return pingImporterCmd
 }
@@ -30,19 +30,27 @@ import (
 func NewPingUpdateCommand(p *commands.KnParams) *cobra.Command {
 	var updateFlags pingUpdateFlags
 	var sinkFlags flags.SinkFlags
+	var dryRunFlags commands.DryRunFlags
 
 	cmd := &cobra.Command{
 		Use:   "update NAME",
 		Short: "Update a ping source",
 		Example: `
   # Update the schedule of a Ping source 'my-ping' to fire every minute
-  kn source ping update my-ping --schedule "* * * * *"`,
+  kn source ping update my-ping --schedule "* * * * *"
+
+  # Validate an update on the server and show the resulting Ping source, without updating it
+  kn source ping update my-ping --schedule "* * * * *" --dry-run server`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
 				return errors.New("name of Ping source required")
 			}
 			name := args[0]
+			err = dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
 
 			pingSourceClient, err := newPingSourceClient(p, cmd)
 			if err != nil {
@@ -90,7 +98,11 @@ func NewPingUpdateCommand(p *commands.KnParams) *cobra.Command {
 				b.CloudEventOverrides(ceOverridesMap, ceOverridesToRemove)
 			}
 
-			err = pingSourceClient.UpdatePingSource(b.Build())
+			pingSource := b.Build()
+			err = pingSourceClient.UpdatePingSource(pingSource, dryRunFlags.UpdateOptions()...)
+			if err == nil && dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(cmd.OutOrStdout(), pingSource)
+			}
 			if err == nil {
 				fmt.Fprintf(cmd.OutOrStdout(), "Ping source '%s' updated in namespace '%s'.\n", name, pingSourceClient.Namespace())
 			}
@@ -100,6 +112,7 @@ func NewPingUpdateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	updateFlags.addFlags(cmd)
 	sinkFlags.Add(cmd)
+	dryRunFlags.Add(cmd)
 
 	return cmd
 }
@@ -16,7 +16,10 @@ package commands
 
 import (
 	"bytes"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,6 +34,7 @@ import (
 	sourcesv1alpha2fake "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2/fake"
 
 	clientdynamic "knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/util"
 )
 
 const FakeNamespace = "current"
@@ -104,3 +108,56 @@ func NewTestCommand(subCommand *cobra.Command, params *KnParams) *cobra.Command
 	rootCmd.AddCommand(subCommand)
 	return rootCmd
 }
+
+// TestEditor is a fake editor for testing the editing of objects. On its n-th invocation
+// it applies the n-th of the given sed scripts to the edited file.
+type TestEditor struct {
+	dir      string
+	original string
+	found    bool
+}
+
+// NewTestEditor creates a fake editor and configures it as editor to use
+func NewTestEditor(sedScripts ...string) (*TestEditor, error) {
+	dir, err := ioutil.TempDir("", "kn-test-editor")
+	if err != nil {
+		return nil, err
+	}
+	for i, script := range sedScripts {
+		err := ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("edit-%d.sed", i+1)), []byte(script+"\n"), 0600)
+		if err != nil {
+			return nil, err
+		}
+	}
+	editor := filepath.Join(dir, "editor.sh")
+	err = ioutil.WriteFile(editor, []byte(fmt.Sprintf(`#!/bin/sh
+count=$(( $(cat "%[1]s/count" 2>/dev/null || echo 0) + 1 ))
+echo $count > "%[1]s/count"
+cp "$1" "%[1]s/input-$count"
+sed -f "%[1]s/edit-$count.sed" "$1" > "%[1]s/output" && cp "%[1]s/output" "$1"
+`, dir)), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	testEditor := &TestEditor{dir: dir}
+	testEditor.original, testEditor.found = os.LookupEnv(util.EditorEnvs[0])
+	os.Setenv(util.EditorEnvs[0], editor)
+	return testEditor, nil
+}
+
+// Input returns the content of the file as it was opened on the n-th invocation, starting with 1
+func (e *TestEditor) Input(n int) string {
+	content, _ := ioutil.ReadFile(filepath.Join(e.dir, fmt.Sprintf("input-%d", n)))
+	return string(content)
+}
+
+// Close removes the fake editor and restores the original editor configuration
+func (e *TestEditor) Close() {
+	if e.found {
+		os.Setenv(util.EditorEnvs[0], e.original)
+	} else {
+		os.Unsetenv(util.EditorEnvs[0])
+	}
+	os.RemoveAll(e.dir)
+}
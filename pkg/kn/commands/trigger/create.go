@@ -22,6 +22,7 @@ import (
 
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	clientdynamic "knative.dev/client/pkg/dynamic"
 	clientv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
@@ -31,6 +32,8 @@ import (
 func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
 	var triggerUpdateFlags TriggerUpdateFlags
 	var sinkFlags flags.SinkFlags
+	var dryRunFlags commands.DryRunFlags
+	var offlineFlags commands.OfflineFlags
 
 	cmd := &cobra.Command{
 		Use:   "create NAME --sink SINK",
@@ -40,30 +43,45 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
   kn trigger create mytrigger --broker default --sink ksvc:mysvc
 
   # Create a trigger to filter events with attribute 'type=dev.knative.foo'
-  kn trigger create mytrigger --broker default --filter type=dev.knative.foo --sink ksvc:mysvc`,
+  kn trigger create mytrigger --broker default --filter type=dev.knative.foo --sink ksvc:mysvc
+
+  # Validate a trigger on the server and show it with all defaults applied, without creating it
+  kn trigger create mytrigger --broker default --filter type=dev.knative.foo --sink ksvc:mysvc --dry-run server
+
+  # Print the trigger as YAML without connecting to a cluster
+  kn trigger create mytrigger --broker default --filter type=dev.knative.foo --sink ksvc:mysvc --offline -o yaml`,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if len(args) != 1 {
 				return errors.New("'trigger create' requires the name of the trigger")
 			}
 			name := args[0]
-
-			namespace, err := p.GetNamespace(cmd)
+			err = dryRunFlags.Validate()
 			if err != nil {
 				return err
 			}
-
-			dynamicClient, err := p.NewDynamicClient(namespace)
+			err = offlineFlags.Validate(cmd)
 			if err != nil {
 				return err
 			}
 
-			eventingClient, err := p.NewEventingClient(namespace)
-			if err != nil {
-				return err
+			var namespace string
+			var objectRef *duckv1.Destination
+			if offlineFlags.Offline {
+				namespace = offlineFlags.Namespace(cmd)
+				objectRef, err = sinkFlags.ResolveSinkOffline(namespace)
+			} else {
+				namespace, err = p.GetNamespace(cmd)
+				if err != nil {
+					return err
+				}
+				var dynamicClient clientdynamic.KnDynamicClient
+				dynamicClient, err = p.NewDynamicClient(namespace)
+				if err != nil {
+					return err
+				}
+				objectRef, err = sinkFlags.ResolveSink(dynamicClient, namespace)
 			}
-
-			objectRef, err := sinkFlags.ResolveSink(dynamicClient, namespace)
 			if err != nil {
 				return fmt.Errorf(
 					"cannot create trigger '%s' in namespace '%s' "+
@@ -96,12 +114,24 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
 				}
 			}
 
-			err = eventingClient.CreateTrigger(triggerBuilder.Build())
+			trigger := triggerBuilder.Build()
+			if offlineFlags.Offline {
+				return offlineFlags.PrintObject(cmd.OutOrStdout(), trigger)
+			}
+
+			eventingClient, err := p.NewEventingClient(namespace)
+			if err != nil {
+				return err
+			}
+			err = eventingClient.CreateTrigger(trigger, dryRunFlags.CreateOptions()...)
 			if err != nil {
 				return fmt.Errorf(
 					"cannot create trigger '%s' in namespace '%s' "+
 						"because: %s", name, namespace, err)
 			}
+			if dryRunFlags.IsServer() {
+				return commands.PrintDryRunResult(cmd.OutOrStdout(), trigger)
+			}
 			fmt.Fprintf(cmd.OutOrStdout(), "Trigger '%s' successfully created in namespace '%s'.\n", args[0], namespace)
 			return nil
 		},
@@ -109,6 +139,8 @@ func NewTriggerCreateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	triggerUpdateFlags.Add(cmd)
 	sinkFlags.Add(cmd)
+	dryRunFlags.Add(cmd)
+	offlineFlags.Add(cmd)
 	cmd.MarkFlagRequired("sink")
 
 	return cmd
@@ -20,6 +20,7 @@ import (
 
 	"gotest.tools/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1beta1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	dynamicfake "knative.dev/client/pkg/dynamic/fake"
@@ -132,3 +133,37 @@ func TestTriggerCreateWithoutFilter(t *testing.T) {
 
 	eventingRecorder.Validate()
 }
+
+func TestTriggerCreateDryRun(t *testing.T) {
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", &servingv1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mysvc", Namespace: "default"},
+	})
+
+	eventingRecorder := eventingClient.Recorder()
+	eventingRecorder.CreateTrigger(func(t *testing.T, a interface{}) {
+		// Simulate the type information set by the server
+		a.(*v1beta1.Trigger).TypeMeta = metav1.TypeMeta{Kind: "Trigger", APIVersion: v1beta1.SchemeGroupVersion.String()}
+	}, nil)
+
+	out, err := executeTriggerCommand(eventingClient, dynamicClient, "create", triggerName, "--broker", "mybroker",
+		"--filter", "type=dev.knative.foo", "--sink", "ksvc:mysvc", "--dry-run", "server")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: Trigger", "name: foo", "broker: mybroker", "type: dev.knative.foo"))
+	assert.Assert(t, util.ContainsNone(out, "created"))
+
+	eventingRecorder.Validate()
+}
+
+func TestTriggerCreateOffline(t *testing.T) {
+	out, err := executeTriggerCommand(nil, nil, "create", triggerName, "--broker", "mybroker",
+		"--filter", "type=dev.knative.foo", "--sink", "ksvc:mysvc", "--offline")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "kind: Trigger", "apiVersion: eventing.knative.dev/v1beta1", "name: foo",
+		"broker: mybroker", "type: dev.knative.foo", "kind: Service", "name: mysvc"))
+	assert.Assert(t, util.ContainsNone(out, "created", "namespace:"))
+
+	_, err = executeTriggerCommand(nil, nil, "create", triggerName, "--broker", "mybroker", "--sink", "svc:mysvc", "--offline")
+	assert.ErrorContains(t, err, "unsupported sink prefix")
+}
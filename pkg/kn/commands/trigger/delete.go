@@ -24,17 +24,26 @@ import (
 
 // NewTriggerDeleteCommand represent 'revision delete' command
 func NewTriggerDeleteCommand(p *commands.KnParams) *cobra.Command {
+	var dryRunFlags commands.DryRunFlags
+
 	TriggerDeleteCommand := &cobra.Command{
 		Use:   "delete NAME",
 		Short: "Delete a trigger",
 		Example: `
   # Delete a trigger 'mytrigger' in default namespace
-  kn trigger delete mytrigger`,
+  kn trigger delete mytrigger
+
+  # Check whether trigger 'mytrigger' could be deleted, without deleting it
+  kn trigger delete mytrigger --dry-run server`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return errors.New("'trigger delete' requires the name of the trigger as single argument")
 			}
 			name := args[0]
+			err := dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -46,14 +55,19 @@ func NewTriggerDeleteCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			err = eventingClient.DeleteTrigger(name)
+			err = eventingClient.DeleteTrigger(name, dryRunFlags.DeleteOptions()...)
 			if err != nil {
 				return err
 			}
+			if dryRunFlags.IsServer() {
+				fmt.Fprintf(cmd.OutOrStdout(), "Trigger '%s' would be deleted in namespace '%s' (server dry run).\n", name, namespace)
+				return nil
+			}
 			fmt.Fprintf(cmd.OutOrStdout(), "Trigger '%s' deleted in namespace '%s'.\n", args[0], namespace)
 			return nil
 		},
 	}
 	commands.AddNamespaceFlags(TriggerDeleteCommand.Flags(), false)
+	dryRunFlags.Add(TriggerDeleteCommand)
 	return TriggerDeleteCommand
 }
@@ -86,12 +86,16 @@ func NewTriggerDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			if showEvents, _ := cmd.Flags().GetBool("events"); showEvents {
+				return commands.PrintEvents(p, out, trigger.Namespace, []commands.EventObject{{Kind: "Trigger", Name: trigger.Name}})
+			}
 			return nil
 		},
 	}
 	flags := triggerDescribe.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.Bool("events", false, "Show the Kubernetes events of the trigger.")
 
 	return triggerDescribe
 }
@@ -0,0 +1,104 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
+
+	clientv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
+	"knative.dev/client/pkg/kn/commands"
+)
+
+// NewTriggerEditCommand returns a new command for editing a trigger in an editor
+func NewTriggerEditCommand(p *commands.KnParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit NAME",
+		Short: "Edit a trigger in an editor",
+		Long: `Edit a trigger in an editor.
+
+The trigger is opened as YAML in the editor given with the KUBE_EDITOR or EDITOR environment
+variable, falling back to 'vi'. Its status and runtime metadata are left out. The edited trigger
+is validated before the trigger gets updated. If the validation or the update fails, the editor
+is reopened with the error shown on top of the file.`,
+		Example: `
+  # Edit the trigger 'mytrigger' in the editor given with $KUBE_EDITOR or $EDITOR
+  kn trigger edit mytrigger`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("name of trigger required")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+
+			eventingClient, err := p.NewEventingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			trigger, err := eventingClient.GetTrigger(name)
+			if err != nil {
+				return err
+			}
+			if trigger.GetDeletionTimestamp() != nil {
+				return fmt.Errorf("can't edit trigger %s because it has been marked for deletion", name)
+			}
+
+			editable := &v1beta1.Trigger{
+				TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "Trigger"},
+				ObjectMeta: commands.EditableObjectMeta(trigger.ObjectMeta),
+				Spec:       trigger.Spec,
+			}
+			changed, err := commands.EditInEditor(cmd, "trigger", name, editable, func(edited []byte) error {
+				return updateEditedTrigger(eventingClient, name, namespace, edited)
+			})
+			if err != nil || !changed {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Trigger '%s' updated in namespace '%s'.\n", name, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	return cmd
+}
+
+// updateEditedTrigger validates the trigger given as edited YAML and updates the trigger with it
+func updateEditedTrigger(client clientv1beta1.KnEventingClient, name string, namespace string, edited []byte) error {
+	editedTrigger := &v1beta1.Trigger{}
+	err := commands.ReadEdited(edited, "trigger", name, namespace, editedTrigger)
+	if err != nil {
+		return err
+	}
+
+	return commands.UpdateEditedWithRetry(func() error {
+		trigger, err := client.GetTrigger(name)
+		if err != nil {
+			return err
+		}
+		commands.MergeEditedObjectMeta(&trigger.ObjectMeta, editedTrigger.ObjectMeta)
+		trigger.Spec = editedTrigger.Spec
+
+		return client.UpdateTrigger(trigger)
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clienteventingv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+)
+
+func TestTriggerEdit(t *testing.T) {
+	editor, err := commands.NewTestEditor(`s|type: dev.knative.foo|type: dev.knative.new|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+	present := createTriggerWithStatus("default", triggerName, map[string]string{"type": "dev.knative.foo"}, "mybroker", "mysvc")
+	eventingRecorder.GetTrigger(triggerName, present, nil)
+	eventingRecorder.GetTrigger(triggerName, present, nil)
+	// A conflicting update is retried with the latest trigger
+	eventingRecorder.UpdateTrigger(nil, apierrors.NewConflict(schema.GroupResource{Resource: "triggers"}, triggerName, fmt.Errorf("modified")))
+	eventingRecorder.GetTrigger(triggerName, present, nil)
+	eventingRecorder.UpdateTrigger(nil, nil)
+
+	out, err := executeTriggerCommand(eventingClient, nil, "edit", triggerName)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Trigger", triggerName, "updated", "namespace", "default"))
+	assert.Assert(t, util.ContainsAll(editor.Input(1), "# Please edit the trigger below", "kind: Trigger", "broker: mybroker", "type: dev.knative.foo"))
+	assert.Assert(t, util.ContainsNone(editor.Input(1), "resourceVersion", "subscriberUri", "Ready"))
+	assert.Equal(t, present.Spec.Filter.Attributes["type"], "dev.knative.new")
+
+	eventingRecorder.Validate()
+}
+
+func TestTriggerEditReopenOnError(t *testing.T) {
+	editor, err := commands.NewTestEditor(`s|type: dev.knative.foo|TYPE: dev.knative.foo|`, `s|TYPE: dev.knative.foo|type: dev.knative.foo|`)
+	assert.NilError(t, err)
+	defer editor.Close()
+
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+	present := createTrigger("default", triggerName, map[string]string{"type": "dev.knative.foo"}, "mybroker", "mysvc")
+	eventingRecorder.GetTrigger(triggerName, present, nil)
+	// The invalid filter is detected locally, the trigger is updated after fixing it
+	eventingRecorder.GetTrigger(triggerName, present, nil)
+	eventingRecorder.UpdateTrigger(nil, nil)
+
+	out, err := executeTriggerCommand(eventingClient, nil, "edit", triggerName)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Trigger", triggerName, "updated"))
+	assert.Assert(t, util.ContainsAll(editor.Input(2), "# trigger 'foo' could not be updated:", "Invalid attribute name: \"TYPE\""))
+
+	eventingRecorder.Validate()
+}
+
+func TestTriggerEditWithError(t *testing.T) {
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+	eventingRecorder.GetTrigger(triggerName, nil, fmt.Errorf("trigger not found"))
+
+	out, err := executeTriggerCommand(eventingClient, nil, "edit", triggerName)
+	assert.ErrorContains(t, err, "trigger not found")
+	assert.Assert(t, util.ContainsAll(out, "Usage", "trigger edit NAME"))
+
+	_, err = executeTriggerCommand(eventingClient, nil, "edit")
+	assert.ErrorContains(t, err, "name of trigger required")
+
+	eventingRecorder.Validate()
+}
@@ -18,14 +18,17 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"knative.dev/client/pkg/kn/commands"
 	"knative.dev/client/pkg/kn/commands/flags"
+	"knative.dev/client/pkg/util"
 )
 
 // NewTriggerListCommand represents 'kn trigger list' command
 func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
 	triggerListFlags := flags.NewListPrintFlags(TriggerListHandlers)
+	selectorFilter := flags.LabelSelectorFilter{}
 
 	triggerListCommand := &cobra.Command{
 		Use:   "list",
@@ -35,7 +38,16 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
   kn trigger list
 
   # List all triggers in JSON output format
-  kn trigger list -o json`,
+  kn trigger list -o json
+
+  # List all triggers with the label 'team=payments'
+  kn trigger list -l team=payments
+
+  # List all triggers with additional columns like the filter and the resolved subscriber URI
+  kn trigger list -o wide
+
+  # List all triggers and watch for changes
+  kn trigger list --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -45,18 +57,37 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			triggerList, err := client.ListTriggers()
+			var listConfigs []util.ListConfig
+			if selectorFilter.Selector != "" {
+				listConfigs = append(listConfigs, util.WithLabelSelector(selectorFilter.Selector))
+			}
+
+			// empty namespace indicates all-namespaces flag is specified
+			if namespace == "" {
+				triggerListFlags.EnsureWithNamespace()
+			}
+
+			listConfigs = append(listConfigs, util.WithChunkSize(triggerListFlags.ChunkSize))
+			if triggerListFlags.Streaming() {
+				listConfigs = append(listConfigs, util.WithPageHandler(func(page runtime.Object) error {
+					return triggerListFlags.PrintPage(page, cmd.OutOrStdout())
+				}))
+			}
+			triggerList, err := client.ListTriggers(listConfigs...)
 			if err != nil {
 				return err
 			}
-			if len(triggerList.Items) == 0 {
+			if len(triggerList.Items) == 0 && !triggerListFlags.Watch {
 				fmt.Fprintf(cmd.OutOrStdout(), "No triggers found.\n")
 				return nil
 			}
 
-			// empty namespace indicates all-namespaces flag is specified
-			if namespace == "" {
-				triggerListFlags.EnsureWithNamespace()
+			if triggerListFlags.Watch {
+				watcher, err := client.WatchTriggers(listConfigs...)
+				if err != nil {
+					return err
+				}
+				return triggerListFlags.PrintWatch(triggerList, watcher, cmd.OutOrStdout())
 			}
 
 			err = triggerListFlags.Print(triggerList, cmd.OutOrStdout())
@@ -68,5 +99,8 @@ func NewTriggerListCommand(p *commands.KnParams) *cobra.Command {
 	}
 	commands.AddNamespaceFlags(triggerListCommand.Flags(), true)
 	triggerListFlags.AddFlags(triggerListCommand)
+	triggerListFlags.AddWatchFlag(triggerListCommand)
+	triggerListFlags.AddChunkSizeFlag(triggerListCommand)
+	selectorFilter.Add(triggerListCommand, "triggers")
 	return triggerListCommand
 }
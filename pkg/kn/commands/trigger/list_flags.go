@@ -16,6 +16,7 @@ package trigger
 
 import (
 	"sort"
+	"strings"
 
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +37,8 @@ func TriggerListHandlers(h hprinters.PrintHandler) {
 		{Name: "Conditions", Type: "string", Description: "Ready state conditions.", Priority: 1},
 		{Name: "Ready", Type: "string", Description: "Ready condition status of the trigger.", Priority: 1},
 		{Name: "Reason", Type: "string", Description: "Reason for non-ready condition of the trigger.", Priority: 1},
+		{Name: "Filter", Type: "string", Description: "Attributes the events are filtered on.", Priority: 2},
+		{Name: "Subscriber URI", Type: "string", Description: "Resolved URI of the subscriber.", Priority: 2},
 	}
 	h.TableHandler(sourceTypesColumnDefinitions, printTrigger)
 	h.TableHandler(sourceTypesColumnDefinitions, printTriggerList)
@@ -67,9 +70,25 @@ func printTrigger(trigger *v1beta1.Trigger, options hprinters.PrintOptions) ([]m
 		conditions,
 		ready,
 		reason)
+	if options.Wide {
+		row.Cells = append(row.Cells, triggerFilter(trigger), flags.SinkURIToString(trigger.Status.SubscriberURI))
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
+// triggerFilter returns the filter attributes of the trigger as sorted key=value pairs
+func triggerFilter(trigger *v1beta1.Trigger) string {
+	if trigger.Spec.Filter == nil {
+		return ""
+	}
+	var attributes []string
+	for key, value := range trigger.Spec.Filter.Attributes {
+		attributes = append(attributes, key+"="+value)
+	}
+	sort.Strings(attributes)
+	return strings.Join(attributes, ",")
+}
+
 // printTriggerListWithNamespace populates the knative service table rows with namespace column
 func printTriggerListWithNamespace(triggerList *v1beta1.TriggerList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
 	rows := make([]metav1beta1.TableRow, 0, len(triggerList.Items))
@@ -107,7 +126,7 @@ func printTriggerListWithNamespace(triggerList *v1beta1.TriggerList, options hpr
 func printTriggerList(triggerList *v1beta1.TriggerList, options hprinters.PrintOptions) ([]metav1beta1.TableRow, error) {
 	rows := make([]metav1beta1.TableRow, 0, len(triggerList.Items))
 
-	if options.AllNamespaces {
+	if options.AllNamespaces && !options.Sorted {
 		return printTriggerListWithNamespace(triggerList, options)
 	}
 
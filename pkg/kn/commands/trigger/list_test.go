@@ -20,6 +20,7 @@ import (
 
 	"gotest.tools/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
 	eventingv1beta1 "knative.dev/eventing/pkg/apis/eventing/v1beta1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -57,6 +58,29 @@ func TestTriggerList(t *testing.T) {
 	eventingRecorder.Validate()
 }
 
+func TestTriggerListWatch(t *testing.T) {
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	trigger1 := createTriggerWithStatus("default", "trigger1", map[string]string{"type": "dev.knative.foo"}, "mybroker1", "mysink")
+	eventingRecorder.ListTriggers(&eventingv1beta1.TriggerList{Items: []eventingv1beta1.Trigger{*trigger1}}, nil)
+	trigger2 := createTriggerWithStatus("default", "trigger2", map[string]string{"type": "dev.knative.bar"}, "mybroker2", "mysink")
+	watcher := watch.NewFakeWithChanSize(1, false)
+	watcher.Add(trigger2)
+	watcher.Stop()
+	eventingRecorder.WatchTriggers(watcher, nil)
+
+	output, err := executeTriggerCommand(eventingClient, nil, "list", "--watch")
+	assert.NilError(t, err)
+
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsAll(outputLines[0], "NAME", "BROKER", "SINK", "AGE", "CONDITIONS", "READY", "REASON"))
+	assert.Check(t, util.ContainsAll(outputLines[1], "trigger1", "mybroker1", "mysink"))
+	assert.Check(t, util.ContainsAll(outputLines[2], "trigger2", "mybroker2", "mysink"))
+
+	eventingRecorder.Validate()
+}
+
 func TestTriggerListEmpty(t *testing.T) {
 	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
 	eventingRecorder := eventingClient.Recorder()
@@ -97,3 +121,39 @@ func TestTriggerListAllNamespace(t *testing.T) {
 
 	eventingRecorder.Validate()
 }
+
+func TestTriggerListWide(t *testing.T) {
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	trigger1 := createTriggerWithStatus("default", "trigger1", map[string]string{"type": "dev.knative.foo", "source": "bar"}, "mybroker1", "mysink")
+	eventingRecorder.ListTriggers(&eventingv1beta1.TriggerList{Items: []eventingv1beta1.Trigger{*trigger1}}, nil)
+
+	output, err := executeTriggerCommand(eventingClient, nil, "list", "-o", "wide")
+	assert.NilError(t, err)
+
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsAll(outputLines[0], "NAME", "BROKER", "SINK", "READY", "FILTER", "SUBSCRIBER URI"))
+	assert.Check(t, util.ContainsAll(outputLines[1], "trigger1", "mybroker1", "source=bar,type=dev.knative.foo", "http://mysink"))
+
+	eventingRecorder.Validate()
+}
+
+func TestTriggerListAllNamespaceSortBy(t *testing.T) {
+	eventingClient := clienteventingv1beta1.NewMockKnEventingClient(t)
+	eventingRecorder := eventingClient.Recorder()
+
+	trigger1 := createTriggerWithStatus("other", "trigger1", map[string]string{"type": "dev.knative.foo"}, "mybroker1", "mysink")
+	trigger2 := createTriggerWithStatus("default", "trigger2", map[string]string{"type": "dev.knative.foo"}, "mybroker2", "mysink")
+	eventingRecorder.ListTriggers(&eventingv1beta1.TriggerList{Items: []eventingv1beta1.Trigger{*trigger2, *trigger1}}, nil)
+
+	output, err := executeTriggerCommand(eventingClient, nil, "list", "--all-namespaces", "--sort-by={.metadata.name}")
+	assert.NilError(t, err)
+
+	// The triggers of the default namespace are not moved to the top when sorting explicitly
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsAll(outputLines[1], "other", "trigger1"))
+	assert.Check(t, util.ContainsAll(outputLines[2], "default", "trigger2"))
+
+	eventingRecorder.Validate()
+}
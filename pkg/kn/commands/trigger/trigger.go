@@ -33,6 +33,7 @@ func NewTriggerCommand(p *commands.KnParams) *cobra.Command {
 	}
 	triggerCmd.AddCommand(NewTriggerCreateCommand(p))
 	triggerCmd.AddCommand(NewTriggerUpdateCommand(p))
+	triggerCmd.AddCommand(NewTriggerEditCommand(p))
 	triggerCmd.AddCommand(NewTriggerDescribeCommand(p))
 	triggerCmd.AddCommand(NewTriggerListCommand(p))
 	triggerCmd.AddCommand(NewTriggerDeleteCommand(p))
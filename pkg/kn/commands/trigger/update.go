@@ -34,6 +34,7 @@ import (
 func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 	var triggerUpdateFlags TriggerUpdateFlags
 	var sinkFlags flags.SinkFlags
+	var dryRunFlags commands.DryRunFlags
 
 	cmd := &cobra.Command{
 		Use:   "update NAME",
@@ -47,6 +48,9 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 
   # Update the sink of a trigger 'mytrigger' to 'ksvc:new-service'
   kn trigger update mytrigger --sink ksvc:new-service
+
+  # Validate an update on the server and show the resulting trigger, without updating it
+  kn trigger update mytrigger --filter type=knative.dev.bar --dry-run server
   `,
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -54,6 +58,10 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 				return errors.New("name of trigger required")
 			}
 			name := args[0]
+			err = dryRunFlags.Validate()
+			if err != nil {
+				return err
+			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -104,7 +112,8 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 						URI: destination.URI,
 					})
 				}
-				err = eventingClient.UpdateTrigger(b.Build())
+				updatedTrigger := b.Build()
+				err = eventingClient.UpdateTrigger(updatedTrigger, dryRunFlags.UpdateOptions()...)
 				if err != nil {
 					if apierrors.IsConflict(err) && retries < MaxUpdateRetries {
 						retries++
@@ -112,6 +121,9 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 					}
 					return err
 				}
+				if dryRunFlags.IsServer() {
+					return commands.PrintDryRunResult(cmd.OutOrStdout(), updatedTrigger)
+				}
 				fmt.Fprintf(cmd.OutOrStdout(), "Trigger '%s' updated in namespace '%s'.\n", name, namespace)
 				return nil
 			}
@@ -121,6 +133,7 @@ func NewTriggerUpdateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(cmd.Flags(), false)
 	triggerUpdateFlags.Add(cmd)
 	sinkFlags.Add(cmd)
+	dryRunFlags.Add(cmd)
 
 	return cmd
 }
@@ -21,6 +21,7 @@ import (
 	"path/filepath"
 
 	"k8s.io/client-go/dynamic"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	eventingv1beta1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/eventing/v1beta1"
@@ -30,6 +31,7 @@ import (
 	"knative.dev/client/pkg/sources/v1alpha2"
 	"knative.dev/client/pkg/util"
 
+	clientcorev1 "knative.dev/client/pkg/core/v1"
 	clientdynamic "knative.dev/client/pkg/dynamic"
 	knerrors "knative.dev/client/pkg/errors"
 	clienteventingv1beta1 "knative.dev/client/pkg/eventing/v1beta1"
@@ -45,6 +47,11 @@ type KnParams struct {
 	NewSourcesClient  func(namespace string) (v1alpha2.KnSourcesClient, error)
 	NewEventingClient func(namespace string) (clienteventingv1beta1.KnEventingClient, error)
 	NewDynamicClient  func(namespace string) (clientdynamic.KnDynamicClient, error)
+	NewCoreClient     func(namespace string) (clientcorev1.KnCoreClient, error)
+
+	// NewServingClientForContext creates a serving client for another context of the
+	// kubeconfig. The namespace of this context is used if no namespace is given.
+	NewServingClientForContext func(kubeContext string, namespace string) (clientservingv1.KnServingClient, error)
 
 	// General global options
 	LogHTTP bool
@@ -69,6 +76,14 @@ func (params *KnParams) Initialize() {
 	if params.NewDynamicClient == nil {
 		params.NewDynamicClient = params.newDynamicClient
 	}
+
+	if params.NewCoreClient == nil {
+		params.NewCoreClient = params.newCoreClient
+	}
+
+	if params.NewServingClientForContext == nil {
+		params.NewServingClientForContext = params.newServingClientForContext
+	}
 }
 
 func (params *KnParams) newServingClient(namespace string) (clientservingv1.KnServingClient, error) {
@@ -81,6 +96,26 @@ func (params *KnParams) newServingClient(namespace string) (clientservingv1.KnSe
 	return clientservingv1.NewKnServingClient(client, namespace), nil
 }
 
+func (params *KnParams) newServingClientForContext(kubeContext string, namespace string) (clientservingv1.KnServingClient, error) {
+	clientConfig, err := params.getClientConfig(&clientcmd.ConfigOverrides{CurrentContext: kubeContext})
+	if err != nil {
+		return nil, knerrors.GetError(err)
+	}
+	restConfig, err := params.restConfig(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	if namespace == "" {
+		namespace, _, err = clientConfig.Namespace()
+		if err != nil {
+			return nil, knerrors.GetError(err)
+		}
+	}
+
+	client, _ := servingv1client.NewForConfig(restConfig)
+	return clientservingv1.NewKnServingClient(client, namespace), nil
+}
+
 func (params *KnParams) newSourcesClient(namespace string) (v1alpha2.KnSourcesClient, error) {
 	restConfig, err := params.RestConfig()
 	if err != nil {
@@ -111,6 +146,16 @@ func (params *KnParams) newDynamicClient(namespace string) (clientdynamic.KnDyna
 	return clientdynamic.NewKnDynamicClient(client, namespace), nil
 }
 
+func (params *KnParams) newCoreClient(namespace string) (clientcorev1.KnCoreClient, error) {
+	restConfig, err := params.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, _ := corev1client.NewForConfig(restConfig)
+	return clientcorev1.NewKnCoreClient(client, namespace), nil
+}
+
 // RestConfig returns REST config, which can be to use to create specific clientset
 func (params *KnParams) RestConfig() (*rest.Config, error) {
 	var err error
@@ -122,7 +167,12 @@ func (params *KnParams) RestConfig() (*rest.Config, error) {
 		}
 	}
 
-	config, err := params.ClientConfig.ClientConfig()
+	return params.restConfig(params.ClientConfig)
+}
+
+// restConfig returns the REST config for the given client config
+func (params *KnParams) restConfig(clientConfig clientcmd.ClientConfig) (*rest.Config, error) {
+	config, err := clientConfig.ClientConfig()
 	if err != nil {
 		return nil, knerrors.GetError(err)
 	}
@@ -137,15 +187,20 @@ func (params *KnParams) RestConfig() (*rest.Config, error) {
 
 // GetClientConfig gets ClientConfig from KubeCfgPath
 func (params *KnParams) GetClientConfig() (clientcmd.ClientConfig, error) {
+	return params.getClientConfig(&clientcmd.ConfigOverrides{})
+}
+
+// getClientConfig gets ClientConfig from KubeCfgPath with the given overrides applied
+func (params *KnParams) getClientConfig(overrides *clientcmd.ConfigOverrides) (clientcmd.ClientConfig, error) {
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	if len(params.KubeCfgPath) == 0 {
-		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}), nil
+		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides), nil
 	}
 
 	_, err := os.Stat(params.KubeCfgPath)
 	if err == nil {
 		loadingRules.ExplicitPath = params.KubeCfgPath
-		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}), nil
+		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides), nil
 	}
 
 	if !os.IsNotExist(err) {
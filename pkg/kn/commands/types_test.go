@@ -16,6 +16,7 @@ package commands
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
@@ -242,3 +243,77 @@ func TestNewDynamicClient(t *testing.T) {
 		}
 	}
 }
+
+func TestNewCoreClient(t *testing.T) {
+	basic, err := clientcmd.NewClientConfigFromBytes([]byte(BASIC_KUBECONFIG))
+	namespace := "test"
+	if err != nil {
+		t.Error(err)
+	}
+	for i, tc := range []configTestCase{
+		{
+			clientcmd.NewDefaultClientConfig(clientcmdapi.Config{}, &clientcmd.ConfigOverrides{}),
+			"no kubeconfig has been provided, please use a valid configuration to connect to the cluster",
+			false,
+		},
+		{
+			basic,
+			"",
+			false,
+		},
+	} {
+		p := &KnParams{
+			ClientConfig: tc.clientConfig,
+			LogHTTP:      tc.logHttp,
+		}
+
+		coreClient, err := p.newCoreClient(namespace)
+
+		switch len(tc.expectedErrString) {
+		case 0:
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err.Error())
+			}
+		default:
+			if err == nil {
+				t.Errorf("%d: wrong error detected: %s (expected) != %s (actual)", i, tc.expectedErrString, err)
+			}
+			if !strings.Contains(err.Error(), tc.expectedErrString) {
+				t.Errorf("%d: wrong error detected: %s (expected) != %s (actual)", i, tc.expectedErrString, err.Error())
+			}
+		}
+
+		if coreClient != nil {
+			assert.Assert(t, coreClient.Namespace() == namespace)
+		}
+	}
+}
+
+func TestNewServingClientForContext(t *testing.T) {
+	kubeConfig := strings.Replace(BASIC_KUBECONFIG, "current-context: a\n", `- name: b
+  context:
+    cluster: a
+    user: a
+    namespace: staging
+current-context: a
+`, 1)
+	file, err := ioutil.TempFile("", "kubeconfig")
+	assert.NilError(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(kubeConfig)
+	assert.NilError(t, err)
+	file.Close()
+
+	p := &KnParams{KubeCfgPath: file.Name()}
+
+	servingClient, err := p.newServingClientForContext("b", "")
+	assert.NilError(t, err)
+	assert.Equal(t, servingClient.Namespace(), "staging")
+
+	servingClient, err = p.newServingClientForContext("b", "test")
+	assert.NilError(t, err)
+	assert.Equal(t, servingClient.Namespace(), "test")
+
+	_, err = p.newServingClientForContext("unknown", "")
+	assert.ErrorContains(t, err, "unknown")
+}
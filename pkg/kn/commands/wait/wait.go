@@ -0,0 +1,198 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/kn/commands"
+	clientwait "knative.dev/client/pkg/wait"
+)
+
+var waitExample = `
+  # Wait until broker 'default' is ready
+  kn wait broker/default
+
+  # Wait at most 5 minutes until trigger 'mytrigger' has resolved its subscriber
+  kn wait trigger/mytrigger --for condition=SubscriberResolved --timeout 5m
+
+  # Wait until the sink of ping source 'heartbeat' is resolved
+  kn wait pingsource/heartbeat --for condition=SinkProvided
+
+  # Wait until service 'svc' has failed
+  kn wait ksvc/svc --for condition=Ready=False`
+
+// knativeResource is a Knative resource type with the names by which it can be referred to
+type knativeResource struct {
+	names []string
+	gvr   schema.GroupVersionResource
+}
+
+// Resources which can be waited for without looking up the installed source types.
+// The first name is the one used in messages.
+var knativeResources = []knativeResource{
+	{[]string{"service", "services", "ksvc", "kservice"}, schema.GroupVersionResource{Group: "serving.knative.dev", Version: "v1", Resource: "services"}},
+	{[]string{"configuration", "configurations", "config"}, schema.GroupVersionResource{Group: "serving.knative.dev", Version: "v1", Resource: "configurations"}},
+	{[]string{"revision", "revisions", "rev"}, schema.GroupVersionResource{Group: "serving.knative.dev", Version: "v1", Resource: "revisions"}},
+	{[]string{"route", "routes"}, schema.GroupVersionResource{Group: "serving.knative.dev", Version: "v1", Resource: "routes"}},
+	{[]string{"broker", "brokers"}, schema.GroupVersionResource{Group: "eventing.knative.dev", Version: "v1beta1", Resource: "brokers"}},
+	{[]string{"trigger", "triggers"}, schema.GroupVersionResource{Group: "eventing.knative.dev", Version: "v1beta1", Resource: "triggers"}},
+	{[]string{"apiserversource", "apiserversources"}, schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha2", Resource: "apiserversources"}},
+	{[]string{"pingsource", "pingsources"}, schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha2", Resource: "pingsources"}},
+	{[]string{"sinkbinding", "sinkbindings"}, schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha2", Resource: "sinkbindings"}},
+	{[]string{"containersource", "containersources"}, schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha2", Resource: "containersources"}},
+}
+
+// NewWaitCommand returns a new command for waiting on a condition of any Knative resource
+func NewWaitCommand(p *commands.KnParams) *cobra.Command {
+	var waitForFlags commands.WaitForFlags
+
+	command := &cobra.Command{
+		Use:   "wait RESOURCE/NAME",
+		Short: "Wait for a Knative resource to reach a condition",
+		Long: "Wait for a Knative resource to reach a condition.\n\n" +
+			"Supported resources are services (ksvc), configurations, revisions, routes, brokers, triggers and " +
+			"all installed source types (e.g. pingsource).",
+		Example: waitExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'kn wait' requires the resource given as single argument RESOURCE/NAME")
+			}
+			parts := strings.Split(args[0], "/")
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return fmt.Errorf("invalid resource '%s', please specify it as RESOURCE/NAME (e.g. broker/default)", args[0])
+			}
+			name := parts[1]
+			condition, err := waitForFlags.Condition()
+			if err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			gvr, err := resolveResource(client, parts[0])
+			if err != nil {
+				return err
+			}
+			resourceClient := client.RawClient().Resource(gvr).Namespace(namespace)
+
+			// Fail early if the resource does not exist
+			obj, err := resourceClient.Get(name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			kind := obj.GetKind()
+
+			watchMaker := func(name string, timeout time.Duration) (watch.Interface, error) {
+				return clientwait.NewListWatcher(resourceClient.Watch,
+					func(opts metav1.ListOptions) (runtime.Object, error) {
+						return resourceClient.List(opts)
+					}, metav1.ListOptions{
+						FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+					})
+			}
+			waitForCondition := clientwait.NewWaitForCondition(strings.ToLower(kind), condition, watchMaker, conditionsExtractor)
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Waiting for %s '%s' in namespace '%s' to reach condition %s:\n", kind, name, namespace, condition)
+			fmt.Fprintln(out, "")
+			err, duration := waitForCondition.Wait(name, clientwait.Options{Timeout: &waitForFlags.Timeout}, clientwait.SimpleMessageCallback(out))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%7.3fs %s '%s' reached condition %s.\n", float64(duration.Round(time.Millisecond))/float64(time.Second), kind, name, condition)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	waitForFlags.AddFlags(command, "resource")
+	return command
+}
+
+// resolveResource returns the GVR for the given resource name, looking up the
+// installed source types if it is not one of the built-in Knative resources
+func resolveResource(client clientdynamic.KnDynamicClient, resource string) (schema.GroupVersionResource, error) {
+	resource = strings.ToLower(resource)
+	for _, r := range knativeResources {
+		for _, name := range r.names {
+			if name == resource {
+				return r.gvr, nil
+			}
+		}
+	}
+
+	sourceTypes, err := client.ListSourcesTypes()
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	for i := range sourceTypes.Items {
+		gvr, kind, err := clientdynamic.GVRAndKindFromSourceCRD(&sourceTypes.Items[i])
+		if err != nil {
+			continue
+		}
+		if resource == strings.ToLower(kind) || resource == gvr.Resource {
+			return gvr, nil
+		}
+	}
+
+	var supported []string
+	for _, r := range knativeResources {
+		supported = append(supported, r.names[0])
+	}
+	sort.Strings(supported)
+	return schema.GroupVersionResource{}, fmt.Errorf("unknown resource type '%s', supported types are %s and installed source types",
+		resource, strings.Join(supported, ", "))
+}
+
+// conditionsExtractor extracts the status conditions of any Knative resource
+func conditionsExtractor(obj runtime.Object) (apis.Conditions, error) {
+	var content map[string]interface{}
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		content = u.Object
+	} else {
+		var err error
+		content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resource := &duckv1.KResource{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, resource)
+	if err != nil {
+		return nil, fmt.Errorf("cannot extract the status conditions: %v", err)
+	}
+	return apis.Conditions(resource.Status.Conditions), nil
+}
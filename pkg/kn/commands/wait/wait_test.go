@@ -0,0 +1,200 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	dynamicfakeclient "knative.dev/client/pkg/dynamic/fake"
+	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/util"
+	clientwait "knative.dev/client/pkg/wait"
+)
+
+var blankConfig clientcmd.ClientConfig
+
+func init() {
+	var err error
+	blankConfig, err = clientcmd.NewClientConfigFromBytes([]byte(`kind: Config
+version: v1
+users:
+- name: u
+clusters:
+- name: c
+  cluster:
+    server: example.com
+contexts:
+- name: x
+  context:
+    user: u
+    cluster: c
+current-context: x
+`))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func executeWaitCommand(dynamicClient clientdynamic.KnDynamicClient, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+
+	output := new(bytes.Buffer)
+	knParams.Output = output
+	knParams.NewDynamicClient = func(namespace string) (clientdynamic.KnDynamicClient, error) {
+		return dynamicClient, nil
+	}
+
+	cmd := NewWaitCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOutput(output)
+
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func TestWaitBroker(t *testing.T) {
+	client := dynamicfakeclient.CreateFakeKnDynamicClient("default", newBroker("default", "Unknown", ""))
+	fakeWatch(client, "brokers",
+		watch.Event{Type: watch.Added, Object: newBroker("default", "Unknown", "waiting for ingress")},
+		watch.Event{Type: watch.Modified, Object: newBroker("default", "True", "")},
+	)
+
+	output, err := executeWaitCommand(client, "broker/default", "--namespace", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Waiting for Broker 'default' in namespace 'default' to reach condition Ready=True",
+		"waiting for ingress", "Broker 'default' reached condition Ready=True"))
+}
+
+func TestWaitBrokerWithoutStatus(t *testing.T) {
+	created := newBroker("default", "Unknown", "")
+	delete(created.(*unstructured.Unstructured).Object, "status")
+	client := dynamicfakeclient.CreateFakeKnDynamicClient("default", created)
+	fakeWatch(client, "brokers",
+		watch.Event{Type: watch.Added, Object: created},
+		watch.Event{Type: watch.Modified, Object: newBroker("default", "True", "")},
+	)
+
+	output, err := executeWaitCommand(client, "broker/default", "--namespace", "default")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Broker 'default' reached condition Ready=True"))
+}
+
+func TestWaitBrokerTimeout(t *testing.T) {
+	client := dynamicfakeclient.CreateFakeKnDynamicClient("default", newBroker("default", "True", ""))
+	fakeWatch(client, "brokers",
+		watch.Event{Type: watch.Added, Object: newBroker("default", "True", "")},
+	)
+
+	_, err := executeWaitCommand(client, "brokers/default", "--namespace", "default", "--for", "condition=Ready=False", "--timeout", "100ms")
+	assert.ErrorContains(t, err, "timeout: broker 'default' has not reached condition Ready=False")
+}
+
+func TestWaitErrors(t *testing.T) {
+	client := dynamicfakeclient.CreateFakeKnDynamicClient("default")
+
+	_, err := executeWaitCommand(client)
+	assert.ErrorContains(t, err, "requires the resource")
+
+	_, err = executeWaitCommand(client, "default", "--namespace", "default")
+	assert.ErrorContains(t, err, "RESOURCE/NAME")
+
+	_, err = executeWaitCommand(client, "broker/default", "--namespace", "default", "--for", "deleted")
+	assert.ErrorContains(t, err, "invalid condition")
+
+	_, err = executeWaitCommand(client, "broker/default", "--namespace", "default")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = executeWaitCommand(client, "foo/bar", "--namespace", "default")
+	assert.ErrorContains(t, err, "unknown resource type 'foo'")
+}
+
+func TestResolveResource(t *testing.T) {
+	client := dynamicfakeclient.CreateFakeKnDynamicClient("default", newSourceCRD("KafkaSource", "kafkasources"))
+
+	gvr, err := resolveResource(client, "ksvc")
+	assert.NilError(t, err)
+	assert.Equal(t, gvr, schema.GroupVersionResource{Group: "serving.knative.dev", Version: "v1", Resource: "services"})
+
+	gvr, err = resolveResource(client, "PingSource")
+	assert.NilError(t, err)
+	assert.Equal(t, gvr.Resource, "pingsources")
+
+	for _, name := range []string{"kafkasource", "kafkasources"} {
+		gvr, err = resolveResource(client, name)
+		assert.NilError(t, err)
+		assert.Equal(t, gvr, schema.GroupVersionResource{Group: "sources.knative.dev", Version: "v1alpha1", Resource: "kafkasources"})
+	}
+
+	_, err = resolveResource(client, "deployment")
+	assert.ErrorContains(t, err, "broker, configuration, containersource")
+}
+
+func fakeWatch(client clientdynamic.KnDynamicClient, resource string, events ...watch.Event) {
+	client.RawClient().(*dynamicfake.FakeDynamicClient).PrependWatchReactor(resource,
+		func(action clienttesting.Action) (bool, watch.Interface, error) {
+			w := clientwait.NewFakeWatch(events)
+			w.Start()
+			return true, w, nil
+		})
+}
+
+func newBroker(name string, readyStatus string, message string) runtime.Object {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "eventing.knative.dev/v1beta1",
+		"kind":       "Broker",
+		"metadata": map[string]interface{}{
+			"namespace":  "default",
+			"name":       name,
+			"generation": int64(1),
+		},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(1),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": readyStatus, "message": message},
+			},
+		},
+	}}
+}
+
+func newSourceCRD(kind string, plural string) runtime.Object {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1beta1",
+		"kind":       "CustomResourceDefinition",
+		"metadata": map[string]interface{}{
+			"name":   plural + ".sources.knative.dev",
+			"labels": map[string]interface{}{"duck.knative.dev/source": "true"},
+		},
+		"spec": map[string]interface{}{
+			"group":   "sources.knative.dev",
+			"version": "v1alpha1",
+			"names": map[string]interface{}{
+				"kind":   kind,
+				"plural": plural,
+			},
+		},
+	}}
+}
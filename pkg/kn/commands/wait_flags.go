@@ -16,10 +16,15 @@ package commands
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
 
 	knflags "knative.dev/client/pkg/kn/flags"
+	"knative.dev/client/pkg/wait"
 )
 
 // Default time out to use when waiting for reconciliation. It is deliberately very long as it is expected that
@@ -54,3 +59,48 @@ func (p *WaitFlags) AddConditionWaitFlags(command *cobra.Command, waitTimeoutDef
 	timeoutUsage := fmt.Sprintf("Seconds to wait before giving up on waiting for %s to be %s.", what, until)
 	command.Flags().IntVar(&p.TimeoutInSeconds, "wait-timeout", waitTimeoutDefault, timeoutUsage)
 }
+
+// WaitForFlags are the flags of commands which wait on a condition of an existing resource
+type WaitForFlags struct {
+	// Condition to wait for, given as condition=TYPE[=STATUS]
+	For string
+	// Timeout for how long to wait at maximum
+	Timeout time.Duration
+}
+
+// AddFlags adds the --for and --timeout flags. Use `what` for describing what is waited for.
+func (p *WaitForFlags) AddFlags(command *cobra.Command, what string) {
+	command.Flags().StringVar(&p.For, "for", "condition=Ready",
+		fmt.Sprintf("Condition to wait for, given as condition=TYPE[=STATUS]. The status is one of True|False|Unknown and defaults to True. "+
+			"Returns immediately if the %s already has reached the condition.", what))
+	command.Flags().DurationVar(&p.Timeout, "timeout", WaitDefaultTimeout*time.Second,
+		fmt.Sprintf("Duration to wait before giving up on waiting for the %s to reach the condition (e.g. 30s, 5m).", what))
+}
+
+// Condition returns the condition given with --for
+func (p *WaitForFlags) Condition() (wait.Condition, error) {
+	return ParseWaitCondition(p.For)
+}
+
+// ParseWaitCondition parses a condition specification of the form condition=TYPE[=STATUS]
+func ParseWaitCondition(spec string) (wait.Condition, error) {
+	formatErr := fmt.Errorf("invalid condition '%s', please specify it as condition=TYPE[=STATUS] (e.g. condition=Ready or condition=Ready=False)", spec)
+	parts := strings.Split(spec, "=")
+	if len(parts) < 2 || len(parts) > 3 || strings.ToLower(parts[0]) != "condition" || parts[1] == "" {
+		return wait.Condition{}, formatErr
+	}
+	condition := wait.Condition{Type: apis.ConditionType(parts[1]), Status: corev1.ConditionTrue}
+	if len(parts) == 3 {
+		switch strings.ToLower(parts[2]) {
+		case "true":
+			condition.Status = corev1.ConditionTrue
+		case "false":
+			condition.Status = corev1.ConditionFalse
+		case "unknown":
+			condition.Status = corev1.ConditionUnknown
+		default:
+			return wait.Condition{}, fmt.Errorf("invalid status '%s' of condition '%s', must be one of True|False|Unknown", parts[2], parts[1])
+		}
+	}
+	return condition, nil
+}
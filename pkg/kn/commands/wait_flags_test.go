@@ -18,11 +18,14 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	knflags "knative.dev/client/pkg/kn/flags"
+	"knative.dev/client/pkg/wait"
 
 	"github.com/spf13/cobra"
 	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
 )
 
 type waitTestCase struct {
@@ -136,3 +139,38 @@ func TestAddWaitUsageDelete(t *testing.T) {
 		t.Error("Delete has wrong default value for --no-wait")
 	}
 }
+
+func TestWaitForFlags(t *testing.T) {
+	flags := &WaitForFlags{}
+	cmd := cobra.Command{}
+	flags.AddFlags(&cmd, "service")
+	assert.NilError(t, cmd.ParseFlags([]string{"--timeout", "5m"}))
+	assert.Equal(t, flags.Timeout, 5*time.Minute)
+	condition, err := flags.Condition()
+	assert.NilError(t, err)
+	assert.Equal(t, condition, wait.ReadyCondition)
+}
+
+func TestParseWaitCondition(t *testing.T) {
+	for _, tc := range []struct {
+		spec      string
+		condition wait.Condition
+		err       string
+	}{
+		{"condition=Ready", wait.Condition{Type: "Ready", Status: corev1.ConditionTrue}, ""},
+		{"condition=RoutesReady=false", wait.Condition{Type: "RoutesReady", Status: corev1.ConditionFalse}, ""},
+		{"condition=Ready=Unknown", wait.Condition{Type: "Ready", Status: corev1.ConditionUnknown}, ""},
+		{"Ready", wait.Condition{}, "invalid condition 'Ready'"},
+		{"delete", wait.Condition{}, "invalid condition"},
+		{"condition=", wait.Condition{}, "invalid condition"},
+		{"condition=Ready=maybe", wait.Condition{}, "invalid status 'maybe'"},
+	} {
+		condition, err := ParseWaitCondition(tc.spec)
+		if tc.err != "" {
+			assert.ErrorContains(t, err, tc.err)
+			continue
+		}
+		assert.NilError(t, err)
+		assert.Equal(t, condition, tc.condition)
+	}
+}
@@ -26,9 +26,13 @@ type PodSpecFlags struct {
 	Image   uniqueStringArg
 	Env     []string
 	EnvFrom []string
+	EnvFile []string
 	Mount   []string
 	Volume  []string
 
+	VolumeItem []string
+	VolumeMode []string
+
 	Command string
 	Arg     []string
 
@@ -38,6 +42,12 @@ type PodSpecFlags struct {
 	ServiceAccountName         string
 	ImagePullSecrets           string
 	User                       int64
+	ReadinessProbe             string
+	LivenessProbe              string
+
+	// Container selects the container to which the container specific flags apply
+	Container string
+	Sidecars  []string
 }
 
 type ResourceFlags struct {
@@ -75,9 +85,16 @@ func (p *PodSpecFlags) AddFlags(flagset *pflag.FlagSet) []string {
 	flagset.StringArrayVarP(&p.Env, "env", "e", []string{},
 		"Environment variable to set. NAME=value; you may provide this flag "+
 			"any number of times to set multiple environment variables. "+
+			"To take the value from a key of a Secret or ConfigMap, use NAME=secret:mysecret:key or NAME=cm:myconfigmap:key. "+
 			"To unset, specify the environment variable name followed by a \"-\" (e.g., NAME-).")
 	flagNames = append(flagNames, "env")
 
+	flagset.StringArrayVarP(&p.EnvFile, "env-file", "", []string{},
+		"Path to a file with environment variables to set, one NAME=value per line (dotenv format). "+
+			"Values can reference keys of Secrets or ConfigMaps like for --env. "+
+			"You can use this flag multiple times, variables given with --env take precedence.")
+	flagNames = append(flagNames, "env-file")
+
 	flagset.StringArrayVarP(&p.EnvFrom, "env-from", "", []string{},
 		"Add environment variables from a ConfigMap (prefix cm: or config-map:) or a Secret (prefix secret:). "+
 			"Example: --env-from cm:myconfigmap or --env-from secret:mysecret. "+
@@ -100,6 +117,20 @@ func (p *PodSpecFlags) AddFlags(flagset *pflag.FlagSet) []string {
 			"To unset a ConfigMap/Secret reference, append \"-\" to the name, e.g. --volume myvolume-.")
 	flagNames = append(flagNames, "volume")
 
+	flagset.StringArrayVarP(&p.VolumeItem, "volume-item", "", []string{},
+		"Project a single key of the ConfigMap or Secret of a volume to a file instead of exposing all keys. "+
+			"The volume is referenced by its name or the name of the ConfigMap or Secret. "+
+			"Example: --mount /etc/cfg=cm:app-config --volume-item app-config:settings.json=config.json. "+
+			"You can use this flag multiple times. "+
+			"To remove a projected key, append \"-\" to the key, e.g. --volume-item app-config:settings.json-.")
+	flagNames = append(flagNames, "volume-item")
+
+	flagset.StringArrayVarP(&p.VolumeMode, "volume-mode", "", []string{},
+		"Octal mode of the files of a ConfigMap or Secret volume, given as [NAME=]MODE. "+
+			"Without a NAME the mode applies to all volumes given with --mount, --volume or --volume-item. "+
+			"Example: --volume-mode 0440 or --volume-mode app-config=0400.")
+	flagNames = append(flagNames, "volume-mode")
+
 	flagset.StringVarP(&p.Command, "cmd", "", "",
 		"Specify command to be used as entrypoint instead of default one. "+
 			"Example: --cmd /app/start or --cmd /app/start --arg myArg to pass aditional arguments.")
@@ -161,5 +192,31 @@ func (p *PodSpecFlags) AddFlags(flagset *pflag.FlagSet) []string {
 	flagNames = append(flagNames, "pull-secret")
 	flagset.Int64VarP(&p.User, "user", "", 0, "The user ID to run the container (e.g., 1001).")
 	flagNames = append(flagNames, "user")
+
+	flagset.StringVar(&p.ReadinessProbe, "probe-readiness", "",
+		"Readiness probe of the container serving the requests. Specify a handler http:PATH[:PORT], https:PATH[:PORT], tcp:[PORT] "+
+			"or exec:COMMAND[,ARG...] followed by the optional comma separated options period=, timeout=, initialDelay= and failureThreshold= (all in seconds, except the threshold). "+
+			"Example: --probe-readiness http:/healthz:8080,period=5 or --probe-readiness exec:cat,/tmp/ready. "+
+			"Without a handler only the options of the existing probe are updated. "+
+			"To remove the probe, specify \"-\", e.g. --probe-readiness -.")
+	flagNames = append(flagNames, "probe-readiness")
+
+	flagset.StringVar(&p.LivenessProbe, "probe-liveness", "",
+		"Liveness probe of the container serving the requests, in the same format as --probe-readiness. "+
+			"Example: --probe-liveness tcp:8080,initialDelay=10,failureThreshold=3. "+
+			"To remove the probe, specify \"-\", e.g. --probe-liveness -.")
+	flagNames = append(flagNames, "probe-liveness")
+
+	flagset.StringArrayVarP(&p.Sidecars, "sidecar", "", []string{},
+		"Add a sidecar container or update its image (format: --sidecar NAME=IMAGE). "+
+			"Requires the multi-container feature to be enabled in Knative Serving. "+
+			"You can use this flag multiple times. "+
+			"To remove a sidecar, append \"-\" to its name, e.g. --sidecar proxy-.")
+	flagNames = append(flagNames, "sidecar")
+
+	// Selecting a container does not change the revision by itself
+	flagset.StringVarP(&p.Container, "container", "", "",
+		"Name of the container to which --env, --env-from, --mount, --limit, --request, --cmd and --arg apply. "+
+			"Defaults to the container serving the requests.")
 	return flagNames
 }
@@ -24,12 +24,16 @@ import (
 func TestPodSpecFlags(t *testing.T) {
 	args := []string{"--image", "repo/user/imageID:tag", "--env", "b=c"}
 	wantedPod := &PodSpecFlags{
-		Image:   "repo/user/imageID:tag",
-		Env:     []string{"b=c"},
-		EnvFrom: []string{},
-		Mount:   []string{},
-		Volume:  []string{},
-		Arg:     []string{},
+		Image:      "repo/user/imageID:tag",
+		Env:        []string{"b=c"},
+		EnvFrom:    []string{},
+		EnvFile:    []string{},
+		Mount:      []string{},
+		Volume:     []string{},
+		VolumeItem: []string{},
+		VolumeMode: []string{},
+		Arg:        []string{},
+		Sidecars:   []string{},
 	}
 	flags := &PodSpecFlags{}
 	testCmd := &cobra.Command{
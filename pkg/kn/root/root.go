@@ -26,8 +26,10 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
 
 	"knative.dev/client/pkg/kn/commands"
+	"knative.dev/client/pkg/kn/commands/admin"
 	"knative.dev/client/pkg/kn/commands/broker"
 	"knative.dev/client/pkg/kn/commands/completion"
+	"knative.dev/client/pkg/kn/commands/configuration"
 	"knative.dev/client/pkg/kn/commands/options"
 	"knative.dev/client/pkg/kn/commands/plugin"
 	"knative.dev/client/pkg/kn/commands/revision"
@@ -36,6 +38,7 @@ import (
 	"knative.dev/client/pkg/kn/commands/source"
 	"knative.dev/client/pkg/kn/commands/trigger"
 	"knative.dev/client/pkg/kn/commands/version"
+	"knative.dev/client/pkg/kn/commands/wait"
 	"knative.dev/client/pkg/kn/config"
 	"knative.dev/client/pkg/kn/flags"
 	"knative.dev/client/pkg/templates"
@@ -85,6 +88,7 @@ func NewRootCommand(helpFuncs *template.FuncMap) (*cobra.Command, error) {
 				service.NewServiceCommand(p),
 				revision.NewRevisionCommand(p),
 				route.NewRouteCommand(p),
+				configuration.NewConfigurationCommand(p),
 			},
 		},
 		{
@@ -95,9 +99,16 @@ func NewRootCommand(helpFuncs *template.FuncMap) (*cobra.Command, error) {
 				trigger.NewTriggerCommand(p),
 			},
 		},
+		{
+			Header: "Admin Commands:",
+			Commands: []*cobra.Command{
+				admin.NewAdminCommand(p),
+			},
+		},
 		{
 			Header: "Other Commands:",
 			Commands: []*cobra.Command{
+				wait.NewWaitCommand(p),
 				plugin.NewPluginCommand(p),
 				completion.NewCompletionCommand(p),
 				version.NewVersionCommand(p),
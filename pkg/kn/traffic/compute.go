@@ -56,9 +56,20 @@ func newTarget(tag, revision string, percent int64, latestRevision bool) (target
 	return
 }
 
+// NewConfigurationTarget returns a traffic target which routes to the latest ready revision
+// of the given configuration
+func NewConfigurationTarget(tag, configuration string, percent int64) servingv1.TrafficTarget {
+	return servingv1.TrafficTarget{
+		Tag:               tag,
+		ConfigurationName: configuration,
+		LatestRevision:    ptr.Bool(true),
+		Percent:           ptr.Int64(percent),
+	}
+}
+
 func (e ServiceTraffic) isTagPresentOnRevision(tag, revision string) bool {
 	for _, target := range e {
-		if target.Tag == tag && target.RevisionName == revision {
+		if target.Tag == tag && (target.RevisionName == revision || target.ConfigurationName == revision) {
 			return true
 		}
 	}
@@ -103,6 +114,15 @@ func (e ServiceTraffic) isRevisionPresent(revision string) bool {
 	return false
 }
 
+func (e ServiceTraffic) isConfigurationPresent(configuration string) bool {
+	for _, target := range e {
+		if target.ConfigurationName == configuration {
+			return true
+		}
+	}
+	return false
+}
+
 func (e ServiceTraffic) isLatestRevisionTrue() bool {
 	for _, target := range e {
 		if *target.LatestRevision == true {
@@ -130,6 +150,23 @@ func (e ServiceTraffic) TagRevision(tag, revision string) ServiceTraffic {
 	return e
 }
 
+// TagConfiguration assigns given tag to the target referencing the latest ready revision
+// of a configuration. Only routes can have such targets.
+func (e ServiceTraffic) TagConfiguration(tag, configuration string) ServiceTraffic {
+	for i, target := range e {
+		if target.ConfigurationName == configuration {
+			if target.Tag != "" { // referenced configuration is requested to have multiple tags
+				break
+			} else {
+				e[i].Tag = tag
+				return e
+			}
+		}
+	}
+	e = append(e, NewConfigurationTarget(tag, configuration, 0))
+	return e
+}
+
 // TagLatestRevision assigns given tag to latest ready revision
 func (e ServiceTraffic) TagLatestRevision(tag string) ServiceTraffic {
 	for i, target := range e {
@@ -152,6 +189,16 @@ func (e ServiceTraffic) SetTrafficByRevision(revision string, percent int64) {
 	}
 }
 
+// SetTrafficByConfiguration checks given configuration in existing traffic block and sets given percent if found
+func (e ServiceTraffic) SetTrafficByConfiguration(configuration string, percent int64) {
+	for i, target := range e {
+		if target.ConfigurationName == configuration {
+			e[i].Percent = ptr.Int64(percent)
+			break
+		}
+	}
+}
+
 // SetTrafficByTag checks given tag in existing traffic block and sets given percent if found
 func (e ServiceTraffic) SetTrafficByTag(tag string, percent int64) {
 	for i, target := range e {
@@ -329,6 +376,11 @@ func Compute(cmd *cobra.Command, targets []servingv1.TrafficTarget,
 			return nil, errorOverWritingTag(tag)
 		}
 
+		if traffic.isConfigurationPresent(revision) {
+			traffic = traffic.TagConfiguration(tag, revision)
+			continue
+		}
+
 		traffic = traffic.TagRevision(tag, revision)
 	}
 
@@ -363,6 +415,12 @@ func Compute(cmd *cobra.Command, targets []servingv1.TrafficTarget,
 				continue
 			}
 
+			// check if given revisionRef is a configuration (only for routes)
+			if traffic.isConfigurationPresent(revisionRef) {
+				traffic.SetTrafficByConfiguration(revisionRef, percentInt)
+				continue
+			}
+
 			// check if given revisionRef is a revision
 			if traffic.isRevisionPresent(revisionRef) {
 				traffic.SetTrafficByRevision(revisionRef, percentInt)
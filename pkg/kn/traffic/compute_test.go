@@ -300,3 +300,20 @@ func TestComputeErrMsg(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeConfigurationTargets(t *testing.T) {
+	existing := []servingv1.TrafficTarget{
+		NewConfigurationTarget("", "config-a", 100),
+		NewConfigurationTarget("", "config-b", 0),
+	}
+	testCmd, tFlags := newTestTrafficCommand()
+	testCmd.SetArgs([]string{"--traffic", "config-a=70,config-b=20,echo-v1=10", "--tag", "config-b=candidate"})
+	testCmd.Execute()
+	targets, err := Compute(testCmd, existing, tFlags, "routeName")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, targets, []servingv1.TrafficTarget{
+		NewConfigurationTarget("", "config-a", 70),
+		NewConfigurationTarget("candidate", "config-b", 20),
+		newTarget("", "echo-v1", 10, false),
+	})
+}
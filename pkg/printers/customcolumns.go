@@ -0,0 +1,155 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Column is a custom column with a header and a JSONPath expression for its values
+type Column struct {
+	Header     string
+	expression *jsonpath.JSONPath
+}
+
+// CustomColumnsPrinter prints objects as a table with user defined columns
+type CustomColumnsPrinter struct {
+	Columns   []Column
+	NoHeaders bool
+}
+
+var _ ResourcePrinter = &CustomColumnsPrinter{}
+
+// NewCustomColumnsPrinter creates a printer from a column specification
+// like "NAME:.metadata.name,IMAGE:.spec.template.spec.containers[0].image"
+func NewCustomColumnsPrinter(spec string, noHeaders bool) (*CustomColumnsPrinter, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+	var columns []Column
+	for _, part := range strings.Split(spec, ",") {
+		colSpec := strings.SplitN(part, ":", 2)
+		if len(colSpec) != 2 || colSpec[0] == "" || colSpec[1] == "" {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %s, expected <header>:<json-path-expr>", part)
+		}
+		expression, err := ParseJSONPath(colSpec[0], colSpec[1])
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, Column{Header: colSpec[0], expression: expression})
+	}
+	return &CustomColumnsPrinter{Columns: columns, NoHeaders: noHeaders}, nil
+}
+
+// PrintObj prints the given object or all items of the given list, one row per object
+func (p *CustomColumnsPrinter) PrintObj(obj runtime.Object, output io.Writer) error {
+	w, found := output.(*tabwriter.Writer)
+	if !found {
+		w = NewTabWriter(output)
+		defer w.Flush()
+	}
+
+	if !p.NoHeaders {
+		headers := make([]string, len(p.Columns))
+		for i, column := range p.Columns {
+			headers[i] = strings.ToUpper(column.Header)
+		}
+		if err := printHeader(headers, w); err != nil {
+			return err
+		}
+	}
+
+	objects := []runtime.Object{obj}
+	if meta.IsListType(obj) {
+		var err error
+		if objects, err = meta.ExtractList(obj); err != nil {
+			return err
+		}
+	}
+	for _, object := range objects {
+		content, err := toUnstructuredContent(object)
+		if err != nil {
+			return err
+		}
+		cells := make([]string, len(p.Columns))
+		for i, column := range p.Columns {
+			if cells[i], err = columnValue(column.expression, content); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", strings.Join(cells, "\t")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseJSONPath parses a JSONPath expression, which can be given with or without
+// the enclosing braces and the leading dot (e.g. ".metadata.name" or "{.metadata.name}")
+func ParseJSONPath(name string, expression string) (*jsonpath.JSONPath, error) {
+	relaxed := strings.TrimSuffix(strings.TrimPrefix(expression, "{"), "}")
+	if !strings.HasPrefix(relaxed, ".") {
+		relaxed = "." + relaxed
+	}
+	parser := jsonpath.New(name).AllowMissingKeys(true)
+	if err := parser.Parse("{" + relaxed + "}"); err != nil {
+		return nil, fmt.Errorf("invalid JSONPath expression '%s': %v", expression, err)
+	}
+	return parser, nil
+}
+
+// columnValue returns the values found by the given expression joined by a comma,
+// or "<none>" if nothing is found
+func columnValue(expression *jsonpath.JSONPath, content map[string]interface{}) (string, error) {
+	results, err := expression.FindResults(content)
+	if err != nil {
+		return "", err
+	}
+	var values []string
+	for _, result := range results {
+		for _, value := range result {
+			if !value.IsValid() || (value.Kind() == reflect.Interface && value.IsNil()) {
+				continue
+			}
+			buf := &bytes.Buffer{}
+			if err := expression.PrintResults(buf, []reflect.Value{value}); err != nil {
+				return "", err
+			}
+			values = append(values, buf.String())
+		}
+	}
+	if len(values) == 0 {
+		return "<none>", nil
+	}
+	return strings.Join(values, ","), nil
+}
+
+// toUnstructuredContent returns the content of the given object as it would be serialized
+func toUnstructuredContent(obj runtime.Object) (map[string]interface{}, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.UnstructuredContent(), nil
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
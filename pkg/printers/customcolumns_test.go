@@ -0,0 +1,95 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestCustomColumnsPrinter(t *testing.T) {
+	printer, err := NewCustomColumnsPrinter("NAME:.metadata.name,IMAGE:{.spec.containers[0].image},Missing:metadata.labels.foo", false)
+	assert.NilError(t, err)
+
+	list := &corev1.PodList{Items: []corev1.Pod{
+		newTestPod("foo", "gcr.io/foo/bar:v1"),
+		newTestPod("bar", "gcr.io/foo/baz:v2"),
+	}}
+	buf := &bytes.Buffer{}
+	assert.NilError(t, printer.PrintObj(list, buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, len(lines), 3)
+	assert.DeepEqual(t, strings.Fields(lines[0]), []string{"NAME", "IMAGE", "MISSING"})
+	assert.DeepEqual(t, strings.Fields(lines[1]), []string{"foo", "gcr.io/foo/bar:v1", "<none>"})
+	assert.DeepEqual(t, strings.Fields(lines[2]), []string{"bar", "gcr.io/foo/baz:v2", "<none>"})
+
+	printer.NoHeaders = true
+	pod := newTestPod("single", "gcr.io/foo/bar:v1")
+	buf.Reset()
+	assert.NilError(t, printer.PrintObj(&pod, buf))
+	assert.DeepEqual(t, strings.Fields(buf.String()), []string{"single", "gcr.io/foo/bar:v1", "<none>"})
+}
+
+func TestCustomColumnsPrinterInvalidSpec(t *testing.T) {
+	for _, spec := range []string{"", "NAME", "NAME:", ":.metadata.name", "NAME:.metadata.name[", "NAME:.metadata.name,IMAGE"} {
+		_, err := NewCustomColumnsPrinter(spec, false)
+		assert.Assert(t, err != nil, "spec %q", spec)
+	}
+}
+
+func TestSortList(t *testing.T) {
+	list := &corev1.PodList{Items: []corev1.Pod{
+		newTestPod("foo", "c"),
+		newTestPod("bar", "a"),
+		newTestPod("baz", "b"),
+	}}
+	list.Items[0].Spec.Priority = ptr.Int32(10)
+	list.Items[1].Spec.Priority = ptr.Int32(9)
+
+	assert.NilError(t, SortList(list, ".metadata.name"))
+	assert.DeepEqual(t, podNames(list), []string{"bar", "baz", "foo"})
+
+	assert.NilError(t, SortList(list, "{.spec.containers[0].image}"))
+	assert.DeepEqual(t, podNames(list), []string{"bar", "baz", "foo"})
+
+	// Numbers are compared numerically, missing values come first
+	assert.NilError(t, SortList(list, ".spec.priority"))
+	assert.DeepEqual(t, podNames(list), []string{"baz", "bar", "foo"})
+
+	assert.ErrorContains(t, SortList(list, ".spec.containers[*].name"), "single value")
+}
+
+func newTestPod(name string, image string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "first", Image: image}, {Name: "second", Image: image}},
+		},
+	}
+}
+
+func podNames(list *corev1.PodList) []string {
+	var names []string
+	for _, pod := range list.Items {
+		names = append(names, pod.Name)
+	}
+	return names
+}
@@ -39,6 +39,11 @@ func (fn ResourcePrinterFunc) PrintObj(obj runtime.Object, w io.Writer) error {
 // PrintOptions for different table printing options
 type PrintOptions struct {
 	NoHeaders bool
-	//TODO: Add options for eg: with-kind, server-printing, wide etc
+	//TODO: Add options for eg: with-kind, server-printing etc
 	AllNamespaces bool
+	// Wide adds the columns with a priority of 2 to the output
+	Wide bool
+	// Sorted indicates that the objects are already sorted (e.g. with --sort-by) and
+	// that handlers should print them in the given order
+	Sorted bool
 }
@@ -0,0 +1,110 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SortList sorts the items of the given list in place by the value found with the
+// given JSONPath expression. Items without a value are sorted first.
+func SortList(list runtime.Object, sortBy string) error {
+	expression, err := ParseJSONPath("sort-by", sortBy)
+	if err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	values := make([]reflect.Value, len(items))
+	for i, item := range items {
+		content, err := toUnstructuredContent(item)
+		if err != nil {
+			return err
+		}
+		results, err := expression.FindResults(content)
+		if err != nil {
+			return err
+		}
+		if len(results) > 1 || (len(results) == 1 && len(results[0]) > 1) {
+			return fmt.Errorf("--sort-by expression '%s' must select a single value per item", sortBy)
+		}
+		if len(results) == 1 && len(results[0]) == 1 {
+			values[i] = results[0][0]
+		}
+	}
+
+	indices := make([]int, len(items))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return lessValue(values[indices[i]], values[indices[j]])
+	})
+	sorted := make([]runtime.Object, len(items))
+	for i, index := range indices {
+		sorted[i] = items[index]
+	}
+	return meta.SetList(list, sorted)
+}
+
+// lessValue compares numbers numerically and everything else by its string representation
+func lessValue(a, b reflect.Value) bool {
+	a, b = indirect(a), indirect(b)
+	if !a.IsValid() || !b.IsValid() {
+		return !a.IsValid() && b.IsValid()
+	}
+	if isNumber(a) && isNumber(b) {
+		return toFloat(a) < toFloat(b)
+	}
+	return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func isNumber(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	}
+	return v.Float()
+}
@@ -84,6 +84,9 @@ func printRowsForHandlerEntry(output io.Writer, handler *handlerEntry, obj runti
 			if !options.AllNamespaces && column.Priority == 0 {
 				continue
 			}
+			if !options.Wide && column.Priority == 2 {
+				continue
+			}
 			headers = append(headers, strings.ToUpper(column.Name))
 		}
 		printHeader(headers, output)
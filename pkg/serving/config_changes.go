@@ -25,6 +25,7 @@ import (
 	"unicode"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/ptr"
 	"knative.dev/serving/pkg/apis/autoscaling"
@@ -40,9 +41,12 @@ type VolumeSourceType int
 const (
 	ConfigMapVolumeSourceType VolumeSourceType = iota
 	SecretVolumeSourceType
-	PortFormatErr = "the port specification '%s' is not valid. Please provide in the format 'NAME:PORT', where 'NAME' is optional. Examples: '--port h2c:8080' , '--port 8080'."
+	PortFormatErr  = "the port specification '%s' is not valid. Please provide in the format 'NAME:PORT', where 'NAME' is optional. Examples: '--port h2c:8080' , '--port 8080'."
+	ProbeFormatErr = "the probe specification '%s' is not valid. Please provide a handler 'http:PATH[:PORT]', 'https:PATH[:PORT]', 'tcp:[PORT]' or 'exec:COMMAND[,ARG...]' followed by optional 'period=', 'timeout=', 'initialDelay=' or 'failureThreshold=' options. Examples: '--probe-readiness http:/healthz:8080,period=5', '--probe-liveness tcp:8080'."
 )
 
+const defaultContainerPort int32 = 8080
+
 var (
 	UserImageAnnotationKey = "client.knative.dev/user-image"
 	ApiTooOldError         = errors.New("the service is using too old of an API format for the operation")
@@ -59,12 +63,18 @@ func (vt VolumeSourceType) String() string {
 // UpdateEnvVars gives the configuration all the env var values listed in the given map of
 // vars.  Does not touch any environment variables not mentioned, but it can add
 // new env vars and change the values of existing ones, then sort by env key name.
-func UpdateEnvVars(template *servingv1.RevisionTemplateSpec, toUpdate map[string]string, toRemove []string) error {
-	container, err := ContainerOfRevisionTemplate(template)
+// A value of the form secret:NAME:KEY or cm:NAME:KEY (also sc: and config-map:) is turned into a
+// reference to the key of the Secret or ConfigMap.
+// The env vars of the named container are updated, or of the serving container if the name is empty.
+func UpdateEnvVars(template *servingv1.RevisionTemplateSpec, containerName string, toUpdate map[string]string, toRemove []string) error {
+	container, err := ContainerOfRevisionTemplateByName(template, containerName)
+	if err != nil {
+		return err
+	}
+	updated, err := updateEnvVarsFromMap(container.Env, toUpdate)
 	if err != nil {
 		return err
 	}
-	updated := updateEnvVarsFromMap(container.Env, toUpdate)
 	updated = removeEnvVars(updated, toRemove)
 	// Sort by env key name
 	sort.SliceStable(updated, func(i, j int) bool {
@@ -75,9 +85,9 @@ func UpdateEnvVars(template *servingv1.RevisionTemplateSpec, toUpdate map[string
 	return nil
 }
 
-// UpdateEnvFrom updates envFrom
-func UpdateEnvFrom(template *servingv1.RevisionTemplateSpec, toUpdate []string, toRemove []string) error {
-	container, err := ContainerOfRevisionTemplate(template)
+// UpdateEnvFrom updates envFrom of the named container or of the serving container if the name is empty
+func UpdateEnvFrom(template *servingv1.RevisionTemplateSpec, containerName string, toUpdate []string, toRemove []string) error {
+	container, err := ContainerOfRevisionTemplateByName(template, containerName)
 	if err != nil {
 		return err
 	}
@@ -136,10 +146,11 @@ func reviseVolumeInfoAndMountsToUpdate(volumes []corev1.Volume, mountsToUpdate *
 	return volumeSourceInfoByName, mountsToUpdateRevised, nil
 }
 
-func reviseVolumesToRemove(volumeMounts []corev1.VolumeMount, volumesToRemove []string, mountsToRemove []string) []string {
+func reviseVolumesToRemove(volumeMounts []corev1.VolumeMount, volumesToRemove []string, mountsToRemove []string, otherVolumeMounts []corev1.VolumeMount) []string {
 	for _, pathToRemove := range mountsToRemove {
 		for _, volumeMount := range volumeMounts {
-			if volumeMount.MountPath == pathToRemove && volumeMount.Name == GenerateVolumeName(pathToRemove) {
+			if volumeMount.MountPath == pathToRemove && volumeMount.Name == GenerateVolumeName(pathToRemove) &&
+				!existsVolumeNameInVolumeMounts(volumeMount.Name, otherVolumeMounts) {
 				volumesToRemove = append(volumesToRemove, volumeMount.Name)
 			}
 		}
@@ -148,9 +159,10 @@ func reviseVolumesToRemove(volumeMounts []corev1.VolumeMount, volumesToRemove []
 }
 
 // UpdateVolumeMountsAndVolumes updates the configuration for volume mounts and volumes.
-func UpdateVolumeMountsAndVolumes(template *servingv1.RevisionTemplateSpec,
+// The volume mounts are updated for the named container or for the serving container if the name is empty.
+func UpdateVolumeMountsAndVolumes(template *servingv1.RevisionTemplateSpec, containerName string,
 	mountsToUpdate *util.OrderedMap, mountsToRemove []string, volumesToUpdate *util.OrderedMap, volumesToRemove []string) error {
-	container, err := ContainerOfRevisionTemplate(template)
+	container, err := ContainerOfRevisionTemplateByName(template, containerName)
 	if err != nil {
 		return err
 	}
@@ -170,14 +182,100 @@ func UpdateVolumeMountsAndVolumes(template *servingv1.RevisionTemplateSpec,
 		return err
 	}
 
-	volumesToRemove = reviseVolumesToRemove(container.VolumeMounts, volumesToRemove, mountsToRemove)
+	// Volumes still mounted by other containers must be kept
+	var otherVolumeMounts []corev1.VolumeMount
+	for i := range template.Spec.Containers {
+		if &template.Spec.Containers[i] != container {
+			otherVolumeMounts = append(otherVolumeMounts, template.Spec.Containers[i].VolumeMounts...)
+		}
+	}
+	volumesToRemove = reviseVolumesToRemove(container.VolumeMounts, volumesToRemove, mountsToRemove, otherVolumeMounts)
 
 	container.VolumeMounts = removeVolumeMounts(volumeMounts, mountsToRemove)
-	template.Spec.Volumes, err = removeVolumes(volumes, volumesToRemove, container.VolumeMounts)
+	template.Spec.Volumes, err = removeVolumes(volumes, volumesToRemove, append(otherVolumeMounts, container.VolumeMounts...))
 
 	return err
 }
 
+// UpdateVolumeItems projects single keys of the ConfigMap or Secret of a volume to the given paths
+// instead of exposing all keys. Keys of itemsToUpdate and the entries of itemsToRemove have the form
+// NAME:KEY, where NAME is either the name of the volume or of the referenced ConfigMap or Secret.
+// Once all items of a volume are removed, all keys are exposed again.
+func UpdateVolumeItems(template *servingv1.RevisionTemplateSpec, itemsToUpdate *util.OrderedMap, itemsToRemove []string) error {
+	it := itemsToUpdate.Iterator()
+	for ref, path, ok := it.NextString(); ok; ref, path, ok = it.NextString() {
+		name, key, err := splitVolumeItemRef(ref)
+		if err != nil {
+			return err
+		}
+		if path == "" || strings.HasPrefix(path, "/") || strings.Contains(path, "..") {
+			return fmt.Errorf("the path %q for key %q must be a relative path not containing '..'", path, key)
+		}
+		volumes := findConfigMapOrSecretVolumes(template.Spec.Volumes, name)
+		if len(volumes) == 0 {
+			return fmt.Errorf("there is no ConfigMap or Secret volume %q for projecting key %q", name, key)
+		}
+		for _, volume := range volumes {
+			items := volumeItems(volume)
+			*items = append(removeKeyToPath(*items, key), corev1.KeyToPath{Key: key, Path: path})
+		}
+	}
+
+	for _, ref := range itemsToRemove {
+		name, key, err := splitVolumeItemRef(ref)
+		if err != nil {
+			return err
+		}
+		for _, volume := range findConfigMapOrSecretVolumes(template.Spec.Volumes, name) {
+			items := volumeItems(volume)
+			*items = removeKeyToPath(*items, key)
+		}
+	}
+	return nil
+}
+
+// UpdateVolumeDefaultMode sets the mode of the files created for the ConfigMap and Secret volumes with
+// the given names. A name is either the name of the volume or of the referenced ConfigMap or Secret.
+func UpdateVolumeDefaultMode(template *servingv1.RevisionTemplateSpec, names []string, mode int32) error {
+	for _, name := range names {
+		volumes := findConfigMapOrSecretVolumes(template.Spec.Volumes, name)
+		if len(volumes) == 0 {
+			return fmt.Errorf("there is no ConfigMap or Secret volume %q for setting the mode", name)
+		}
+		for _, volume := range volumes {
+			m := mode
+			if volume.ConfigMap != nil {
+				volume.ConfigMap.DefaultMode = &m
+			} else {
+				volume.Secret.DefaultMode = &m
+			}
+		}
+	}
+	return nil
+}
+
+// ReferencedVolumeNames returns the names of the volumes, ConfigMaps and Secrets referenced by the
+// given mounts (path -> [TYPE:]NAME), volumes (NAME -> TYPE:SOURCE) and volume items (NAME:KEY -> PATH).
+func ReferencedVolumeNames(mounts *util.OrderedMap, volumes *util.OrderedMap, items *util.OrderedMap) []string {
+	names := sets.NewString()
+	it := mounts.Iterator()
+	for _, value, ok := it.NextString(); ok; _, value, ok = it.NextString() {
+		slices := strings.SplitN(value, ":", 2)
+		names.Insert(slices[len(slices)-1])
+	}
+	it = volumes.Iterator()
+	for name, _, ok := it.NextString(); ok; name, _, ok = it.NextString() {
+		names.Insert(name)
+	}
+	it = items.Iterator()
+	for ref, _, ok := it.NextString(); ok; ref, _, ok = it.NextString() {
+		if name, _, err := splitVolumeItemRef(ref); err == nil {
+			names.Insert(name)
+		}
+	}
+	return names.List()
+}
+
 // UpdateMinScale updates min scale annotation
 func UpdateMinScale(template *servingv1.RevisionTemplateSpec, min int) error {
 	return UpdateRevisionTemplateAnnotation(template, autoscaling.MinScaleAnnotationKey, strconv.Itoa(min))
@@ -216,6 +314,158 @@ func UpdateConcurrencyLimit(template *servingv1.RevisionTemplateSpec, limit int6
 	return nil
 }
 
+// Short names of the autoscaler classes and the metrics they support
+const (
+	AutoscaleClassKPA = "kpa"
+	AutoscaleClassHPA = "hpa"
+
+	AutoscaleMetricConcurrency = autoscaling.Concurrency
+	AutoscaleMetricRPS         = autoscaling.RPS
+	AutoscaleMetricCPU         = autoscaling.CPU
+)
+
+var autoscaleClasses = map[string]string{
+	AutoscaleClassKPA: autoscaling.KPA,
+	AutoscaleClassHPA: autoscaling.HPA,
+}
+
+var autoscaleMetricsByClass = map[string][]string{
+	autoscaling.KPA: {AutoscaleMetricConcurrency, AutoscaleMetricRPS},
+	autoscaling.HPA: {AutoscaleMetricCPU},
+}
+
+// UpdateAutoscaleClass updates the autoscaler class annotation. The class can be
+// given either with its short name (kpa|hpa) or with its fully qualified name.
+func UpdateAutoscaleClass(template *servingv1.RevisionTemplateSpec, class string) error {
+	fullName, ok := autoscaleClasses[class]
+	if !ok {
+		for _, name := range autoscaleClasses {
+			if name == class {
+				fullName, ok = name, true
+			}
+		}
+	}
+	if !ok {
+		return fmt.Errorf("invalid autoscale class '%s' (must be one of %s|%s)", class, AutoscaleClassKPA, AutoscaleClassHPA)
+	}
+	return UpdateRevisionTemplateAnnotation(template, autoscaling.ClassAnnotationKey, fullName)
+}
+
+// UpdateAutoscaleMetric updates the autoscaling metric annotation. The metric must be
+// supported by the autoscaler class of the template, so the class has to be updated first.
+func UpdateAutoscaleMetric(template *servingv1.RevisionTemplateSpec, metric string) error {
+	switch metric {
+	case AutoscaleMetricConcurrency, AutoscaleMetricRPS, AutoscaleMetricCPU:
+	case "memory":
+		return fmt.Errorf("invalid autoscale metric '%s' (the Knative Serving version kn is built against supports no memory metric, "+
+			"must be one of %s|%s|%s)", metric, AutoscaleMetricConcurrency, AutoscaleMetricRPS, AutoscaleMetricCPU)
+	default:
+		return fmt.Errorf("invalid autoscale metric '%s' (must be one of %s|%s|%s)", metric,
+			AutoscaleMetricConcurrency, AutoscaleMetricRPS, AutoscaleMetricCPU)
+	}
+	return UpdateRevisionTemplateAnnotation(template, autoscaling.MetricAnnotationKey, metric)
+}
+
+// UpdateAutoscaleTarget updates the autoscaling target annotation. The target is
+// given as VALUE or as METRIC=VALUE, in which case the metric annotation is updated, too.
+// The range of the value depends on the metric and is checked by ValidateAutoscaling.
+func UpdateAutoscaleTarget(template *servingv1.RevisionTemplateSpec, target string) error {
+	metric, value := "", target
+	if parts := strings.SplitN(target, "=", 2); len(parts) == 2 {
+		metric, value = parts[0], parts[1]
+	}
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return fmt.Errorf("invalid autoscale target '%s' (must be a number, optionally prefixed with the metric, e.g. rps=150)", target)
+	}
+	if metric != "" {
+		err := UpdateAutoscaleMetric(template, metric)
+		if err != nil {
+			return err
+		}
+	}
+	return UpdateRevisionTemplateAnnotation(template, autoscaling.TargetAnnotationKey, value)
+}
+
+// UpdateInitialScale updates the initial scale annotation
+func UpdateInitialScale(template *servingv1.RevisionTemplateSpec, initial int) error {
+	if initial < 0 {
+		return fmt.Errorf("invalid scale-init %d (must not be less than 0)", initial)
+	}
+	return UpdateRevisionTemplateAnnotation(template, autoscaling.InitialScaleAnnotationKey, strconv.Itoa(initial))
+}
+
+// UpdatePanicWindow updates the panic window annotation, given as percentage of the stable window
+func UpdatePanicWindow(template *servingv1.RevisionTemplateSpec, percentage float64) error {
+	return UpdateRevisionTemplateAnnotation(template, autoscaling.PanicWindowPercentageAnnotationKey, strconv.FormatFloat(percentage, 'f', -1, 64))
+}
+
+// UpdatePanicThreshold updates the panic threshold annotation, given as percentage of the target
+func UpdatePanicThreshold(template *servingv1.RevisionTemplateSpec, percentage float64) error {
+	return UpdateRevisionTemplateAnnotation(template, autoscaling.PanicThresholdPercentageAnnotationKey, strconv.FormatFloat(percentage, 'f', -1, 64))
+}
+
+// ValidateAutoscaling checks that the autoscaling annotations of the template fit together:
+// The metric must be supported by the autoscaler class, the target must be in the range
+// allowed for the metric and the panic mode settings are only supported by the KPA class.
+func ValidateAutoscaling(template *servingv1.RevisionTemplateSpec) error {
+	annotations := template.Annotations
+	class := annotations[autoscaling.ClassAnnotationKey]
+	if class == "" {
+		class = autoscaling.KPA
+	}
+	supportedMetrics, knownClass := autoscaleMetricsByClass[class]
+	if !knownClass {
+		// Custom autoscaler classes define their own rules
+		return nil
+	}
+	shortClass := AutoscaleClassName(class)
+
+	metric := annotations[autoscaling.MetricAnnotationKey]
+	if metric == "" {
+		metric = supportedMetrics[0]
+	} else if !util.SliceContainsIgnoreCase(supportedMetrics, metric) {
+		return fmt.Errorf("autoscale metric '%s' is not supported by autoscale class '%s' (must be one of %s)",
+			metric, shortClass, strings.Join(supportedMetrics, "|"))
+	}
+
+	if target, ok := annotations[autoscaling.TargetAnnotationKey]; ok {
+		value, err := strconv.ParseFloat(target, 64)
+		if err != nil {
+			return fmt.Errorf("invalid autoscale target '%s': %v", target, err)
+		}
+		switch metric {
+		case AutoscaleMetricCPU:
+			if value < 1 || value > 100 {
+				return fmt.Errorf("invalid autoscale target %s for metric '%s' (must be a utilization percentage between 1 and 100)", target, metric)
+			}
+		default:
+			if value < autoscaling.TargetMin {
+				return fmt.Errorf("invalid autoscale target %s for metric '%s' (must be at least %g)", target, metric, autoscaling.TargetMin)
+			}
+		}
+	}
+
+	if class == autoscaling.HPA {
+		for _, key := range []string{autoscaling.PanicWindowPercentageAnnotationKey, autoscaling.PanicThresholdPercentageAnnotationKey} {
+			if _, ok := annotations[key]; ok {
+				return fmt.Errorf("panic mode settings are not supported by autoscale class '%s'", shortClass)
+			}
+		}
+	}
+	return nil
+}
+
+// AutoscaleClassName returns the short name of a known autoscaler class or
+// the given class unchanged
+func AutoscaleClassName(class string) string {
+	for short, name := range autoscaleClasses {
+		if name == class {
+			return short
+		}
+	}
+	return class
+}
+
 // UpdateRevisionTemplateAnnotation updates an annotation for the given Revision Template.
 // Also validates the autoscaling annotation values
 func UpdateRevisionTemplateAnnotation(template *servingv1.RevisionTemplateSpec, annotation string, value string) error {
@@ -229,6 +479,10 @@ func UpdateRevisionTemplateAnnotation(template *servingv1.RevisionTemplateSpec,
 	// without changing the existing spec
 	in := make(map[string]string)
 	in[annotation] = value
+	// Which metrics are valid depends on the autoscaler class of the template
+	if class, ok := annoMap[autoscaling.ClassAnnotationKey]; ok && annotation != autoscaling.ClassAnnotationKey {
+		in[autoscaling.ClassAnnotationKey] = class
+	}
 	// The boolean indicates whether or not the init-scale annotation can be set to 0.
 	// Since we don't have the config handy, err towards allowing it. The API will
 	// correctly fail the request if it's forbidden.
@@ -241,7 +495,8 @@ func UpdateRevisionTemplateAnnotation(template *servingv1.RevisionTemplateSpec,
 }
 
 // EnvToMap is an utility function to translate between the API list form of env vars, and the
-// more convenient map form.
+// more convenient map form. Env vars referencing a value are mapped to the reference as
+// returned by EnvVarSourceToString.
 func EnvToMap(vars []corev1.EnvVar) (map[string]string, error) {
 	result := map[string]string{}
 	for _, envVar := range vars {
@@ -249,11 +504,32 @@ func EnvToMap(vars []corev1.EnvVar) (map[string]string, error) {
 		if present {
 			return nil, fmt.Errorf("env var name present more than once: %v", envVar.Name)
 		}
-		result[envVar.Name] = envVar.Value
+		if envVar.ValueFrom != nil {
+			result[envVar.Name] = EnvVarSourceToString(envVar.ValueFrom)
+		} else {
+			result[envVar.Name] = envVar.Value
+		}
 	}
 	return result, nil
 }
 
+// EnvVarSourceToString returns a short description of where the value of an env var comes from,
+// e.g. secret:mysecret:key or cm:myconfig:key. The referenced value itself is never included.
+func EnvVarSourceToString(source *corev1.EnvVarSource) string {
+	switch {
+	case source.SecretKeyRef != nil:
+		return fmt.Sprintf("secret:%s:%s", source.SecretKeyRef.Name, source.SecretKeyRef.Key)
+	case source.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("cm:%s:%s", source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)
+	case source.FieldRef != nil:
+		return "field:" + source.FieldRef.FieldPath
+	case source.ResourceFieldRef != nil:
+		return "resource:" + source.ResourceFieldRef.Resource
+	default:
+		return "[ref]"
+	}
+}
+
 // UpdateImage a given image
 func UpdateImage(template *servingv1.RevisionTemplateSpec, image string) error {
 	// When not setting the image to a digest, add the user image annotation.
@@ -313,9 +589,9 @@ func FreezeImageToDigest(template *servingv1.RevisionTemplateSpec, baseRevision
 	return nil
 }
 
-// UpdateContainerCommand updates container with a given argument
-func UpdateContainerCommand(template *servingv1.RevisionTemplateSpec, command string) error {
-	container, err := ContainerOfRevisionTemplate(template)
+// UpdateContainerCommand updates the named container, or the serving container if the name is empty, with a given command
+func UpdateContainerCommand(template *servingv1.RevisionTemplateSpec, containerName string, command string) error {
+	container, err := ContainerOfRevisionTemplateByName(template, containerName)
 	if err != nil {
 		return err
 	}
@@ -323,9 +599,9 @@ func UpdateContainerCommand(template *servingv1.RevisionTemplateSpec, command st
 	return nil
 }
 
-// UpdateContainerArg updates container with a given argument
-func UpdateContainerArg(template *servingv1.RevisionTemplateSpec, arg []string) error {
-	container, err := ContainerOfRevisionTemplate(template)
+// UpdateContainerArg updates the named container, or the serving container if the name is empty, with a given argument
+func UpdateContainerArg(template *servingv1.RevisionTemplateSpec, containerName string, arg []string) error {
+	container, err := ContainerOfRevisionTemplateByName(template, containerName)
 	if err != nil {
 		return err
 	}
@@ -333,6 +609,52 @@ func UpdateContainerArg(template *servingv1.RevisionTemplateSpec, arg []string)
 	return nil
 }
 
+// UpdateSidecars adds sidecar containers or updates the image of existing ones, and removes the
+// sidecars with the given names. Knative identifies the serving container of a revision with
+// multiple containers by its port, so the serving container gets the default port 8080
+// if it does not expose a port yet.
+func UpdateSidecars(template *servingv1.RevisionTemplateSpec, toUpdate *util.OrderedMap, toRemove []string) error {
+	serving, err := ContainerOfRevisionTemplate(template)
+	if err != nil {
+		return err
+	}
+	servingName := serving.Name
+
+	it := toUpdate.Iterator()
+	for name, image, ok := it.NextString(); ok; name, image, ok = it.NextString() {
+		if name == "" || image == "" {
+			return fmt.Errorf("sidecar requires a name and an image in the format NAME=IMAGE")
+		}
+		if name == servingName {
+			return fmt.Errorf("container '%s' serves the requests and is not a sidecar, use --image to change its image", name)
+		}
+		sidecar, err := ContainerOfRevisionTemplateByName(template, name)
+		if err == nil {
+			sidecar.Image = image
+			continue
+		}
+		serving, _ = ContainerOfRevisionTemplate(template)
+		if len(serving.Ports) == 0 {
+			serving.Ports = []corev1.ContainerPort{{ContainerPort: defaultContainerPort}}
+		}
+		template.Spec.Containers = append(template.Spec.Containers, corev1.Container{Name: name, Image: image})
+	}
+
+	for _, name := range toRemove {
+		if name == servingName {
+			return fmt.Errorf("container '%s' serves the requests and can not be removed", name)
+		}
+		containers := template.Spec.Containers[:0]
+		for _, container := range template.Spec.Containers {
+			if container.Name != name {
+				containers = append(containers, container)
+			}
+		}
+		template.Spec.Containers = containers
+	}
+	return nil
+}
+
 // UpdateContainerPort updates container with a given name:port
 func UpdateContainerPort(template *servingv1.RevisionTemplateSpec, port string) error {
 	container, err := ContainerOfRevisionTemplate(template)
@@ -365,6 +687,82 @@ func UpdateContainerPort(template *servingv1.RevisionTemplateSpec, port string)
 	return nil
 }
 
+// UpdateReadinessProbe sets, updates or removes the readiness probe of the serving container.
+// See UpdateLivenessProbe for the format of the probe specification.
+func UpdateReadinessProbe(template *servingv1.RevisionTemplateSpec, spec string) error {
+	container, err := ContainerOfRevisionTemplate(template)
+	if err != nil {
+		return err
+	}
+	probe, err := updateProbe(container.ReadinessProbe, spec)
+	if err != nil {
+		return err
+	}
+	container.ReadinessProbe = probe
+	return nil
+}
+
+// UpdateLivenessProbe sets, updates or removes the liveness probe of the serving container.
+// The probe is given as comma separated list starting with the handler
+// ('http:PATH[:PORT]', 'https:PATH[:PORT]', 'tcp:[PORT]' or 'exec:COMMAND[,ARG...]')
+// followed by the options 'period', 'timeout', 'initialDelay' and 'failureThreshold', e.g.
+// 'http:/healthz:8080,period=5'. When the handler is omitted, only the options of an existing
+// probe are updated. The specification "-" removes the probe.
+func UpdateLivenessProbe(template *servingv1.RevisionTemplateSpec, spec string) error {
+	container, err := ContainerOfRevisionTemplate(template)
+	if err != nil {
+		return err
+	}
+	probe, err := updateProbe(container.LivenessProbe, spec)
+	if err != nil {
+		return err
+	}
+	container.LivenessProbe = probe
+	return nil
+}
+
+// ProbeToString formats a probe in the same format as accepted by UpdateLivenessProbe
+func ProbeToString(probe *corev1.Probe) string {
+	if probe == nil {
+		return ""
+	}
+	var parts []string
+	switch {
+	case probe.HTTPGet != nil:
+		scheme := "http"
+		if probe.HTTPGet.Scheme == corev1.URISchemeHTTPS {
+			scheme = "https"
+		}
+		handler := scheme + ":" + probe.HTTPGet.Path
+		if port := probe.HTTPGet.Port; port.IntValue() != 0 || port.StrVal != "" {
+			handler += ":" + port.String()
+		}
+		parts = append(parts, handler)
+	case probe.TCPSocket != nil:
+		handler := "tcp:"
+		if port := probe.TCPSocket.Port; port.IntValue() != 0 || port.StrVal != "" {
+			handler += port.String()
+		}
+		parts = append(parts, handler)
+	case probe.Exec != nil:
+		parts = append(parts, "exec:"+strings.Join(probe.Exec.Command, ","))
+	}
+	for _, option := range []struct {
+		name  string
+		value int32
+	}{
+		{"period", probe.PeriodSeconds},
+		{"timeout", probe.TimeoutSeconds},
+		{"initialDelay", probe.InitialDelaySeconds},
+		{"failureThreshold", probe.FailureThreshold},
+	} {
+		if option.value != 0 {
+			parts = append(parts, fmt.Sprintf("%s=%d", option.name, option.value))
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
 // UpdateRunAsUser updates container with a given user id
 func UpdateUser(template *servingv1.RevisionTemplateSpec, user int64) error {
 	container, err := ContainerOfRevisionTemplate(template)
@@ -377,9 +775,10 @@ func UpdateUser(template *servingv1.RevisionTemplateSpec, user int64) error {
 	return nil
 }
 
-// UpdateResources updates container resources for given revision template
-func UpdateResources(template *servingv1.RevisionTemplateSpec, resources corev1.ResourceRequirements, requestsToRemove, limitsToRemove []string) error {
-	container, err := ContainerOfRevisionTemplate(template)
+// UpdateResources updates the resources of the named container, or of the serving container if the name is empty,
+// for given revision template
+func UpdateResources(template *servingv1.RevisionTemplateSpec, containerName string, resources corev1.ResourceRequirements, requestsToRemove, limitsToRemove []string) error {
+	container, err := ContainerOfRevisionTemplateByName(template, containerName)
 	if err != nil {
 		return err
 	}
@@ -525,21 +924,60 @@ func GenerateVolumeName(path string) string {
 
 // =======================================================================================
 
-func updateEnvVarsFromMap(env []corev1.EnvVar, toUpdate map[string]string) []corev1.EnvVar {
+func updateEnvVarsFromMap(env []corev1.EnvVar, toUpdate map[string]string) ([]corev1.EnvVar, error) {
 	set := sets.NewString()
 	for i := range env {
 		envVar := &env[i]
 		if val, ok := toUpdate[envVar.Name]; ok {
-			envVar.Value = val
+			updated, err := newEnvVar(envVar.Name, val)
+			if err != nil {
+				return nil, err
+			}
+			*envVar = updated
 			set.Insert(envVar.Name)
 		}
 	}
 	for name, val := range toUpdate {
 		if !set.Has(name) {
-			env = append(env, corev1.EnvVar{Name: name, Value: val})
+			envVar, err := newEnvVar(name, val)
+			if err != nil {
+				return nil, err
+			}
+			env = append(env, envVar)
 		}
 	}
-	return env
+	return env, nil
+}
+
+// newEnvVar creates an env var with a literal value or, for a value of the
+// form secret:NAME:KEY or cm:NAME:KEY, with a reference to a key of a Secret or ConfigMap
+func newEnvVar(name string, value string) (corev1.EnvVar, error) {
+	slices := strings.Split(value, ":")
+	if len(slices) != 3 {
+		return corev1.EnvVar{Name: name, Value: value}, nil
+	}
+	var sourceType VolumeSourceType
+	switch slices[0] {
+	case "config-map", "cm":
+		sourceType = ConfigMapVolumeSourceType
+	case "secret", "sc":
+		sourceType = SecretVolumeSourceType
+	default:
+		return corev1.EnvVar{Name: name, Value: value}, nil
+	}
+	refName, key := strings.TrimSpace(slices[1]), strings.TrimSpace(slices[2])
+	if refName == "" || key == "" {
+		return corev1.EnvVar{}, fmt.Errorf("invalid reference %q for env var %s, expected %s:NAME:KEY", value, name, sourceType)
+	}
+	selector := corev1.LocalObjectReference{Name: refName}
+	if sourceType == ConfigMapVolumeSourceType {
+		return corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: selector, Key: key},
+		}}, nil
+	}
+	return corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{
+		SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: selector, Key: key},
+	}}, nil
 }
 
 func removeEnvVars(env []corev1.EnvVar, toRemove []string) []corev1.EnvVar {
@@ -602,9 +1040,16 @@ func removeEnvFrom(envFromSources []corev1.EnvFromSource, toRemove []string) ([]
 func updateVolume(volume *corev1.Volume, info *volumeSourceInfo) error {
 	switch info.volumeSourceType {
 	case ConfigMapVolumeSourceType:
+		if volume.ConfigMap != nil && volume.ConfigMap.Name == info.volumeSourceName {
+			// Keep projected items and modes
+			return nil
+		}
 		volume.Secret = nil
 		volume.ConfigMap = &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: info.volumeSourceName}}
 	case SecretVolumeSourceType:
+		if volume.Secret != nil && volume.Secret.SecretName == info.volumeSourceName {
+			return nil
+		}
 		volume.ConfigMap = nil
 		volume.Secret = &corev1.SecretVolumeSource{SecretName: info.volumeSourceName}
 	default:
@@ -711,6 +1156,48 @@ func removeVolumes(volumes []corev1.Volume, toRemove []string, volumeMounts []co
 	return volumes, nil
 }
 
+// splitVolumeItemRef splits a reference of the form NAME:KEY
+func splitVolumeItemRef(ref string) (string, string, error) {
+	slices := strings.SplitN(ref, ":", 2)
+	if len(slices) != 2 || slices[0] == "" || slices[1] == "" {
+		return "", "", fmt.Errorf("volume item requires a reference of the form NAME:KEY; got %q", ref)
+	}
+	return slices[0], slices[1], nil
+}
+
+// findConfigMapOrSecretVolumes returns the ConfigMap and Secret volumes with the given name
+// or referencing a ConfigMap or Secret with the given name
+func findConfigMapOrSecretVolumes(volumes []corev1.Volume, name string) []*corev1.Volume {
+	var result []*corev1.Volume
+	for i := range volumes {
+		volume := &volumes[i]
+		switch {
+		case volume.ConfigMap != nil && (volume.Name == name || volume.ConfigMap.Name == name):
+			result = append(result, volume)
+		case volume.Secret != nil && (volume.Name == name || volume.Secret.SecretName == name):
+			result = append(result, volume)
+		}
+	}
+	return result
+}
+
+func volumeItems(volume *corev1.Volume) *[]corev1.KeyToPath {
+	if volume.ConfigMap != nil {
+		return &volume.ConfigMap.Items
+	}
+	return &volume.Secret.Items
+}
+
+func removeKeyToPath(items []corev1.KeyToPath, key string) []corev1.KeyToPath {
+	var result []corev1.KeyToPath
+	for _, item := range items {
+		if item.Key != key {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 // =======================================================================================
 
 type volumeSourceInfo struct {
@@ -807,3 +1294,119 @@ func appendCheckSum(sanitiedString string, path string) string {
 	shortCheckSum := checkSum[0:4]
 	return fmt.Sprintf("%s-%x", sanitiedString, shortCheckSum)
 }
+
+func updateProbe(existing *corev1.Probe, spec string) (*corev1.Probe, error) {
+	if spec == "-" {
+		return nil, nil
+	}
+	probe := &corev1.Probe{}
+	if existing != nil {
+		probe = existing.DeepCopy()
+	}
+
+	// Elements after an exec handler are command arguments until the first option
+	inCommand := false
+	for i, part := range strings.Split(spec, ",") {
+		if name, value, ok := splitProbeOption(part); ok {
+			err := updateProbeOption(probe, name, value)
+			if err != nil {
+				return nil, err
+			}
+			inCommand = false
+			continue
+		}
+		if inCommand {
+			probe.Exec.Command = append(probe.Exec.Command, part)
+			continue
+		}
+		if i > 0 {
+			return nil, fmt.Errorf(ProbeFormatErr, spec)
+		}
+		handler, err := parseProbeHandler(part, spec)
+		if err != nil {
+			return nil, err
+		}
+		probe.Handler = *handler
+		inCommand = handler.Exec != nil
+	}
+
+	if probe.HTTPGet == nil && probe.TCPSocket == nil && probe.Exec == nil {
+		return nil, fmt.Errorf("the probe specification '%s' does not specify a handler and no probe exists which could be updated", spec)
+	}
+	return probe, nil
+}
+
+func parseProbeHandler(handlerSpec string, spec string) (*corev1.Handler, error) {
+	elements := strings.SplitN(handlerSpec, ":", 2)
+	if len(elements) != 2 {
+		return nil, fmt.Errorf(ProbeFormatErr, spec)
+	}
+	kind, value := elements[0], elements[1]
+	switch kind {
+	case "http", "https":
+		path := value
+		action := &corev1.HTTPGetAction{}
+		if idx := strings.LastIndex(value, ":"); idx >= 0 {
+			path = value[:idx]
+			action.Port = parseProbePort(value[idx+1:])
+		}
+		if path != "" && !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf(ProbeFormatErr, spec)
+		}
+		action.Path = path
+		if kind == "https" {
+			action.Scheme = corev1.URISchemeHTTPS
+		}
+		return &corev1.Handler{HTTPGet: action}, nil
+	case "tcp":
+		return &corev1.Handler{TCPSocket: &corev1.TCPSocketAction{Port: parseProbePort(value)}}, nil
+	case "exec":
+		if value == "" {
+			return nil, fmt.Errorf(ProbeFormatErr, spec)
+		}
+		return &corev1.Handler{Exec: &corev1.ExecAction{Command: []string{value}}}, nil
+	}
+	return nil, fmt.Errorf(ProbeFormatErr, spec)
+}
+
+// parseProbePort returns a numeric port or a named port when the value is not a number
+func parseProbePort(value string) intstr.IntOrString {
+	if value == "" {
+		return intstr.IntOrString{}
+	}
+	port, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return intstr.FromString(value)
+	}
+	return intstr.FromInt(int(port))
+}
+
+func splitProbeOption(part string) (string, string, bool) {
+	elements := strings.SplitN(part, "=", 2)
+	if len(elements) != 2 {
+		return "", "", false
+	}
+	switch elements[0] {
+	case "period", "timeout", "initialDelay", "failureThreshold":
+		return elements[0], elements[1], true
+	}
+	return "", "", false
+}
+
+func updateProbeOption(probe *corev1.Probe, name string, value string) error {
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || number < 0 {
+		return fmt.Errorf("the value '%s' of probe option '%s' is not valid, it must be a non-negative integer", value, name)
+	}
+	switch name {
+	case "period":
+		probe.PeriodSeconds = int32(number)
+	case "timeout":
+		probe.TimeoutSeconds = int32(number)
+	case "initialDelay":
+		probe.InitialDelaySeconds = int32(number)
+	case "failureThreshold":
+		probe.FailureThreshold = int32(number)
+	}
+	return nil
+}
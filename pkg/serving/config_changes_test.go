@@ -29,6 +29,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
@@ -81,7 +82,7 @@ func TestUpdateEnvVarsNew(t *testing.T) {
 	}
 	found, err := EnvToMap(env)
 	assert.NilError(t, err)
-	err = UpdateEnvVars(template, found, []string{})
+	err = UpdateEnvVars(template, "", found, []string{})
 	assert.NilError(t, err)
 	assert.DeepEqual(t, env, container.Env)
 }
@@ -140,7 +141,7 @@ func testUpdateEnvVarsAppendOld(t *testing.T, template *servingv1.RevisionTempla
 	env := map[string]string{
 		"b": "bar",
 	}
-	err := UpdateEnvVars(template, env, []string{})
+	err := UpdateEnvVars(template, "", env, []string{})
 	assert.NilError(t, err)
 
 	expected := []corev1.EnvVar{
@@ -158,7 +159,7 @@ func TestUpdateEnvVarsModify(t *testing.T) {
 	env := map[string]string{
 		"a": "fancy",
 	}
-	err := UpdateEnvVars(revision, env, []string{})
+	err := UpdateEnvVars(revision, "", env, []string{})
 	assert.NilError(t, err)
 
 	expected := map[string]string{
@@ -177,7 +178,7 @@ func TestUpdateEnvVarsRemove(t *testing.T) {
 		{Name: "b", Value: "bar"},
 	}
 	remove := []string{"b"}
-	err := UpdateEnvVars(revision, map[string]string{}, remove)
+	err := UpdateEnvVars(revision, "", map[string]string{}, remove)
 	assert.NilError(t, err)
 
 	expected := []corev1.EnvVar{
@@ -187,6 +188,55 @@ func TestUpdateEnvVarsRemove(t *testing.T) {
 	assert.DeepEqual(t, expected, container.Env)
 }
 
+func TestUpdateEnvVarsWithReferences(t *testing.T) {
+	template, container := getRevisionTemplate()
+	container.Env = []corev1.EnvVar{
+		{Name: "a", Value: "foo"},
+		{Name: "b", ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "old"}, Key: "k"},
+		}},
+	}
+	env := map[string]string{
+		"a": "secret:mysecret:password",
+		"b": "plain",
+		"c": "cm:myconfig:level",
+		"d": "cm:only-two",
+		"e": "http://a:b",
+	}
+	err := UpdateEnvVars(template, "", env, []string{})
+	assert.NilError(t, err)
+
+	expected := []corev1.EnvVar{
+		{Name: "a", ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"}, Key: "password"},
+		}},
+		{Name: "b", Value: "plain"},
+		{Name: "c", ValueFrom: &corev1.EnvVarSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "myconfig"}, Key: "level"},
+		}},
+		{Name: "d", Value: "cm:only-two"},
+		{Name: "e", Value: "http://a:b"},
+	}
+	assert.DeepEqual(t, expected, container.Env)
+
+	found, err := EnvToMap(container.Env)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, found, env)
+
+	err = UpdateEnvVars(template, "", map[string]string{"x": "secret::key"}, []string{})
+	assert.ErrorContains(t, err, "invalid reference")
+}
+
+func TestEnvVarSourceToString(t *testing.T) {
+	assert.Equal(t, EnvVarSourceToString(&corev1.EnvVarSource{
+		FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+	}), "field:metadata.name")
+	assert.Equal(t, EnvVarSourceToString(&corev1.EnvVarSource{
+		ResourceFieldRef: &corev1.ResourceFieldSelector{Resource: "limits.cpu"},
+	}), "resource:limits.cpu")
+	assert.Equal(t, EnvVarSourceToString(&corev1.EnvVarSource{}), "[ref]")
+}
+
 func TestUpdateMinScale(t *testing.T) {
 	template, _ := getRevisionTemplate()
 	err := UpdateMinScale(template, 10)
@@ -242,6 +292,76 @@ func TestUpdateConcurrencyLimit(t *testing.T) {
 	assert.ErrorContains(t, err, "invalid")
 }
 
+func TestUpdateAutoscaling(t *testing.T) {
+	template, _ := getRevisionTemplate()
+	assert.NilError(t, UpdateAutoscaleClass(template, "hpa"))
+	assert.NilError(t, UpdateAutoscaleTarget(template, "cpu=80"))
+	assert.NilError(t, UpdateInitialScale(template, 0))
+	assert.NilError(t, ValidateAutoscaling(template))
+	assert.Equal(t, template.Annotations[autoscaling.ClassAnnotationKey], autoscaling.HPA)
+	assert.Equal(t, template.Annotations[autoscaling.MetricAnnotationKey], "cpu")
+	assert.Equal(t, template.Annotations[autoscaling.TargetAnnotationKey], "80")
+	assert.Equal(t, template.Annotations[autoscaling.InitialScaleAnnotationKey], "0")
+
+	// Panic mode is only supported by the kpa class
+	assert.NilError(t, UpdatePanicWindow(template, 10))
+	assert.ErrorContains(t, ValidateAutoscaling(template), "panic mode")
+
+	template, _ = getRevisionTemplate()
+	assert.NilError(t, UpdateAutoscaleClass(template, autoscaling.KPA))
+	assert.NilError(t, UpdateAutoscaleTarget(template, "rps=150.5"))
+	assert.NilError(t, UpdatePanicWindow(template, 12.5))
+	assert.NilError(t, UpdatePanicThreshold(template, 200))
+	assert.NilError(t, ValidateAutoscaling(template))
+	assert.Equal(t, template.Annotations[autoscaling.ClassAnnotationKey], autoscaling.KPA)
+	assert.Equal(t, template.Annotations[autoscaling.MetricAnnotationKey], "rps")
+	assert.Equal(t, template.Annotations[autoscaling.TargetAnnotationKey], "150.5")
+	assert.Equal(t, template.Annotations[autoscaling.PanicWindowPercentageAnnotationKey], "12.5")
+	assert.Equal(t, template.Annotations[autoscaling.PanicThresholdPercentageAnnotationKey], "200")
+}
+
+func TestUpdateAutoscalingInvalid(t *testing.T) {
+	template, _ := getRevisionTemplate()
+	assert.ErrorContains(t, UpdateAutoscaleClass(template, "foo"), "invalid autoscale class")
+	assert.ErrorContains(t, UpdateAutoscaleMetric(template, "latency"), "invalid autoscale metric")
+	assert.ErrorContains(t, UpdateAutoscaleTarget(template, "rps=fast"), "invalid autoscale target")
+	assert.ErrorContains(t, UpdateAutoscaleTarget(template, "latency=10"), "invalid autoscale metric")
+	assert.ErrorContains(t, UpdateInitialScale(template, -1), "invalid scale-init")
+	assert.ErrorContains(t, UpdatePanicWindow(template, 120), "panicWindowPercentage")
+	assert.ErrorContains(t, UpdatePanicThreshold(template, 50), "panicThresholdPercentage")
+	assert.Equal(t, len(template.Annotations), 0)
+
+	for _, tc := range []struct {
+		class  string
+		target string
+		err    string
+	}{
+		{"kpa", "cpu=80", "invalid value: cpu: autoscaling.knative.dev/metric"},
+		{"hpa", "rps=100", "invalid value: rps: autoscaling.knative.dev/metric"},
+		{"hpa", "concurrency=100", "invalid value: concurrency: autoscaling.knative.dev/metric"},
+		{"hpa", "cpu=120", "utilization percentage"},
+		{"hpa", "memory=80", "supports no memory metric"},
+		{"kpa", "concurrency=0", "at least 0.01"},
+	} {
+		template, _ := getRevisionTemplate()
+		assert.NilError(t, UpdateAutoscaleClass(template, tc.class))
+		err := UpdateAutoscaleTarget(template, tc.target)
+		if err == nil {
+			err = ValidateAutoscaling(template)
+		}
+		assert.ErrorContains(t, err, tc.err)
+	}
+}
+
+func TestUpdateAutoscaleMetricWithExistingClass(t *testing.T) {
+	template, _ := getRevisionTemplate()
+	assert.NilError(t, UpdateAutoscaleClass(template, "hpa"))
+	assert.NilError(t, UpdateAutoscaleMetric(template, "cpu"))
+	assert.Equal(t, template.Annotations[autoscaling.MetricAnnotationKey], "cpu")
+	assert.ErrorContains(t, UpdateAutoscaleMetric(template, "rps"), "invalid value: rps")
+	assert.Equal(t, template.Annotations[autoscaling.MetricAnnotationKey], "cpu")
+}
+
 func TestUpdateContainerImage(t *testing.T) {
 	template, _ := getRevisionTemplate()
 	err := UpdateImage(template, "gcr.io/foo/bar:baz")
@@ -264,22 +384,22 @@ func checkContainerImage(t *testing.T, template *servingv1.RevisionTemplateSpec,
 
 func TestUpdateContainerCommand(t *testing.T) {
 	template, _ := getRevisionTemplate()
-	err := UpdateContainerCommand(template, "/app/start")
+	err := UpdateContainerCommand(template, "", "/app/start")
 	assert.NilError(t, err)
 	assert.DeepEqual(t, template.Spec.Containers[0].Command, []string{"/app/start"})
 
-	err = UpdateContainerCommand(template, "/app/latest")
+	err = UpdateContainerCommand(template, "", "/app/latest")
 	assert.NilError(t, err)
 	assert.DeepEqual(t, template.Spec.Containers[0].Command, []string{"/app/latest"})
 }
 
 func TestUpdateContainerArg(t *testing.T) {
 	template, _ := getRevisionTemplate()
-	err := UpdateContainerArg(template, []string{"--myArg"})
+	err := UpdateContainerArg(template, "", []string{"--myArg"})
 	assert.NilError(t, err)
 	assert.DeepEqual(t, template.Spec.Containers[0].Args, []string{"--myArg"})
 
-	err = UpdateContainerArg(template, []string{"myArg1", "--myArg2"})
+	err = UpdateContainerArg(template, "", []string{"myArg1", "--myArg2"})
 	assert.NilError(t, err)
 	assert.DeepEqual(t, template.Spec.Containers[0].Args, []string{"myArg1", "--myArg2"})
 }
@@ -335,6 +455,121 @@ func TestUpdateContainerPort(t *testing.T) {
 	}
 }
 
+func TestUpdateProbes(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		existing *corev1.Probe
+		input    string
+		expected *corev1.Probe
+		errMsg   string
+	}{{
+		name:  "http with port",
+		input: "http:/healthz:8080",
+		expected: &corev1.Probe{Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt(8080)},
+		}},
+	}, {
+		name:  "https without port and options",
+		input: "https:/ready,period=5,timeout=2,initialDelay=10,failureThreshold=3",
+		expected: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{Path: "/ready", Scheme: corev1.URISchemeHTTPS},
+			},
+			PeriodSeconds:       5,
+			TimeoutSeconds:      2,
+			InitialDelaySeconds: 10,
+			FailureThreshold:    3,
+		},
+	}, {
+		name:  "tcp",
+		input: "tcp:8080",
+		expected: &corev1.Probe{Handler: corev1.Handler{
+			TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(8080)},
+		}},
+	}, {
+		name:  "exec with arguments",
+		input: "exec:cat,/tmp/ready,period=1",
+		expected: &corev1.Probe{
+			Handler:       corev1.Handler{Exec: &corev1.ExecAction{Command: []string{"cat", "/tmp/ready"}}},
+			PeriodSeconds: 1,
+		},
+	}, {
+		name:     "options only update existing probe",
+		existing: &corev1.Probe{Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}}, PeriodSeconds: 5},
+		input:    "period=10,timeout=1",
+		expected: &corev1.Probe{
+			Handler:        corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}},
+			PeriodSeconds:  10,
+			TimeoutSeconds: 1,
+		},
+	}, {
+		name:     "handler replaces existing handler",
+		existing: &corev1.Probe{Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}}, PeriodSeconds: 5},
+		input:    "http:/healthz",
+		expected: &corev1.Probe{
+			Handler:       corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/healthz"}},
+			PeriodSeconds: 5,
+		},
+	}, {
+		name:     "removal",
+		existing: &corev1.Probe{Handler: corev1.Handler{TCPSocket: &corev1.TCPSocketAction{}}},
+		input:    "-",
+	}, {
+		name:   "options only without existing probe",
+		input:  "period=10",
+		errMsg: "does not specify a handler",
+	}, {
+		name:   "unknown handler",
+		input:  "grpc:8080",
+		errMsg: "'grpc:8080' is not valid",
+	}, {
+		name:   "relative http path",
+		input:  "http:healthz:8080",
+		errMsg: "is not valid",
+	}, {
+		name:   "unknown option",
+		input:  "tcp:8080,delay=5",
+		errMsg: "is not valid",
+	}, {
+		name:   "invalid option value",
+		input:  "tcp:8080,period=-1",
+		errMsg: "value '-1' of probe option 'period' is not valid",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			template, container := getRevisionTemplate()
+			container.ReadinessProbe = tc.existing
+			container.LivenessProbe = tc.existing
+			for _, update := range []func(*servingv1.RevisionTemplateSpec, string) error{UpdateReadinessProbe, UpdateLivenessProbe} {
+				err := update(template, tc.input)
+				if tc.errMsg != "" {
+					assert.ErrorContains(t, err, tc.errMsg)
+					continue
+				}
+				assert.NilError(t, err)
+			}
+			if tc.errMsg == "" {
+				assert.DeepEqual(t, template.Spec.Containers[0].ReadinessProbe, tc.expected)
+				assert.DeepEqual(t, template.Spec.Containers[0].LivenessProbe, tc.expected)
+			}
+		})
+	}
+}
+
+func TestProbeToString(t *testing.T) {
+	for _, spec := range []string{
+		"http:/healthz:8080",
+		"https:/ready,period=5,timeout=2,initialDelay=10,failureThreshold=3",
+		"tcp:",
+		"tcp:http",
+		"exec:cat,/tmp/ready,period=1",
+	} {
+		template, _ := getRevisionTemplate()
+		assert.NilError(t, UpdateReadinessProbe(template, spec))
+		assert.Equal(t, ProbeToString(template.Spec.Containers[0].ReadinessProbe), spec)
+	}
+	assert.Equal(t, ProbeToString(nil), "")
+}
+
 func checkUserUpdate(t *testing.T, template *servingv1.RevisionTemplateSpec, user *int64) {
 	assert.DeepEqual(t, template.Spec.Containers[0].SecurityContext.RunAsUser, user)
 }
@@ -351,7 +586,7 @@ func TestUpdateEnvVarsBoth(t *testing.T) {
 		"b": "boo",
 	}
 	remove := []string{"d"}
-	err := UpdateEnvVars(template, env, remove)
+	err := UpdateEnvVars(template, "", env, remove)
 	assert.NilError(t, err)
 
 	expected := []corev1.EnvVar{
@@ -460,7 +695,7 @@ func TestUpdateEnvFrom(t *testing.T) {
 					Name: "secret-existing-name",
 				}}},
 	)
-	UpdateEnvFrom(template,
+	UpdateEnvFrom(template, "",
 		[]string{"config-map:config-map-new-name-1", "secret:secret-new-name-1"},
 		[]string{"config-map:config-map-existing-name", "secret:secret-existing-name"})
 	assert.Equal(t, len(container.EnvFrom), 2)
@@ -521,28 +756,28 @@ func TestUpdateVolumeMountsAndVolumes(t *testing.T) {
 		},
 	)
 
-	err := UpdateVolumeMountsAndVolumes(template,
+	err := UpdateVolumeMountsAndVolumes(template, "",
 		util.NewOrderedMapWithKVStrings([][]string{{"/new-config-map/mount/path", "new-config-map-volume-name"}}),
 		[]string{},
 		util.NewOrderedMapWithKVStrings([][]string{{"new-config-map-volume-name", "config-map:new-config-map"}}),
 		[]string{})
 	assert.NilError(t, err)
 
-	err = UpdateVolumeMountsAndVolumes(template,
+	err = UpdateVolumeMountsAndVolumes(template, "",
 		util.NewOrderedMapWithKVStrings([][]string{{"/updated-config-map/mount/path", "existing-config-map-volume-name-2"}}),
 		[]string{},
 		util.NewOrderedMapWithKVStrings([][]string{{"existing-config-map-volume-name-2", "config-map:updated-config-map"}}),
 		[]string{})
 	assert.NilError(t, err)
 
-	err = UpdateVolumeMountsAndVolumes(template,
+	err = UpdateVolumeMountsAndVolumes(template, "",
 		util.NewOrderedMapWithKVStrings([][]string{{"/new-secret/mount/path", "new-secret-volume-name"}}),
 		[]string{},
 		util.NewOrderedMapWithKVStrings([][]string{{"new-secret-volume-name", "secret:new-secret"}}),
 		[]string{})
 	assert.NilError(t, err)
 
-	err = UpdateVolumeMountsAndVolumes(template,
+	err = UpdateVolumeMountsAndVolumes(template, "",
 		util.NewOrderedMapWithKVStrings([][]string{{"/updated-secret/mount/path", "existing-secret-volume-name-2"}}),
 		[]string{"/existing-config-map-1/mount/path",
 			"/existing-secret-1/mount/path"},
@@ -576,6 +811,146 @@ func TestUpdateVolumeMountsAndVolumes(t *testing.T) {
 	assert.Equal(t, container.VolumeMounts[5].MountPath, "/updated-secret/mount/path")
 }
 
+func TestUpdateVolumeItemsAndDefaultMode(t *testing.T) {
+	template, _ := getRevisionTemplate()
+	template.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "config-volume",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"},
+					Items:                []corev1.KeyToPath{{Key: "old.json", Path: "old.json"}},
+				},
+			},
+		},
+		{
+			Name: "secret-volume",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: "app-secret"},
+			},
+		},
+	}
+
+	items := util.NewOrderedMap()
+	items.Set("app-config:settings.json", "config.json")
+	items.Set("secret-volume:tls.crt", "certs/tls.crt")
+	err := UpdateVolumeItems(template, items, []string{"config-volume:old.json"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, template.Spec.Volumes[0].ConfigMap.Items, []corev1.KeyToPath{{Key: "settings.json", Path: "config.json"}})
+	assert.DeepEqual(t, template.Spec.Volumes[1].Secret.Items, []corev1.KeyToPath{{Key: "tls.crt", Path: "certs/tls.crt"}})
+
+	// Updating the path of an existing key
+	items = util.NewOrderedMap()
+	items.Set("app-config:settings.json", "app.json")
+	assert.NilError(t, UpdateVolumeItems(template, items, nil))
+	assert.DeepEqual(t, template.Spec.Volumes[0].ConfigMap.Items, []corev1.KeyToPath{{Key: "settings.json", Path: "app.json"}})
+
+	// Removing the last item exposes all keys again
+	assert.NilError(t, UpdateVolumeItems(template, util.NewOrderedMap(), []string{"app-config:settings.json"}))
+	assert.Assert(t, template.Spec.Volumes[0].ConfigMap.Items == nil)
+
+	err = UpdateVolumeDefaultMode(template, []string{"app-config", "secret-volume"}, 0440)
+	assert.NilError(t, err)
+	assert.Equal(t, *template.Spec.Volumes[0].ConfigMap.DefaultMode, int32(0440))
+	assert.Equal(t, *template.Spec.Volumes[1].Secret.DefaultMode, int32(0440))
+
+	// Items and mode are kept when the volume is given again with the same source
+	volumes := util.NewOrderedMap()
+	volumes.Set("secret-volume", "secret:app-secret")
+	err = UpdateVolumeMountsAndVolumes(template, "", util.NewOrderedMap(), nil, volumes, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, len(template.Spec.Volumes[1].Secret.Items), 1)
+	assert.Equal(t, *template.Spec.Volumes[1].Secret.DefaultMode, int32(0440))
+
+	for _, tc := range []struct {
+		ref  string
+		path string
+		msg  string
+	}{
+		{"app-config", "config.json", "form NAME:KEY"},
+		{"app-config:settings.json", "/etc/config.json", "must be a relative path"},
+		{"app-config:settings.json", "../config.json", "must be a relative path"},
+		{"other:settings.json", "config.json", "there is no ConfigMap or Secret volume \"other\""},
+	} {
+		items = util.NewOrderedMap()
+		items.Set(tc.ref, tc.path)
+		err = UpdateVolumeItems(template, items, nil)
+		assert.ErrorContains(t, err, tc.msg)
+	}
+	err = UpdateVolumeDefaultMode(template, []string{"other"}, 0400)
+	assert.ErrorContains(t, err, "there is no ConfigMap or Secret volume \"other\"")
+}
+
+func TestReferencedVolumeNames(t *testing.T) {
+	mounts := util.NewOrderedMap()
+	mounts.Set("/etc/cfg", "cm:app-config")
+	mounts.Set("/data", "data-volume")
+	volumes := util.NewOrderedMap()
+	volumes.Set("secret-volume", "secret:app-secret")
+	items := util.NewOrderedMap()
+	items.Set("app-config:settings.json", "config.json")
+	items.Set("other:key", "key")
+	assert.DeepEqual(t, ReferencedVolumeNames(mounts, volumes, items), []string{"app-config", "data-volume", "other", "secret-volume"})
+}
+
+func TestUpdateSidecars(t *testing.T) {
+	template, container := getRevisionTemplate()
+	container.Name = "app"
+	container.Image = "gcr.io/foo/app"
+
+	err := UpdateSidecars(template,
+		util.NewOrderedMapWithKVStrings([][]string{{"proxy", "gcr.io/foo/proxy:v1"}, {"logger", "gcr.io/foo/logger"}}), []string{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(template.Spec.Containers), 3)
+	assert.DeepEqual(t, template.Spec.Containers[0].Ports, []corev1.ContainerPort{{ContainerPort: 8080}})
+	assert.Equal(t, template.Spec.Containers[1].Name, "proxy")
+	assert.Equal(t, template.Spec.Containers[1].Image, "gcr.io/foo/proxy:v1")
+	assert.Equal(t, template.Spec.Containers[2].Name, "logger")
+
+	err = UpdateSidecars(template, util.NewOrderedMapWithKVStrings([][]string{{"proxy", "gcr.io/foo/proxy:v2"}}), []string{"logger"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(template.Spec.Containers), 2)
+	assert.Equal(t, template.Spec.Containers[1].Image, "gcr.io/foo/proxy:v2")
+
+	err = UpdateSidecars(template, util.NewOrderedMap(), []string{"app"})
+	assert.ErrorContains(t, err, "can not be removed")
+	err = UpdateSidecars(template, util.NewOrderedMapWithKVStrings([][]string{{"app", "gcr.io/foo/app:v2"}}), []string{})
+	assert.ErrorContains(t, err, "use --image")
+}
+
+func TestUpdateContainerByName(t *testing.T) {
+	template, container := getRevisionTemplate()
+	container.Name = "app"
+	container.Ports = []corev1.ContainerPort{{ContainerPort: 8080}}
+	template.Spec.Containers = append([]corev1.Container{{Name: "proxy"}}, template.Spec.Containers...)
+	proxy := &template.Spec.Containers[0]
+	app := &template.Spec.Containers[1]
+
+	assert.NilError(t, UpdateEnvVars(template, "proxy", map[string]string{"a": "b"}, []string{}))
+	assert.NilError(t, UpdateEnvVars(template, "", map[string]string{"c": "d"}, []string{}))
+	assert.DeepEqual(t, proxy.Env, []corev1.EnvVar{{Name: "a", Value: "b"}})
+	assert.DeepEqual(t, app.Env, []corev1.EnvVar{{Name: "c", Value: "d"}})
+
+	assert.NilError(t, UpdateContainerCommand(template, "proxy", "/proxy"))
+	assert.NilError(t, UpdateContainerArg(template, "proxy", []string{"--verbose"}))
+	assert.DeepEqual(t, proxy.Command, []string{"/proxy"})
+	assert.DeepEqual(t, proxy.Args, []string{"--verbose"})
+	assert.Assert(t, app.Command == nil)
+
+	assert.ErrorContains(t, UpdateEnvFrom(template, "bogus", []string{"cm:foo"}, []string{}), "no container 'bogus' found")
+
+	// A generated volume mounted by both containers is kept until the last mount is removed
+	mounts := util.NewOrderedMapWithKVStrings([][]string{{"/etc/config", "cm:config"}})
+	assert.NilError(t, UpdateVolumeMountsAndVolumes(template, "proxy", mounts, []string{}, util.NewOrderedMap(), []string{}))
+	assert.NilError(t, UpdateVolumeMountsAndVolumes(template, "", mounts, []string{}, util.NewOrderedMap(), []string{}))
+	assert.Equal(t, len(template.Spec.Volumes), 1)
+	assert.NilError(t, UpdateVolumeMountsAndVolumes(template, "proxy", util.NewOrderedMap(), []string{"/etc/config"}, util.NewOrderedMap(), []string{}))
+	assert.Equal(t, len(template.Spec.Volumes), 1)
+	assert.Equal(t, len(proxy.VolumeMounts), 0)
+	assert.NilError(t, UpdateVolumeMountsAndVolumes(template, "", util.NewOrderedMap(), []string{"/etc/config"}, util.NewOrderedMap(), []string{}))
+	assert.Equal(t, len(template.Spec.Volumes), 0)
+}
+
 func TestUpdateServiceAccountName(t *testing.T) {
 	template, _ := getRevisionTemplate()
 	template.Spec.ServiceAccountName = ""
@@ -17,6 +17,7 @@ package serving
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,19 +26,80 @@ import (
 )
 
 type Scaling struct {
-	Min *int
-	Max *int
+	Min  *int
+	Max  *int
+	Init *int
+
+	// Autoscaler settings as given in the annotations, with the class
+	// shortened to kpa|hpa for the built-in autoscalers
+	Class          string
+	Metric         string
+	Target         string
+	PanicWindow    string
+	PanicThreshold string
 }
 
 func ContainerOfRevisionTemplate(template *servingv1.RevisionTemplateSpec) (*corev1.Container, error) {
 	return ContainerOfRevisionSpec(&template.Spec)
 }
 
+// ContainerOfRevisionTemplateByName returns the container with the given name
+// or the serving container if the name is empty
+func ContainerOfRevisionTemplateByName(template *servingv1.RevisionTemplateSpec, name string) (*corev1.Container, error) {
+	return ContainerOfRevisionSpecByName(&template.Spec, name)
+}
+
+// ContainerOfRevisionSpec returns the container serving the requests. This is either the
+// only container or, for multiple containers, the one exposing a port. If no container
+// exposes a port, the first container is returned.
 func ContainerOfRevisionSpec(revisionSpec *servingv1.RevisionSpec) (*corev1.Container, error) {
 	if len(revisionSpec.Containers) == 0 {
 		return nil, fmt.Errorf("internal: no container set in spec.template.spec.containers")
 	}
-	return &revisionSpec.Containers[0], nil
+	return &revisionSpec.Containers[servingContainerIndex(revisionSpec)], nil
+}
+
+// ContainerOfRevisionSpecByName returns the container with the given name
+// or the serving container if the name is empty
+func ContainerOfRevisionSpecByName(revisionSpec *servingv1.RevisionSpec, name string) (*corev1.Container, error) {
+	if name == "" {
+		return ContainerOfRevisionSpec(revisionSpec)
+	}
+	names := make([]string, 0, len(revisionSpec.Containers))
+	for i := range revisionSpec.Containers {
+		if revisionSpec.Containers[i].Name == name {
+			return &revisionSpec.Containers[i], nil
+		}
+		names = append(names, revisionSpec.Containers[i].Name)
+	}
+	return nil, fmt.Errorf("no container '%s' found, available containers: %s", name, strings.Join(names, ", "))
+}
+
+// SidecarsOfRevisionSpec returns all containers except the serving container
+func SidecarsOfRevisionSpec(revisionSpec *servingv1.RevisionSpec) []*corev1.Container {
+	if len(revisionSpec.Containers) < 2 {
+		return nil
+	}
+	servingIdx := servingContainerIndex(revisionSpec)
+	sidecars := make([]*corev1.Container, 0, len(revisionSpec.Containers)-1)
+	for i := range revisionSpec.Containers {
+		if i != servingIdx {
+			sidecars = append(sidecars, &revisionSpec.Containers[i])
+		}
+	}
+	return sidecars
+}
+
+// servingContainerIndex returns the index of the container serving the requests
+func servingContainerIndex(revisionSpec *servingv1.RevisionSpec) int {
+	if len(revisionSpec.Containers) > 1 {
+		for i, container := range revisionSpec.Containers {
+			if len(container.Ports) > 0 {
+				return i
+			}
+		}
+	}
+	return 0
 }
 
 func ScalingInfo(m *metav1.ObjectMeta) (*Scaling, error) {
@@ -51,6 +113,15 @@ func ScalingInfo(m *metav1.ObjectMeta) (*Scaling, error) {
 	if err != nil {
 		return nil, err
 	}
+	ret.Init, err = annotationAsInt(m, autoscaling.InitialScaleAnnotationKey)
+	if err != nil {
+		return nil, err
+	}
+	ret.Class = AutoscaleClassName(m.Annotations[autoscaling.ClassAnnotationKey])
+	ret.Metric = m.Annotations[autoscaling.MetricAnnotationKey]
+	ret.Target = m.Annotations[autoscaling.TargetAnnotationKey]
+	ret.PanicWindow = m.Annotations[autoscaling.PanicWindowPercentageAnnotationKey]
+	ret.PanicThreshold = m.Annotations[autoscaling.PanicThresholdPercentageAnnotationKey]
 	return ret, nil
 }
 
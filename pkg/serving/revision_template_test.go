@@ -18,9 +18,11 @@ import (
 	"testing"
 
 	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
 type scalingInfoTest struct {
@@ -71,3 +73,66 @@ func TestScalingInfo(t *testing.T) {
 
 	}
 }
+
+func TestScalingInfoAutoscaler(t *testing.T) {
+	m := metav1.ObjectMeta{Annotations: map[string]string{
+		autoscaling.ClassAnnotationKey:                    autoscaling.HPA,
+		autoscaling.MetricAnnotationKey:                   "cpu",
+		autoscaling.TargetAnnotationKey:                   "80",
+		autoscaling.InitialScaleAnnotationKey:             "0",
+		autoscaling.PanicThresholdPercentageAnnotationKey: "200",
+	}}
+	s, err := ScalingInfo(&m)
+	assert.NilError(t, err)
+	assert.Equal(t, s.Class, "hpa")
+	assert.Equal(t, s.Metric, "cpu")
+	assert.Equal(t, s.Target, "80")
+	assert.Equal(t, *s.Init, 0)
+	assert.Equal(t, s.PanicWindow, "")
+	assert.Equal(t, s.PanicThreshold, "200")
+
+	m.Annotations[autoscaling.ClassAnnotationKey] = "custom.example.com"
+	m.Annotations[autoscaling.InitialScaleAnnotationKey] = "many"
+	_, err = ScalingInfo(&m)
+	assert.Assert(t, err != nil)
+}
+
+func TestContainerOfRevisionSpec(t *testing.T) {
+	spec := &servingv1.RevisionSpec{}
+	_, err := ContainerOfRevisionSpec(spec)
+	assert.ErrorContains(t, err, "no container set")
+
+	spec.Containers = []corev1.Container{{Name: "first"}}
+	container, err := ContainerOfRevisionSpec(spec)
+	assert.NilError(t, err)
+	assert.Equal(t, container.Name, "first")
+	assert.Assert(t, SidecarsOfRevisionSpec(spec) == nil)
+
+	// The serving container is identified by its port
+	spec.Containers = []corev1.Container{
+		{Name: "proxy"},
+		{Name: "app", Ports: []corev1.ContainerPort{{ContainerPort: 8080}}},
+		{Name: "logger"},
+	}
+	container, err = ContainerOfRevisionSpec(spec)
+	assert.NilError(t, err)
+	assert.Equal(t, container.Name, "app")
+
+	container, err = ContainerOfRevisionSpecByName(spec, "logger")
+	assert.NilError(t, err)
+	assert.Equal(t, container.Name, "logger")
+	container.Image = "gcr.io/foo/logger"
+	assert.Equal(t, spec.Containers[2].Image, "gcr.io/foo/logger")
+
+	container, err = ContainerOfRevisionSpecByName(spec, "")
+	assert.NilError(t, err)
+	assert.Equal(t, container.Name, "app")
+
+	_, err = ContainerOfRevisionSpecByName(spec, "bogus")
+	assert.ErrorContains(t, err, "no container 'bogus' found, available containers: proxy, app, logger")
+
+	sidecars := SidecarsOfRevisionSpec(spec)
+	assert.Equal(t, len(sidecars), 2)
+	assert.Equal(t, sidecars[0].Name, "proxy")
+	assert.Equal(t, sidecars[1].Name, "logger")
+}
@@ -19,7 +19,7 @@ import (
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"knative.dev/pkg/apis"
 	"knative.dev/serving/pkg/client/clientset/versioned/scheme"
 
@@ -28,7 +28,6 @@ import (
 	"knative.dev/client/pkg/wait"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	apiserving "knative.dev/serving/pkg/apis/serving"
@@ -42,6 +41,14 @@ import (
 // or an error
 type serviceUpdateFunc func(origService *servingv1.Service) (*servingv1.Service, error)
 
+// Func signature for an updating function which returns the updated configuration object
+// or an error
+type configurationUpdateFunc func(origConfiguration *servingv1.Configuration) (*servingv1.Configuration, error)
+
+// Func signature for an updating function which returns the updated route object
+// or an error
+type routeUpdateFunc func(origRoute *servingv1.Route) (*servingv1.Route, error)
+
 // Kn interface to serving. All methods are relative to the
 // namespace specified during construction
 type KnServingClient interface {
@@ -53,30 +60,60 @@ type KnServingClient interface {
 	GetService(name string) (*servingv1.Service, error)
 
 	// List services
-	ListServices(opts ...ListConfig) (*servingv1.ServiceList, error)
+	ListServices(opts ...util.ListConfig) (*servingv1.ServiceList, error)
+
+	// Watch services matching the given list configs for changes
+	WatchServices(opts ...util.ListConfig) (watch.Interface, error)
 
-	// Create a new service
-	CreateService(service *servingv1.Service) error
+	// Create a new service. If create options are given (e.g. for a server side dry-run),
+	// the given service is updated with the service returned by the server.
+	CreateService(service *servingv1.Service, opts ...v1.CreateOptions) error
 
 	// UpdateService updates the given service. For a more robust variant with automatic
-	// conflict resolution see UpdateServiceWithRetry
-	UpdateService(service *servingv1.Service) error
+	// conflict resolution see UpdateServiceWithRetry. If update options are given, the given
+	// service is updated with the service returned by the server.
+	UpdateService(service *servingv1.Service, opts ...v1.UpdateOptions) error
 
 	// UpdateServiceWithRetry updates service and retries if there is a version conflict.
 	// The updateFunc receives a deep copy of the existing service and can add update it in
-	// place.
-	UpdateServiceWithRetry(name string, updateFunc serviceUpdateFunc, nrRetries int) error
+	// place. The update options are passed to UpdateService.
+	UpdateServiceWithRetry(name string, updateFunc serviceUpdateFunc, nrRetries int, opts ...v1.UpdateOptions) error
 
-	// Delete a service by name
-	DeleteService(name string, timeout time.Duration) error
+	// Delete a service by name. The timeout is ignored for a server side dry-run.
+	DeleteService(name string, timeout time.Duration, opts ...v1.DeleteOptions) error
 
 	// Wait for a service to become ready, but not longer than provided timeout.
 	// Return error and how long has been waited
 	WaitForService(name string, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration)
 
+	// Wait for a service's condition to reach the given status, but not longer than provided timeout.
+	// Return immediately if the condition already has this status.
+	WaitForServiceCondition(name string, condition wait.Condition, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration)
+
 	// Get a configuration by name
 	GetConfiguration(name string) (*servingv1.Configuration, error)
 
+	// List configurations
+	ListConfigurations(opts ...util.ListConfig) (*servingv1.ConfigurationList, error)
+
+	// Create a new configuration
+	CreateConfiguration(configuration *servingv1.Configuration) error
+
+	// UpdateConfiguration updates the given configuration. For a more robust variant with
+	// automatic conflict resolution see UpdateConfigurationWithRetry
+	UpdateConfiguration(configuration *servingv1.Configuration) error
+
+	// UpdateConfigurationWithRetry updates a configuration and retries if there is a version conflict.
+	// The updateFunc receives a deep copy of the existing configuration and can update it in place.
+	UpdateConfigurationWithRetry(name string, updateFunc configurationUpdateFunc, nrRetries int) error
+
+	// Delete a configuration by name
+	DeleteConfiguration(name string, timeout time.Duration) error
+
+	// Wait for a configuration to become ready, but not longer than provided timeout.
+	// Return error and how long has been waited
+	WaitForConfiguration(name string, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration)
+
 	// Get a revision by name
 	GetRevision(name string) (*servingv1.Revision, error)
 
@@ -85,57 +122,49 @@ type KnServingClient interface {
 	GetBaseRevision(service *servingv1.Service) (*servingv1.Revision, error)
 
 	// List revisions
-	ListRevisions(opts ...ListConfig) (*servingv1.RevisionList, error)
+	ListRevisions(opts ...util.ListConfig) (*servingv1.RevisionList, error)
+
+	// Watch revisions matching the given list configs for changes
+	WatchRevisions(opts ...util.ListConfig) (watch.Interface, error)
 
-	// Delete a revision
-	DeleteRevision(name string, timeout time.Duration) error
+	// Delete a revision. The timeout is ignored for a server side dry-run.
+	DeleteRevision(name string, timeout time.Duration, opts ...v1.DeleteOptions) error
 
 	// Get a route by its unique name
 	GetRoute(name string) (*servingv1.Route, error)
 
 	// List routes
-	ListRoutes(opts ...ListConfig) (*servingv1.RouteList, error)
-}
+	ListRoutes(opts ...util.ListConfig) (*servingv1.RouteList, error)
 
-type listConfigCollector struct {
-	// Labels to filter on
-	Labels labels.Set
+	// Watch routes matching the given list configs for changes
+	WatchRoutes(opts ...util.ListConfig) (watch.Interface, error)
 
-	// Labels to filter on
-	Fields fields.Set
-}
+	// Create a new route
+	CreateRoute(route *servingv1.Route) error
 
-// Config function for builder pattern
-type ListConfig func(config *listConfigCollector)
+	// UpdateRoute updates the given route. For a more robust variant with
+	// automatic conflict resolution see UpdateRouteWithRetry
+	UpdateRoute(route *servingv1.Route) error
 
-type ListConfigs []ListConfig
+	// UpdateRouteWithRetry updates a route and retries if there is a version conflict.
+	// The updateFunc receives a deep copy of the existing route and can update it in place.
+	UpdateRouteWithRetry(name string, updateFunc routeUpdateFunc, nrRetries int) error
 
-// add selectors to a list options
-func (opts ListConfigs) toListOptions() v1.ListOptions {
-	listConfig := listConfigCollector{labels.Set{}, fields.Set{}}
-	for _, f := range opts {
-		f(&listConfig)
-	}
-	options := v1.ListOptions{}
-	if len(listConfig.Fields) > 0 {
-		options.FieldSelector = listConfig.Fields.String()
-	}
-	if len(listConfig.Labels) > 0 {
-		options.LabelSelector = listConfig.Labels.String()
-	}
-	return options
+	// Wait for a route to become ready, but not longer than provided timeout.
+	// Return error and how long has been waited
+	WaitForRoute(name string, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration)
 }
 
 // Filter list on the provided name
-func WithName(name string) ListConfig {
-	return func(lo *listConfigCollector) {
+func WithName(name string) util.ListConfig {
+	return func(lo *util.ListConfigCollector) {
 		lo.Fields["metadata.name"] = name
 	}
 }
 
 // Filter on the service name
-func WithService(service string) ListConfig {
-	return func(lo *listConfigCollector) {
+func WithService(service string) util.ListConfig {
+	return func(lo *util.ListConfigCollector) {
 		lo.Labels[apiserving.ServiceLabelKey] = service
 	}
 }
@@ -181,32 +210,50 @@ func (cl *knServingClient) WatchRevision(name string, timeout time.Duration) (wa
 		cl.client.RESTClient(), cl.namespace, "revision", name, timeout)
 }
 
+func (cl *knServingClient) WatchConfiguration(name string, timeout time.Duration) (watch.Interface, error) {
+	return wait.NewWatcher(cl.client.Configurations(cl.namespace).Watch,
+		cl.client.RESTClient(), cl.namespace, "configurations", name, timeout)
+}
+
+func (cl *knServingClient) WatchRoute(name string, timeout time.Duration) (watch.Interface, error) {
+	return wait.NewWatcher(cl.client.Routes(cl.namespace).Watch,
+		cl.client.RESTClient(), cl.namespace, "routes", name, timeout)
+}
+
+// Watch services for changes
+func (cl *knServingClient) WatchServices(config ...util.ListConfig) (watch.Interface, error) {
+	return wait.NewListWatcher(cl.client.Services(cl.namespace).Watch,
+		func(opts v1.ListOptions) (runtime.Object, error) {
+			return cl.client.Services(cl.namespace).List(opts)
+		}, util.ListConfigs(config).ListOptions())
+}
+
 // List services
-func (cl *knServingClient) ListServices(config ...ListConfig) (*servingv1.ServiceList, error) {
-	serviceList, err := cl.client.Services(cl.namespace).List(ListConfigs(config).toListOptions())
-	if err != nil {
-		return nil, clienterrors.GetError(err)
-	}
-	serviceListNew := serviceList.DeepCopy()
-	err = updateServingGvk(serviceListNew)
+func (cl *knServingClient) ListServices(config ...util.ListConfig) (*servingv1.ServiceList, error) {
+	serviceList, err := util.ListConfigs(config).ListAll(func(opts v1.ListOptions) (runtime.Object, error) {
+		serviceList, err := cl.client.Services(cl.namespace).List(opts)
+		if err != nil {
+			return nil, clienterrors.GetError(err)
+		}
+		return updateServingGvkForServiceList(serviceList)
+	})
 	if err != nil {
 		return nil, err
 	}
+	return serviceList.(*servingv1.ServiceList), nil
+}
 
-	serviceListNew.Items = make([]servingv1.Service, len(serviceList.Items))
-	for idx, service := range serviceList.Items {
-		serviceClone := service.DeepCopy()
-		err := updateServingGvk(serviceClone)
+// Create a new service
+func (cl *knServingClient) CreateService(service *servingv1.Service, opts ...v1.CreateOptions) error {
+	if len(opts) > 0 {
+		result := &servingv1.Service{}
+		err := util.CreateWithOptions(cl.client.RESTClient(), cl.namespace, "services", service, opts[0], result)
 		if err != nil {
-			return nil, err
+			return clienterrors.GetError(err)
 		}
-		serviceListNew.Items[idx] = *serviceClone
+		*service = *result
+		return updateServingGvk(service)
 	}
-	return serviceListNew, nil
-}
-
-// Create a new service
-func (cl *knServingClient) CreateService(service *servingv1.Service) error {
 	_, err := cl.client.Services(cl.namespace).Create(service)
 	if err != nil {
 		return clienterrors.GetError(err)
@@ -215,7 +262,16 @@ func (cl *knServingClient) CreateService(service *servingv1.Service) error {
 }
 
 // Update the given service
-func (cl *knServingClient) UpdateService(service *servingv1.Service) error {
+func (cl *knServingClient) UpdateService(service *servingv1.Service, opts ...v1.UpdateOptions) error {
+	if len(opts) > 0 {
+		result := &servingv1.Service{}
+		err := util.UpdateWithOptions(cl.client.RESTClient(), cl.namespace, "services", service.Name, service, opts[0], result)
+		if err != nil {
+			return err
+		}
+		*service = *result
+		return updateServingGvk(service)
+	}
 	_, err := cl.client.Services(cl.namespace).Update(service)
 	if err != nil {
 		return err
@@ -224,27 +280,42 @@ func (cl *knServingClient) UpdateService(service *servingv1.Service) error {
 }
 
 // Update the given service with a retry in case of a conflict
-func (cl *knServingClient) UpdateServiceWithRetry(name string, updateFunc serviceUpdateFunc, nrRetries int) error {
-	return updateServiceWithRetry(cl, name, updateFunc, nrRetries)
+func (cl *knServingClient) UpdateServiceWithRetry(name string, updateFunc serviceUpdateFunc, nrRetries int, opts ...v1.UpdateOptions) error {
+	return updateServiceWithRetry(cl, name, updateFunc, nrRetries, opts...)
 }
 
 // Extracted to be usable with the Mocking client
-func updateServiceWithRetry(cl KnServingClient, name string, updateFunc serviceUpdateFunc, nrRetries int) error {
+func updateServiceWithRetry(cl KnServingClient, name string, updateFunc serviceUpdateFunc, nrRetries int, opts ...v1.UpdateOptions) error {
+	return updateWithRetry("service", name, nrRetries,
+		func() (runtime.Object, error) { return cl.GetService(name) },
+		func(obj runtime.Object) (runtime.Object, error) { return updateFunc(obj.(*servingv1.Service)) },
+		func(obj runtime.Object) error { return cl.UpdateService(obj.(*servingv1.Service), opts...) })
+}
+
+// updateWithRetry gets an object, changes it with the given modify function and updates it.
+// If the update fails because of a resource version conflict, the object is fetched and changed
+// again, at most nrRetries times.
+func updateWithRetry(kind string, name string, nrRetries int, get func() (runtime.Object, error),
+	modify func(runtime.Object) (runtime.Object, error), update func(runtime.Object) error) error {
 	var retries = 0
 	for {
-		service, err := cl.GetService(name)
+		obj, err := get()
 		if err != nil {
 			return err
 		}
-		if service.GetDeletionTimestamp() != nil {
-			return fmt.Errorf("can't update service %s because it has been marked for deletion", name)
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		if accessor.GetDeletionTimestamp() != nil {
+			return fmt.Errorf("can't update %s %s because it has been marked for deletion", kind, name)
 		}
-		updatedService, err := updateFunc(service.DeepCopy())
+		updated, err := modify(obj.DeepCopyObject())
 		if err != nil {
 			return err
 		}
 
-		err = cl.UpdateService(updatedService)
+		err = update(updated)
 		if err != nil {
 			// Retry to update when a resource version conflict exists
 			if apierrors.IsConflict(err) && retries < nrRetries {
@@ -261,10 +332,14 @@ func updateServiceWithRetry(cl KnServingClient, name string, updateFunc serviceU
 
 // Delete a service by name
 // Param `timeout` represents a duration to wait for a delete op to finish.
-// For `timeout == 0` delete is performed async without any wait.
-func (cl *knServingClient) DeleteService(serviceName string, timeout time.Duration) error {
-	if timeout == 0 {
-		return cl.deleteService(serviceName, v1.DeletePropagationBackground)
+// For `timeout == 0` or a server side dry-run, delete is performed async without any wait.
+func (cl *knServingClient) DeleteService(serviceName string, timeout time.Duration, opts ...v1.DeleteOptions) error {
+	deleteOptions := v1.DeleteOptions{}
+	if len(opts) > 0 {
+		deleteOptions = opts[0]
+	}
+	if timeout == 0 || len(deleteOptions.DryRun) > 0 {
+		return cl.deleteService(serviceName, deleteOptions, v1.DeletePropagationBackground)
 	}
 	waitC := make(chan error)
 	go func() {
@@ -272,17 +347,18 @@ func (cl *knServingClient) DeleteService(serviceName string, timeout time.Durati
 		err, _ := waitForEvent.Wait(serviceName, wait.Options{Timeout: &timeout}, wait.NoopMessageCallback())
 		waitC <- err
 	}()
-	err := cl.deleteService(serviceName, v1.DeletePropagationForeground)
+	err := cl.deleteService(serviceName, deleteOptions, v1.DeletePropagationForeground)
 	if err != nil {
 		return err
 	}
 	return <-waitC
 }
 
-func (cl *knServingClient) deleteService(serviceName string, propagationPolicy v1.DeletionPropagation) error {
+func (cl *knServingClient) deleteService(serviceName string, options v1.DeleteOptions, propagationPolicy v1.DeletionPropagation) error {
+	options.PropagationPolicy = &propagationPolicy
 	err := cl.client.Services(cl.namespace).Delete(
 		serviceName,
-		&v1.DeleteOptions{PropagationPolicy: &propagationPolicy},
+		&options,
 	)
 	if err != nil {
 		return clienterrors.GetError(err)
@@ -297,6 +373,12 @@ func (cl *knServingClient) WaitForService(name string, timeout time.Duration, ms
 	return waitForReady.Wait(name, wait.Options{Timeout: &timeout}, msgCallback)
 }
 
+// Wait for a service's condition to reach the given status
+func (cl *knServingClient) WaitForServiceCondition(name string, condition wait.Condition, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration) {
+	waitForCondition := wait.NewWaitForCondition("service", condition, cl.WatchService, serviceConditionExtractor)
+	return waitForCondition.Wait(name, wait.Options{Timeout: &timeout}, msgCallback)
+}
+
 // Get the configuration for a service
 func (cl *knServingClient) GetConfiguration(name string) (*servingv1.Configuration, error) {
 	configuration, err := cl.client.Configurations(cl.namespace).Get(name, v1.GetOptions{})
@@ -310,6 +392,88 @@ func (cl *knServingClient) GetConfiguration(name string) (*servingv1.Configurati
 	return configuration, nil
 }
 
+// List configurations
+func (cl *knServingClient) ListConfigurations(config ...util.ListConfig) (*servingv1.ConfigurationList, error) {
+	configurationList, err := util.ListConfigs(config).ListAll(func(opts v1.ListOptions) (runtime.Object, error) {
+		configurationList, err := cl.client.Configurations(cl.namespace).List(opts)
+		if err != nil {
+			return nil, clienterrors.GetError(err)
+		}
+		return updateServingGvkForConfigurationList(configurationList)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return configurationList.(*servingv1.ConfigurationList), nil
+}
+
+// Create a new configuration
+func (cl *knServingClient) CreateConfiguration(configuration *servingv1.Configuration) error {
+	_, err := cl.client.Configurations(cl.namespace).Create(configuration)
+	if err != nil {
+		return clienterrors.GetError(err)
+	}
+	return updateServingGvk(configuration)
+}
+
+// Update the given configuration
+func (cl *knServingClient) UpdateConfiguration(configuration *servingv1.Configuration) error {
+	_, err := cl.client.Configurations(cl.namespace).Update(configuration)
+	if err != nil {
+		return err
+	}
+	return updateServingGvk(configuration)
+}
+
+// Update the given configuration with a retry in case of a conflict
+func (cl *knServingClient) UpdateConfigurationWithRetry(name string, updateFunc configurationUpdateFunc, nrRetries int) error {
+	return updateConfigurationWithRetry(cl, name, updateFunc, nrRetries)
+}
+
+func updateConfigurationWithRetry(cl KnServingClient, name string, updateFunc configurationUpdateFunc, nrRetries int) error {
+	return updateWithRetry("configuration", name, nrRetries,
+		func() (runtime.Object, error) { return cl.GetConfiguration(name) },
+		func(obj runtime.Object) (runtime.Object, error) { return updateFunc(obj.(*servingv1.Configuration)) },
+		func(obj runtime.Object) error { return cl.UpdateConfiguration(obj.(*servingv1.Configuration)) })
+}
+
+// Delete a configuration by name
+// Param `timeout` represents a duration to wait for a delete op to finish.
+// For `timeout == 0` delete is performed async without any wait.
+func (cl *knServingClient) DeleteConfiguration(name string, timeout time.Duration) error {
+	if timeout == 0 {
+		return cl.deleteConfiguration(name, v1.DeletePropagationBackground)
+	}
+	waitC := make(chan error)
+	go func() {
+		waitForEvent := wait.NewWaitForEvent("configuration", cl.WatchConfiguration, func(evt *watch.Event) bool { return evt.Type == watch.Deleted })
+		err, _ := waitForEvent.Wait(name, wait.Options{Timeout: &timeout}, wait.NoopMessageCallback())
+		waitC <- err
+	}()
+	err := cl.deleteConfiguration(name, v1.DeletePropagationForeground)
+	if err != nil {
+		return err
+	}
+	return <-waitC
+}
+
+func (cl *knServingClient) deleteConfiguration(name string, propagationPolicy v1.DeletionPropagation) error {
+	err := cl.client.Configurations(cl.namespace).Delete(
+		name,
+		&v1.DeleteOptions{PropagationPolicy: &propagationPolicy},
+	)
+	if err != nil {
+		return clienterrors.GetError(err)
+	}
+	return nil
+}
+
+// Wait for a configuration to become ready, but not longer than provided timeout
+func (cl *knServingClient) WaitForConfiguration(name string, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration) {
+	waitForReady := wait.NewWaitForReady("configuration", cl.WatchConfiguration, configurationConditionExtractor)
+	return waitForReady.Wait(name, wait.Options{Timeout: &timeout}, msgCallback)
+}
+
 // Get a revision by name
 func (cl *knServingClient) GetRevision(name string) (*servingv1.Revision, error) {
 	revision, err := cl.client.Revisions(cl.namespace).Get(name, v1.GetOptions{})
@@ -377,7 +541,7 @@ func getBaseRevision(cl KnServingClient, service *servingv1.Service) (*servingv1
 }
 
 // Delete a revision by name
-func (cl *knServingClient) DeleteRevision(name string, timeout time.Duration) error {
+func (cl *knServingClient) DeleteRevision(name string, timeout time.Duration, opts ...v1.DeleteOptions) error {
 	revision, err := cl.client.Revisions(cl.namespace).Get(name, v1.GetOptions{})
 	if err != nil {
 		return clienterrors.GetError(err)
@@ -385,8 +549,12 @@ func (cl *knServingClient) DeleteRevision(name string, timeout time.Duration) er
 	if revision.GetDeletionTimestamp() != nil {
 		return fmt.Errorf("can't delete revision '%s' because it has been already marked for deletion", name)
 	}
-	if timeout == 0 {
-		return cl.deleteRevision(name)
+	deleteOptions := v1.DeleteOptions{}
+	if len(opts) > 0 {
+		deleteOptions = opts[0]
+	}
+	if timeout == 0 || len(deleteOptions.DryRun) > 0 {
+		return cl.deleteRevision(name, deleteOptions)
 	}
 	waitC := make(chan error)
 	go func() {
@@ -394,7 +562,7 @@ func (cl *knServingClient) DeleteRevision(name string, timeout time.Duration) er
 		err, _ := waitForEvent.Wait(name, wait.Options{Timeout: &timeout}, wait.NoopMessageCallback())
 		waitC <- err
 	}()
-	err = cl.deleteRevision(name)
+	err = cl.deleteRevision(name, deleteOptions)
 	if err != nil {
 		return clienterrors.GetError(err)
 	}
@@ -402,8 +570,8 @@ func (cl *knServingClient) DeleteRevision(name string, timeout time.Duration) er
 	return <-waitC
 }
 
-func (cl *knServingClient) deleteRevision(name string) error {
-	err := cl.client.Revisions(cl.namespace).Delete(name, &v1.DeleteOptions{})
+func (cl *knServingClient) deleteRevision(name string, options v1.DeleteOptions) error {
+	err := cl.client.Revisions(cl.namespace).Delete(name, &options)
 	if err != nil {
 		return clienterrors.GetError(err)
 	}
@@ -412,12 +580,26 @@ func (cl *knServingClient) deleteRevision(name string) error {
 }
 
 // List revisions
-func (cl *knServingClient) ListRevisions(config ...ListConfig) (*servingv1.RevisionList, error) {
-	revisionList, err := cl.client.Revisions(cl.namespace).List(ListConfigs(config).toListOptions())
+func (cl *knServingClient) ListRevisions(config ...util.ListConfig) (*servingv1.RevisionList, error) {
+	revisionList, err := util.ListConfigs(config).ListAll(func(opts v1.ListOptions) (runtime.Object, error) {
+		revisionList, err := cl.client.Revisions(cl.namespace).List(opts)
+		if err != nil {
+			return nil, clienterrors.GetError(err)
+		}
+		return updateServingGvkForRevisionList(revisionList)
+	})
 	if err != nil {
-		return nil, clienterrors.GetError(err)
+		return nil, err
 	}
-	return updateServingGvkForRevisionList(revisionList)
+	return revisionList.(*servingv1.RevisionList), nil
+}
+
+// Watch revisions for changes
+func (cl *knServingClient) WatchRevisions(config ...util.ListConfig) (watch.Interface, error) {
+	return wait.NewListWatcher(cl.client.Revisions(cl.namespace).Watch,
+		func(opts v1.ListOptions) (runtime.Object, error) {
+			return cl.client.Revisions(cl.namespace).List(opts)
+		}, util.ListConfigs(config).ListOptions())
 }
 
 // Get a route by its unique name
@@ -434,12 +616,83 @@ func (cl *knServingClient) GetRoute(name string) (*servingv1.Route, error) {
 }
 
 // List routes
-func (cl *knServingClient) ListRoutes(config ...ListConfig) (*servingv1.RouteList, error) {
-	routeList, err := cl.client.Routes(cl.namespace).List(ListConfigs(config).toListOptions())
+func (cl *knServingClient) ListRoutes(config ...util.ListConfig) (*servingv1.RouteList, error) {
+	routeList, err := util.ListConfigs(config).ListAll(func(opts v1.ListOptions) (runtime.Object, error) {
+		routeList, err := cl.client.Routes(cl.namespace).List(opts)
+		if err != nil {
+			return nil, clienterrors.GetError(err)
+		}
+		return updateServingGvkForRouteList(routeList)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return routeList.(*servingv1.RouteList), nil
+}
+
+// Watch routes for changes
+func (cl *knServingClient) WatchRoutes(config ...util.ListConfig) (watch.Interface, error) {
+	return wait.NewListWatcher(cl.client.Routes(cl.namespace).Watch,
+		func(opts v1.ListOptions) (runtime.Object, error) {
+			return cl.client.Routes(cl.namespace).List(opts)
+		}, util.ListConfigs(config).ListOptions())
+}
+
+// Create a new route
+func (cl *knServingClient) CreateRoute(route *servingv1.Route) error {
+	_, err := cl.client.Routes(cl.namespace).Create(route)
+	if err != nil {
+		return clienterrors.GetError(err)
+	}
+	return updateServingGvk(route)
+}
+
+// Update the given route
+func (cl *knServingClient) UpdateRoute(route *servingv1.Route) error {
+	_, err := cl.client.Routes(cl.namespace).Update(route)
+	if err != nil {
+		return err
+	}
+	return updateServingGvk(route)
+}
+
+// Update the given route with a retry in case of a conflict
+func (cl *knServingClient) UpdateRouteWithRetry(name string, updateFunc routeUpdateFunc, nrRetries int) error {
+	return updateRouteWithRetry(cl, name, updateFunc, nrRetries)
+}
+
+func updateRouteWithRetry(cl KnServingClient, name string, updateFunc routeUpdateFunc, nrRetries int) error {
+	return updateWithRetry("route", name, nrRetries,
+		func() (runtime.Object, error) { return cl.GetRoute(name) },
+		func(obj runtime.Object) (runtime.Object, error) { return updateFunc(obj.(*servingv1.Route)) },
+		func(obj runtime.Object) error { return cl.UpdateRoute(obj.(*servingv1.Route)) })
+}
+
+// Wait for a route to become ready, but not longer than provided timeout
+func (cl *knServingClient) WaitForRoute(name string, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration) {
+	waitForReady := wait.NewWaitForReady("route", cl.WatchRoute, routeConditionExtractor)
+	return waitForReady.Wait(name, wait.Options{Timeout: &timeout}, msgCallback)
+}
+
+// update all the list + all items contained in the list with
+// the proper GroupVersionKind specific to Knative serving
+func updateServingGvkForServiceList(serviceList *servingv1.ServiceList) (*servingv1.ServiceList, error) {
+	serviceListNew := serviceList.DeepCopy()
+	err := updateServingGvk(serviceListNew)
 	if err != nil {
 		return nil, err
 	}
-	return updateServingGvkForRouteList(routeList)
+
+	serviceListNew.Items = make([]servingv1.Service, len(serviceList.Items))
+	for idx, service := range serviceList.Items {
+		serviceClone := service.DeepCopy()
+		err := updateServingGvk(serviceClone)
+		if err != nil {
+			return nil, err
+		}
+		serviceListNew.Items[idx] = *serviceClone
+	}
+	return serviceListNew, nil
 }
 
 // update all the list + all items contained in the list with
@@ -484,6 +737,27 @@ func updateServingGvkForRouteList(routeList *servingv1.RouteList) (*servingv1.Ro
 	return routeListNew, nil
 }
 
+// update all the list + all items contained in the list with
+// the proper GroupVersionKind specific to Knative serving
+func updateServingGvkForConfigurationList(configurationList *servingv1.ConfigurationList) (*servingv1.ConfigurationList, error) {
+	configurationListNew := configurationList.DeepCopy()
+	err := updateServingGvk(configurationListNew)
+	if err != nil {
+		return nil, err
+	}
+
+	configurationListNew.Items = make([]servingv1.Configuration, len(configurationList.Items))
+	for idx := range configurationList.Items {
+		configuration := configurationList.Items[idx].DeepCopy()
+		err := updateServingGvk(configuration)
+		if err != nil {
+			return nil, err
+		}
+		configurationListNew.Items[idx] = *configuration
+	}
+	return configurationListNew, nil
+}
+
 // update with the servingv1 group + version
 func updateServingGvk(obj runtime.Object) error {
 	return util.UpdateGroupVersionKindWithScheme(obj, servingv1.SchemeGroupVersion, scheme.Scheme)
@@ -496,3 +770,19 @@ func serviceConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
 	}
 	return apis.Conditions(service.Status.Conditions), nil
 }
+
+func configurationConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
+	configuration, ok := obj.(*servingv1.Configuration)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a configuration", obj)
+	}
+	return apis.Conditions(configuration.Status.Conditions), nil
+}
+
+func routeConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
+	route, ok := obj.(*servingv1.Route)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a route", obj)
+	}
+	return apis.Conditions(route.Status.Conditions), nil
+}
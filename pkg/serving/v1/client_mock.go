@@ -19,10 +19,13 @@ import (
 	"time"
 
 	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
 	"knative.dev/client/pkg/wait"
 )
@@ -75,17 +78,29 @@ func (sr *ServingRecorder) ListServices(opts interface{}, serviceList *servingv1
 	sr.r.Add("ListServices", []interface{}{opts}, []interface{}{serviceList, err})
 }
 
-func (c *MockKnServingClient) ListServices(opts ...ListConfig) (*servingv1.ServiceList, error) {
+func (c *MockKnServingClient) ListServices(opts ...util.ListConfig) (*servingv1.ServiceList, error) {
 	call := c.recorder.r.VerifyCall("ListServices", opts)
 	return call.Result[0].(*servingv1.ServiceList), mock.ErrorOrNil(call.Result[1])
 }
 
+// Watch services
+func (sr *ServingRecorder) WatchServices(opts interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchServices", []interface{}{opts}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchServices(opts ...util.ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchServices", opts)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Create a new service
 func (sr *ServingRecorder) CreateService(service interface{}, err error) {
 	sr.r.Add("CreateService", []interface{}{service}, []interface{}{err})
 }
 
-func (c *MockKnServingClient) CreateService(service *servingv1.Service) error {
+// Options are not verified
+func (c *MockKnServingClient) CreateService(service *servingv1.Service, opts ...metav1.CreateOptions) error {
 	call := c.recorder.r.VerifyCall("CreateService", service)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -95,14 +110,15 @@ func (sr *ServingRecorder) UpdateService(service interface{}, err error) {
 	sr.r.Add("UpdateService", []interface{}{service}, []interface{}{err})
 }
 
-func (c *MockKnServingClient) UpdateService(service *servingv1.Service) error {
+// Options are not verified
+func (c *MockKnServingClient) UpdateService(service *servingv1.Service, opts ...metav1.UpdateOptions) error {
 	call := c.recorder.r.VerifyCall("UpdateService", service)
 	return mock.ErrorOrNil(call.Result[0])
 }
 
 // Delegate to shared retry method
-func (c *MockKnServingClient) UpdateServiceWithRetry(name string, updateFunc serviceUpdateFunc, maxRetry int) error {
-	return updateServiceWithRetry(c, name, updateFunc, maxRetry)
+func (c *MockKnServingClient) UpdateServiceWithRetry(name string, updateFunc serviceUpdateFunc, maxRetry int, opts ...metav1.UpdateOptions) error {
+	return updateServiceWithRetry(c, name, updateFunc, maxRetry, opts...)
 }
 
 // Delete a service by name
@@ -110,7 +126,8 @@ func (sr *ServingRecorder) DeleteService(name, timeout interface{}, err error) {
 	sr.r.Add("DeleteService", []interface{}{name, timeout}, []interface{}{err})
 }
 
-func (c *MockKnServingClient) DeleteService(name string, timeout time.Duration) error {
+// Options are not verified
+func (c *MockKnServingClient) DeleteService(name string, timeout time.Duration, opts ...metav1.DeleteOptions) error {
 	call := c.recorder.r.VerifyCall("DeleteService", name, timeout)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -125,6 +142,16 @@ func (c *MockKnServingClient) WaitForService(name string, timeout time.Duration,
 	return mock.ErrorOrNil(call.Result[0]), call.Result[1].(time.Duration)
 }
 
+// Wait for a service's condition to reach the given status
+func (sr *ServingRecorder) WaitForServiceCondition(name interface{}, condition interface{}, timeout interface{}, callback interface{}, err error, duration time.Duration) {
+	sr.r.Add("WaitForServiceCondition", []interface{}{name, condition, timeout, callback}, []interface{}{err, duration})
+}
+
+func (c *MockKnServingClient) WaitForServiceCondition(name string, condition wait.Condition, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration) {
+	call := c.recorder.r.VerifyCall("WaitForServiceCondition", name, condition, timeout, msgCallback)
+	return mock.ErrorOrNil(call.Result[0]), call.Result[1].(time.Duration)
+}
+
 // Get a revision by name
 func (sr *ServingRecorder) GetRevision(name interface{}, revision *servingv1.Revision, err error) {
 	sr.r.Add("GetRevision", []interface{}{name}, []interface{}{revision, err})
@@ -140,17 +167,29 @@ func (sr *ServingRecorder) ListRevisions(opts interface{}, revisionList *serving
 	sr.r.Add("ListRevisions", []interface{}{opts}, []interface{}{revisionList, err})
 }
 
-func (c *MockKnServingClient) ListRevisions(opts ...ListConfig) (*servingv1.RevisionList, error) {
+func (c *MockKnServingClient) ListRevisions(opts ...util.ListConfig) (*servingv1.RevisionList, error) {
 	call := c.recorder.r.VerifyCall("ListRevisions", opts)
 	return call.Result[0].(*servingv1.RevisionList), mock.ErrorOrNil(call.Result[1])
 }
 
+// Watch revisions
+func (sr *ServingRecorder) WatchRevisions(opts interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchRevisions", []interface{}{opts}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchRevisions(opts ...util.ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchRevisions", opts)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Delete a revision
 func (sr *ServingRecorder) DeleteRevision(name, timeout interface{}, err error) {
 	sr.r.Add("DeleteRevision", []interface{}{name, timeout}, []interface{}{err})
 }
 
-func (c *MockKnServingClient) DeleteRevision(name string, timeout time.Duration) error {
+// Options are not verified
+func (c *MockKnServingClient) DeleteRevision(name string, timeout time.Duration, opts ...metav1.DeleteOptions) error {
 	call := c.recorder.r.VerifyCall("DeleteRevision", name, timeout)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -171,11 +210,22 @@ func (sr *ServingRecorder) ListRoutes(opts interface{}, routeList *servingv1.Rou
 	sr.r.Add("ListRoutes", []interface{}{opts}, []interface{}{routeList, err})
 }
 
-func (c *MockKnServingClient) ListRoutes(opts ...ListConfig) (*servingv1.RouteList, error) {
+func (c *MockKnServingClient) ListRoutes(opts ...util.ListConfig) (*servingv1.RouteList, error) {
 	call := c.recorder.r.VerifyCall("ListRoutes", opts)
 	return call.Result[0].(*servingv1.RouteList), mock.ErrorOrNil(call.Result[1])
 }
 
+// Watch routes
+func (sr *ServingRecorder) WatchRoutes(opts interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchRoutes", []interface{}{opts}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchRoutes(opts ...util.ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchRoutes", opts)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // GetConfiguration records a call to GetConfiguration with possible return values
 func (sr *ServingRecorder) GetConfiguration(name string, config *servingv1.Configuration, err error) {
 	sr.r.Add("GetConfiguration", []interface{}{name}, []interface{}{config, err})
@@ -193,6 +243,96 @@ func (c *MockKnServingClient) GetConfiguration(name string) (*servingv1.Configur
 	return call.Result[0].(*servingv1.Configuration), mock.ErrorOrNil(call.Result[1])
 }
 
+// List configurations
+func (sr *ServingRecorder) ListConfigurations(opts interface{}, configurationList *servingv1.ConfigurationList, err error) {
+	sr.r.Add("ListConfigurations", []interface{}{opts}, []interface{}{configurationList, err})
+}
+
+func (c *MockKnServingClient) ListConfigurations(opts ...util.ListConfig) (*servingv1.ConfigurationList, error) {
+	call := c.recorder.r.VerifyCall("ListConfigurations", opts)
+	return call.Result[0].(*servingv1.ConfigurationList), mock.ErrorOrNil(call.Result[1])
+}
+
+// Create a new configuration
+func (sr *ServingRecorder) CreateConfiguration(configuration interface{}, err error) {
+	sr.r.Add("CreateConfiguration", []interface{}{configuration}, []interface{}{err})
+}
+
+func (c *MockKnServingClient) CreateConfiguration(configuration *servingv1.Configuration) error {
+	call := c.recorder.r.VerifyCall("CreateConfiguration", configuration)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// Update the given configuration
+func (sr *ServingRecorder) UpdateConfiguration(configuration interface{}, err error) {
+	sr.r.Add("UpdateConfiguration", []interface{}{configuration}, []interface{}{err})
+}
+
+func (c *MockKnServingClient) UpdateConfiguration(configuration *servingv1.Configuration) error {
+	call := c.recorder.r.VerifyCall("UpdateConfiguration", configuration)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// Delegate to shared retry method
+func (c *MockKnServingClient) UpdateConfigurationWithRetry(name string, updateFunc configurationUpdateFunc, maxRetry int) error {
+	return updateConfigurationWithRetry(c, name, updateFunc, maxRetry)
+}
+
+// Delete a configuration by name
+func (sr *ServingRecorder) DeleteConfiguration(name, timeout interface{}, err error) {
+	sr.r.Add("DeleteConfiguration", []interface{}{name, timeout}, []interface{}{err})
+}
+
+func (c *MockKnServingClient) DeleteConfiguration(name string, timeout time.Duration) error {
+	call := c.recorder.r.VerifyCall("DeleteConfiguration", name, timeout)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// Wait for a configuration to become ready, but not longer than provided timeout
+func (sr *ServingRecorder) WaitForConfiguration(name interface{}, timeout interface{}, callback interface{}, err error, duration time.Duration) {
+	sr.r.Add("WaitForConfiguration", []interface{}{name, timeout, callback}, []interface{}{err, duration})
+}
+
+func (c *MockKnServingClient) WaitForConfiguration(name string, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration) {
+	call := c.recorder.r.VerifyCall("WaitForConfiguration", name, timeout, msgCallback)
+	return mock.ErrorOrNil(call.Result[0]), call.Result[1].(time.Duration)
+}
+
+// Create a new route
+func (sr *ServingRecorder) CreateRoute(route interface{}, err error) {
+	sr.r.Add("CreateRoute", []interface{}{route}, []interface{}{err})
+}
+
+func (c *MockKnServingClient) CreateRoute(route *servingv1.Route) error {
+	call := c.recorder.r.VerifyCall("CreateRoute", route)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// Update the given route
+func (sr *ServingRecorder) UpdateRoute(route interface{}, err error) {
+	sr.r.Add("UpdateRoute", []interface{}{route}, []interface{}{err})
+}
+
+func (c *MockKnServingClient) UpdateRoute(route *servingv1.Route) error {
+	call := c.recorder.r.VerifyCall("UpdateRoute", route)
+	return mock.ErrorOrNil(call.Result[0])
+}
+
+// Delegate to shared retry method
+func (c *MockKnServingClient) UpdateRouteWithRetry(name string, updateFunc routeUpdateFunc, maxRetry int) error {
+	return updateRouteWithRetry(c, name, updateFunc, maxRetry)
+}
+
+// Wait for a route to become ready, but not longer than provided timeout
+func (sr *ServingRecorder) WaitForRoute(name interface{}, timeout interface{}, callback interface{}, err error, duration time.Duration) {
+	sr.r.Add("WaitForRoute", []interface{}{name, timeout, callback}, []interface{}{err, duration})
+}
+
+func (c *MockKnServingClient) WaitForRoute(name string, timeout time.Duration, msgCallback wait.MessageCallback) (error, time.Duration) {
+	call := c.recorder.r.VerifyCall("WaitForRoute", name, timeout, msgCallback)
+	return mock.ErrorOrNil(call.Result[0]), call.Result[1].(time.Duration)
+}
+
 // Check that every recorded method has been called
 func (sr *ServingRecorder) Validate() {
 	sr.r.CheckThatAllRecordedMethodsHaveBeenCalled()
@@ -202,8 +342,8 @@ func (sr *ServingRecorder) Validate() {
 // with the appropriate label selector
 func HasLabelSelector(keyAndValues ...string) func(t *testing.T, a interface{}) {
 	return func(t *testing.T, a interface{}) {
-		lc := a.([]ListConfig)
-		listConfigCollector := listConfigCollector{
+		lc := a.([]util.ListConfig)
+		listConfigCollector := util.ListConfigCollector{
 			Labels: make(labels.Set),
 			Fields: make(fields.Set),
 		}
@@ -218,8 +358,8 @@ func HasLabelSelector(keyAndValues ...string) func(t *testing.T, a interface{})
 // with the appropriate field selectors
 func HasFieldSelector(keyAndValues ...string) func(t *testing.T, a interface{}) {
 	return func(t *testing.T, a interface{}) {
-		lc := a.([]ListConfig)
-		listConfigCollector := listConfigCollector{
+		lc := a.([]util.ListConfig)
+		listConfigCollector := util.ListConfigCollector{
 			Labels: make(labels.Set),
 			Fields: make(fields.Set),
 		}
@@ -21,6 +21,7 @@ import (
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
 	"knative.dev/client/pkg/wait"
 )
@@ -34,30 +35,54 @@ func TestMockKnClient(t *testing.T) {
 	// Record all services
 	recorder.GetService("hello", nil, nil)
 	recorder.ListServices(mock.Any(), nil, nil)
+	recorder.WatchServices(mock.Any(), nil, nil)
 	recorder.CreateService(&servingv1.Service{}, nil)
 	recorder.UpdateService(&servingv1.Service{}, nil)
 	recorder.DeleteService("hello", time.Duration(10)*time.Second, nil)
 	recorder.WaitForService("hello", time.Duration(10)*time.Second, wait.NoopMessageCallback(), nil, 10*time.Second)
+	recorder.WaitForServiceCondition("hello", wait.ReadyCondition, time.Duration(10)*time.Second, wait.NoopMessageCallback(), nil, 10*time.Second)
 	recorder.GetRevision("hello", nil, nil)
 	recorder.ListRevisions(mock.Any(), nil, nil)
+	recorder.WatchRevisions(mock.Any(), nil, nil)
 	recorder.DeleteRevision("hello", time.Duration(10)*time.Second, nil)
 	recorder.GetRoute("hello", nil, nil)
 	recorder.ListRoutes(mock.Any(), nil, nil)
+	recorder.WatchRoutes(mock.Any(), nil, nil)
 	recorder.GetConfiguration("hello", nil, nil)
+	recorder.ListConfigurations(mock.Any(), nil, nil)
+	recorder.CreateConfiguration(&servingv1.Configuration{}, nil)
+	recorder.UpdateConfiguration(&servingv1.Configuration{}, nil)
+	recorder.DeleteConfiguration("hello", time.Duration(10)*time.Second, nil)
+	recorder.WaitForConfiguration("hello", time.Duration(10)*time.Second, wait.NoopMessageCallback(), nil, 10*time.Second)
+	recorder.CreateRoute(&servingv1.Route{}, nil)
+	recorder.UpdateRoute(&servingv1.Route{}, nil)
+	recorder.WaitForRoute("hello", time.Duration(10)*time.Second, wait.NoopMessageCallback(), nil, 10*time.Second)
 
 	// Call all services
 	client.GetService("hello")
 	client.ListServices(WithName("blub"))
+	client.WatchServices(WithName("blub"))
 	client.CreateService(&servingv1.Service{})
 	client.UpdateService(&servingv1.Service{})
 	client.DeleteService("hello", time.Duration(10)*time.Second)
 	client.WaitForService("hello", time.Duration(10)*time.Second, wait.NoopMessageCallback())
+	client.WaitForServiceCondition("hello", wait.ReadyCondition, time.Duration(10)*time.Second, wait.NoopMessageCallback())
 	client.GetRevision("hello")
 	client.ListRevisions(WithName("blub"))
+	client.WatchRevisions(WithName("blub"))
 	client.DeleteRevision("hello", time.Duration(10)*time.Second)
 	client.GetRoute("hello")
 	client.ListRoutes(WithName("blub"))
+	client.WatchRoutes(WithName("blub"))
 	client.GetConfiguration("hello")
+	client.ListConfigurations(WithName("blub"))
+	client.CreateConfiguration(&servingv1.Configuration{})
+	client.UpdateConfiguration(&servingv1.Configuration{})
+	client.DeleteConfiguration("hello", time.Duration(10)*time.Second)
+	client.WaitForConfiguration("hello", time.Duration(10)*time.Second, wait.NoopMessageCallback())
+	client.CreateRoute(&servingv1.Route{})
+	client.UpdateRoute(&servingv1.Route{})
+	client.WaitForRoute("hello", time.Duration(10)*time.Second, wait.NoopMessageCallback())
 
 	// Validate
 	recorder.Validate()
@@ -65,7 +90,7 @@ func TestMockKnClient(t *testing.T) {
 
 func TestHasLabelSelector(t *testing.T) {
 	assertFunction := HasLabelSelector(serving.ServiceLabelKey, "myservice")
-	listConfig := []ListConfig{
+	listConfig := []util.ListConfig{
 		WithService("myservice"),
 	}
 	assertFunction(t, listConfig)
@@ -73,7 +98,7 @@ func TestHasLabelSelector(t *testing.T) {
 
 func TestHasFieldSelector(t *testing.T) {
 	assertFunction := HasFieldSelector("metadata.name", "myname")
-	listConfig := []ListConfig{
+	listConfig := []util.ListConfig{
 		WithName("myname"),
 	}
 	assertFunction(t, listConfig)
@@ -83,8 +108,8 @@ func TestHasSelector(t *testing.T) {
 	assertFunction := HasSelector(
 		[]string{serving.ServiceLabelKey, "myservice"},
 		[]string{"metadata.name", "myname"})
-	listConfig := []ListConfig{
-		func(lo *listConfigCollector) {
+	listConfig := []util.ListConfig{
+		func(lo *util.ListConfigCollector) {
 			lo.Labels[serving.ServiceLabelKey] = "myservice"
 			lo.Fields["metadata.name"] = "myname"
 		},
@@ -15,7 +15,10 @@
 package v1
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -23,6 +26,7 @@ import (
 	"gotest.tools/assert/cmp"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
+	"knative.dev/pkg/apis"
 	"knative.dev/serving/pkg/apis/serving"
 	"knative.dev/serving/pkg/client/clientset/versioned/scheme"
 
@@ -34,7 +38,9 @@ import (
 	servingv1fake "knative.dev/serving/pkg/client/clientset/versioned/typed/serving/v1/fake"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 	clienttesting "k8s.io/client-go/testing"
+	servingv1client "knative.dev/serving/pkg/client/clientset/versioned/typed/serving/v1"
 
 	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/wait"
@@ -203,6 +209,77 @@ func TestDeleteService(t *testing.T) {
 	})
 }
 
+func TestDryRunService(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		service := newService("foo")
+		service.Namespace = testNamespace
+		service.Spec.Template.Spec.TimeoutSeconds = new(int64)
+		*service.Spec.Template.Spec.TimeoutSeconds = 300
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(service)
+	}))
+	defer server.Close()
+
+	serving, err := servingv1client.NewForConfig(&rest.Config{Host: server.URL})
+	assert.NilError(t, err)
+	client := NewKnServingClient(serving, testNamespace)
+
+	t.Run("create with dry-run returns the defaulted service", func(t *testing.T) {
+		service := newService("foo")
+		err := client.CreateService(service, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+		assert.NilError(t, err)
+		request := requests[len(requests)-1]
+		assert.Equal(t, request.Method, "POST")
+		assert.Equal(t, request.URL.Path, "/apis/serving.knative.dev/v1/namespaces/test-ns/services")
+		assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+		assert.Equal(t, *service.Spec.Template.Spec.TimeoutSeconds, int64(300))
+		validateGroupVersionKind(t, service)
+	})
+
+	t.Run("update with dry-run returns the defaulted service", func(t *testing.T) {
+		service := newService("foo")
+		err := client.UpdateService(service, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}})
+		assert.NilError(t, err)
+		request := requests[len(requests)-1]
+		assert.Equal(t, request.Method, "PUT")
+		assert.Equal(t, request.URL.Path, "/apis/serving.knative.dev/v1/namespaces/test-ns/services/foo")
+		assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+		assert.Equal(t, *service.Spec.Template.Spec.TimeoutSeconds, int64(300))
+	})
+}
+
+func TestDeleteServiceDryRun(t *testing.T) {
+	serving, client := setup()
+	serving.AddReactor("delete", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, a.(clienttesting.DeleteAction).GetName(), "foo")
+			return true, nil, nil
+		})
+
+	// No watch reactor, as no wait happens for a dry-run
+	err := client.DeleteService("foo", time.Duration(10)*time.Second, metav1.DeleteOptions{DryRun: []string{metav1.DryRunAll}})
+	assert.NilError(t, err)
+}
+
+func TestDeleteRevisionDryRun(t *testing.T) {
+	serving, client := setup()
+	serving.AddReactor("get", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &servingv1.Revision{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil
+		})
+	serving.AddReactor("delete", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, a.(clienttesting.DeleteAction).GetName(), "foo")
+			return true, nil, nil
+		})
+
+	// No watch reactor, as no wait happens for a dry-run
+	err := client.DeleteRevision("foo", time.Duration(10)*time.Second, metav1.DeleteOptions{DryRun: []string{metav1.DryRunAll}})
+	assert.NilError(t, err)
+}
+
 func getServiceDeleteEvents(name string) []watch.Event {
 	return []watch.Event{
 		{watch.Added, wait.CreateTestServiceWithConditions(name, corev1.ConditionUnknown, corev1.ConditionUnknown, "", "msg1")},
@@ -305,6 +382,55 @@ func TestListRevisionForService(t *testing.T) {
 		})
 }
 
+func TestListRevisionsWithLabelSelector(t *testing.T) {
+	fakeServing, client := setup()
+
+	fakeServing.AddReactor("list", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			restrictions := a.(clienttesting.ListAction).GetListRestrictions()
+			assert.Equal(t, restrictions.Labels.String(), "serving.knative.dev/service=service,team!=payments")
+			return true, &servingv1.RevisionList{Items: []servingv1.Revision{
+				*newRevision("revision-1", serving.ServiceLabelKey, "service"),
+				*newRevision("revision-2", serving.ServiceLabelKey, "service", "team", "payments"),
+			}}, nil
+		})
+
+	revisions, err := client.ListRevisions(WithService("service"), util.WithLabelSelector("team!=payments"))
+	assert.NilError(t, err)
+	assert.Assert(t, cmp.Len(revisions.Items, 1))
+	assert.Equal(t, revisions.Items[0].Name, "revision-1")
+}
+
+func TestListRevisionsWithChunkSize(t *testing.T) {
+	fakeServing, client := setup()
+
+	pages := []*servingv1.RevisionList{
+		{ListMeta: metav1.ListMeta{Continue: "page-2"}, Items: []servingv1.Revision{*newRevision("revision-1"), *newRevision("revision-2")}},
+		{Items: []servingv1.Revision{*newRevision("revision-3")}},
+	}
+	nrCalls := 0
+	fakeServing.AddReactor("list", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			page := pages[nrCalls]
+			nrCalls++
+			return true, page, nil
+		})
+
+	var pageSizes []int
+	revisions, err := client.ListRevisions(util.WithChunkSize(2), util.WithPageHandler(func(page runtime.Object) error {
+		revisionPage := page.(*servingv1.RevisionList)
+		assert.Equal(t, revisionPage.Kind, "RevisionList")
+		pageSizes = append(pageSizes, len(revisionPage.Items))
+		return nil
+	}))
+	assert.NilError(t, err)
+	assert.Equal(t, nrCalls, 2)
+	assert.DeepEqual(t, pageSizes, []int{2, 1})
+	assert.Assert(t, cmp.Len(revisions.Items, 3))
+	assert.Equal(t, revisions.Items[2].Name, "revision-3")
+	assert.Equal(t, revisions.Continue, "")
+}
+
 func TestGetRoute(t *testing.T) {
 	serving, client := setup()
 	routeName := "test-route"
@@ -415,6 +541,76 @@ func TestWaitForService(t *testing.T) {
 	})
 }
 
+func TestWaitForServiceCondition(t *testing.T) {
+	serving, client := setup()
+
+	serviceName := "test-service"
+	var events []watch.Event
+	serving.AddWatchReactor("services",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			w := wait.NewFakeWatch(events)
+			w.Start()
+			return true, w, nil
+		})
+
+	t.Run("wait on a condition other than ready", func(t *testing.T) {
+		events = getServiceEvents(serviceName)
+		condition := wait.Condition{Type: "RoutesReady", Status: corev1.ConditionTrue}
+		err, _ := client.WaitForServiceCondition(serviceName, condition, 60*time.Second, wait.NoopMessageCallback())
+		assert.NilError(t, err)
+	})
+
+	t.Run("wait on a service which is already ready", func(t *testing.T) {
+		events = []watch.Event{
+			{watch.Added, wait.CreateTestServiceWithConditions(serviceName, corev1.ConditionTrue, corev1.ConditionTrue, "", "")},
+		}
+		err, _ := client.WaitForServiceCondition(serviceName, wait.ReadyCondition, 60*time.Second, wait.NoopMessageCallback())
+		assert.NilError(t, err)
+	})
+}
+
+func TestWatchServices(t *testing.T) {
+	serving, client := setup()
+
+	serviceName := "test-service"
+	watchSupported := true
+	serving.AddWatchReactor("services",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			if !watchSupported {
+				return true, nil, errors.NewMethodNotSupported(servingv1.Resource("services"), "watch")
+			}
+			watchAction := a.(clienttesting.WatchAction)
+			name, found := watchAction.GetWatchRestrictions().Fields.RequiresExactMatch("metadata.name")
+			assert.Assert(t, found)
+			assert.Equal(t, name, serviceName)
+			w := wait.NewFakeWatch(getServiceEvents(serviceName))
+			w.Start()
+			return true, w, nil
+		})
+	serving.AddReactor("list", "services",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, &servingv1.ServiceList{Items: []servingv1.Service{*newService(serviceName)}}, nil
+		})
+
+	t.Run("watch services natively", func(t *testing.T) {
+		w, err := client.WatchServices(WithName(serviceName))
+		assert.NilError(t, err)
+		event := <-w.ResultChan()
+		assert.Equal(t, event.Type, watch.Added)
+		w.Stop()
+	})
+
+	t.Run("watch services by polling if watch is not supported", func(t *testing.T) {
+		watchSupported = false
+		w, err := client.WatchServices(WithName(serviceName))
+		assert.NilError(t, err)
+		event := <-w.ResultChan()
+		assert.Equal(t, event.Type, watch.Added)
+		assert.Equal(t, event.Object.(*servingv1.Service).Name, serviceName)
+		w.Stop()
+	})
+}
+
 type baseRevisionCase struct {
 	templateName          string
 	templateImage         string
@@ -534,3 +730,193 @@ func getServiceEvents(name string) []watch.Event {
 		{watch.Modified, wait.CreateTestServiceWithConditions(name, corev1.ConditionTrue, corev1.ConditionTrue, "", "")},
 	}
 }
+
+func TestListConfigurations(t *testing.T) {
+	serving, client := setup()
+
+	configurations := []servingv1.Configuration{*newConfiguration("config-1"), *newConfiguration("config-2")}
+	serving.AddReactor("list", "configurations",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, testNamespace, a.GetNamespace())
+			return true, &servingv1.ConfigurationList{Items: configurations}, nil
+		})
+
+	configurationList, err := client.ListConfigurations()
+	assert.NilError(t, err)
+	assert.Equal(t, len(configurationList.Items), 2)
+	assert.Equal(t, configurationList.Items[1].Name, "config-2")
+	validateGroupVersionKind(t, configurationList)
+	for i := 0; i < len(configurationList.Items); i++ {
+		validateGroupVersionKind(t, &configurationList.Items[i])
+	}
+}
+
+func TestCreateConfiguration(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddReactor("create", "configurations",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, testNamespace, a.GetNamespace())
+			name := a.(clienttesting.CreateAction).GetObject().(metav1.Object).GetName()
+			if name == "new-config" {
+				return true, newConfiguration(name), nil
+			}
+			return true, nil, fmt.Errorf("error while creating configuration %s", name)
+		})
+
+	t.Run("create configuration without error", func(t *testing.T) {
+		configuration := newConfiguration("new-config")
+		err := client.CreateConfiguration(configuration)
+		assert.NilError(t, err)
+		validateGroupVersionKind(t, configuration)
+	})
+
+	t.Run("create configuration with an error returns an error object", func(t *testing.T) {
+		err := client.CreateConfiguration(newConfiguration("unknown"))
+		assert.ErrorContains(t, err, "unknown")
+	})
+}
+
+func TestUpdateConfigurationWithRetry(t *testing.T) {
+	serving, client := setup()
+
+	conflicts := 1
+	serving.AddReactor("get", "configurations",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, newConfiguration(a.(clienttesting.GetAction).GetName()), nil
+		})
+	serving.AddReactor("update", "configurations",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			configuration := a.(clienttesting.UpdateAction).GetObject().(*servingv1.Configuration)
+			assert.Equal(t, configuration.Labels["updated"], "true")
+			if conflicts > 0 {
+				conflicts--
+				return true, nil, errors.NewConflict(servingv1.Resource("configuration"), configuration.Name, fmt.Errorf("conflict"))
+			}
+			return true, configuration, nil
+		})
+
+	err := client.UpdateConfigurationWithRetry("config", func(configuration *servingv1.Configuration) (*servingv1.Configuration, error) {
+		configuration.Labels = map[string]string{"updated": "true"}
+		return configuration, nil
+	}, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, conflicts, 0)
+}
+
+func TestDeleteConfiguration(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddReactor("delete", "configurations",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.DeleteAction).GetName()
+			assert.Equal(t, testNamespace, a.GetNamespace())
+			if name == "config" {
+				return true, nil, nil
+			}
+			return true, nil, errors.NewNotFound(servingv1.Resource("configuration"), name)
+		})
+
+	t.Run("delete existing configuration returns no error", func(t *testing.T) {
+		err := client.DeleteConfiguration("config", 0)
+		assert.NilError(t, err)
+	})
+
+	t.Run("trying to delete non-existing configuration returns error", func(t *testing.T) {
+		err := client.DeleteConfiguration("no-config", 0)
+		assert.ErrorContains(t, err, "not found")
+		assert.ErrorContains(t, err, "no-config")
+	})
+}
+
+func TestWaitForConfiguration(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddWatchReactor("configurations",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			w := wait.NewFakeWatch([]watch.Event{
+				{Type: watch.Added, Object: newConfigurationWithReadyCondition("config", corev1.ConditionUnknown)},
+				{Type: watch.Modified, Object: newConfigurationWithReadyCondition("config", corev1.ConditionTrue)},
+			})
+			w.Start()
+			return true, w, nil
+		})
+
+	err, _ := client.WaitForConfiguration("config", 60*time.Second, wait.NoopMessageCallback())
+	assert.NilError(t, err)
+}
+
+func TestCreateRoute(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddReactor("create", "routes",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			assert.Equal(t, testNamespace, a.GetNamespace())
+			name := a.(clienttesting.CreateAction).GetObject().(metav1.Object).GetName()
+			if name == "new-route" {
+				return true, newRoute(name), nil
+			}
+			return true, nil, fmt.Errorf("error while creating route %s", name)
+		})
+
+	t.Run("create route without error", func(t *testing.T) {
+		route := newRoute("new-route")
+		err := client.CreateRoute(route)
+		assert.NilError(t, err)
+		validateGroupVersionKind(t, route)
+	})
+
+	t.Run("create route with an error returns an error object", func(t *testing.T) {
+		err := client.CreateRoute(newRoute("unknown"))
+		assert.ErrorContains(t, err, "unknown")
+	})
+}
+
+func TestUpdateRouteWithRetry(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddReactor("get", "routes",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, newRoute(a.(clienttesting.GetAction).GetName()), nil
+		})
+	serving.AddReactor("update", "routes",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			route := a.(clienttesting.UpdateAction).GetObject().(*servingv1.Route)
+			return true, nil, errors.NewConflict(servingv1.Resource("route"), route.Name, fmt.Errorf("conflict"))
+		})
+
+	err := client.UpdateRouteWithRetry("route", func(route *servingv1.Route) (*servingv1.Route, error) {
+		return route, nil
+	}, 0)
+	assert.ErrorContains(t, err, "giving up after 0 retries")
+}
+
+func TestWaitForRoute(t *testing.T) {
+	serving, client := setup()
+
+	serving.AddWatchReactor("routes",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			route := newRoute("route")
+			route.Generation = 1
+			route.Status.ObservedGeneration = 1
+			route.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: corev1.ConditionTrue}}
+			w := wait.NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: route}})
+			w.Start()
+			return true, w, nil
+		})
+
+	err, _ := client.WaitForRoute("route", 60*time.Second, wait.NoopMessageCallback())
+	assert.NilError(t, err)
+}
+
+func newConfiguration(name string) *servingv1.Configuration {
+	return &servingv1.Configuration{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace}}
+}
+
+func newConfigurationWithReadyCondition(name string, status corev1.ConditionStatus) *servingv1.Configuration {
+	configuration := newConfiguration(name)
+	configuration.Generation = 1
+	configuration.Status.ObservedGeneration = 1
+	configuration.Status.Conditions = []apis.Condition{{Type: apis.ConditionReady, Status: status}}
+	return configuration
+}
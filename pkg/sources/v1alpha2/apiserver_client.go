@@ -17,6 +17,7 @@ package v1alpha2
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
 	clientv1alpha2 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -34,17 +35,20 @@ type KnAPIServerSourcesClient interface {
 	GetAPIServerSource(name string) (*v1alpha2.ApiServerSource, error)
 
 	// Create an ApiServerSource by object
-	CreateAPIServerSource(apiSource *v1alpha2.ApiServerSource) error
+	// If create options are given (e.g. for a server side dry-run), the given source is updated
+	// with the source returned by the server.
+	CreateAPIServerSource(apiSource *v1alpha2.ApiServerSource, opts ...metav1.CreateOptions) error
 
 	// Update an ApiServerSource by object
-	UpdateAPIServerSource(apiSource *v1alpha2.ApiServerSource) error
+	// If update options are given, the given source is updated with the source returned by the server.
+	UpdateAPIServerSource(apiSource *v1alpha2.ApiServerSource, opts ...metav1.UpdateOptions) error
 
 	// Delete an ApiServerSource by name
 	DeleteAPIServerSource(name string) error
 
 	// List ApiServerSource
 	// TODO: Support list configs like in service list
-	ListAPIServerSource() (*v1alpha2.ApiServerSourceList, error)
+	ListAPIServerSource(opts ...util.ListConfig) (*v1alpha2.ApiServerSourceList, error)
 
 	// Get namespace for this client
 	Namespace() string
@@ -54,15 +58,17 @@ type KnAPIServerSourcesClient interface {
 // Temporarily help to add sources dependencies
 // May be changed when adding real sources features
 type apiServerSourcesClient struct {
-	client    clientv1alpha2.ApiServerSourceInterface
-	namespace string
+	client     clientv1alpha2.ApiServerSourceInterface
+	restClient rest.Interface
+	namespace  string
 }
 
 // newKnAPIServerSourcesClient is to invoke Eventing Sources Client API to create object
-func newKnAPIServerSourcesClient(client clientv1alpha2.ApiServerSourceInterface, namespace string) KnAPIServerSourcesClient {
+func newKnAPIServerSourcesClient(client clientv1alpha2.ApiServerSourceInterface, restClient rest.Interface, namespace string) KnAPIServerSourcesClient {
 	return &apiServerSourcesClient{
-		client:    client,
-		namespace: namespace,
+		client:     client,
+		restClient: restClient,
+		namespace:  namespace,
 	}
 }
 
@@ -77,7 +83,16 @@ func (c *apiServerSourcesClient) GetAPIServerSource(name string) (*v1alpha2.ApiS
 }
 
 //CreateAPIServerSource is used to create an instance of ApiServerSource
-func (c *apiServerSourcesClient) CreateAPIServerSource(apiSource *v1alpha2.ApiServerSource) error {
+func (c *apiServerSourcesClient) CreateAPIServerSource(apiSource *v1alpha2.ApiServerSource, opts ...metav1.CreateOptions) error {
+	if len(opts) > 0 {
+		result := &v1alpha2.ApiServerSource{}
+		err := util.CreateWithOptions(c.restClient, c.namespace, "apiserversources", apiSource, opts[0], result)
+		if err != nil {
+			return knerrors.GetError(err)
+		}
+		*apiSource = *result
+		return updateSourceGVK(apiSource)
+	}
 	_, err := c.client.Create(apiSource)
 	if err != nil {
 		return knerrors.GetError(err)
@@ -87,7 +102,16 @@ func (c *apiServerSourcesClient) CreateAPIServerSource(apiSource *v1alpha2.ApiSe
 }
 
 //UpdateAPIServerSource is used to update an instance of ApiServerSource
-func (c *apiServerSourcesClient) UpdateAPIServerSource(apiSource *v1alpha2.ApiServerSource) error {
+func (c *apiServerSourcesClient) UpdateAPIServerSource(apiSource *v1alpha2.ApiServerSource, opts ...metav1.UpdateOptions) error {
+	if len(opts) > 0 {
+		result := &v1alpha2.ApiServerSource{}
+		err := util.UpdateWithOptions(c.restClient, c.namespace, "apiserversources", apiSource.Name, apiSource, opts[0], result)
+		if err != nil {
+			return knerrors.GetError(err)
+		}
+		*apiSource = *result
+		return updateSourceGVK(apiSource)
+	}
 	_, err := c.client.Update(apiSource)
 	if err != nil {
 		return knerrors.GetError(err)
@@ -108,13 +132,18 @@ func (c *apiServerSourcesClient) Namespace() string {
 }
 
 // ListAPIServerSource returns the available ApiServer type sources
-func (c *apiServerSourcesClient) ListAPIServerSource() (*v1alpha2.ApiServerSourceList, error) {
-	sourceList, err := c.client.List(metav1.ListOptions{})
+func (c *apiServerSourcesClient) ListAPIServerSource(config ...util.ListConfig) (*v1alpha2.ApiServerSourceList, error) {
+	sourceList, err := util.ListConfigs(config).ListAll(func(opts metav1.ListOptions) (runtime.Object, error) {
+		sourceList, err := c.client.List(opts)
+		if err != nil {
+			return nil, knerrors.GetError(err)
+		}
+		return updateAPIServerSourceListGVK(sourceList)
+	})
 	if err != nil {
-		return nil, knerrors.GetError(err)
+		return nil, err
 	}
-
-	return updateAPIServerSourceListGVK(sourceList)
+	return sourceList.(*v1alpha2.ApiServerSourceList), nil
 }
 
 func updateAPIServerSourceListGVK(sourceList *v1alpha2.ApiServerSourceList) (*v1alpha2.ApiServerSourceList, error) {
@@ -159,6 +188,12 @@ func NewAPIServerSourceBuilderFromExisting(apiServerSource *v1alpha2.ApiServerSo
 	return &APIServerSourceBuilder{apiServerSource: apiServerSource.DeepCopy()}
 }
 
+// Namespace for this source
+func (b *APIServerSourceBuilder) Namespace(ns string) *APIServerSourceBuilder {
+	b.apiServerSource.Namespace = ns
+	return b
+}
+
 // Resources which should be streamed
 func (b *APIServerSourceBuilder) Resources(resources []v1alpha2.APIVersionKindSelector) *APIServerSourceBuilder {
 	b.apiServerSource.Spec.Resources = resources
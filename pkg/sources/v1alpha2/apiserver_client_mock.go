@@ -17,8 +17,10 @@ package v1alpha2
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
 
+	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
 )
 
@@ -76,7 +78,7 @@ func (sr *APIServerSourcesRecorder) CreateAPIServerSource(apiServerSource interf
 }
 
 // CreateAPIServerSource performs a previously recorded action, failing if non has been registered
-func (c *MockKnAPIServerSourceClient) CreateAPIServerSource(apiServerSource *v1alpha2.ApiServerSource) error {
+func (c *MockKnAPIServerSourceClient) CreateAPIServerSource(apiServerSource *v1alpha2.ApiServerSource, opts ...metav1.CreateOptions) error {
 	call := c.recorder.r.VerifyCall("CreateApiServerSource", apiServerSource)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -87,7 +89,7 @@ func (sr *APIServerSourcesRecorder) UpdateAPIServerSource(apiServerSource interf
 }
 
 // UpdateAPIServerSource performs a previously recorded action, failing if non has been registered
-func (c *MockKnAPIServerSourceClient) UpdateAPIServerSource(apiServerSource *v1alpha2.ApiServerSource) error {
+func (c *MockKnAPIServerSourceClient) UpdateAPIServerSource(apiServerSource *v1alpha2.ApiServerSource, opts ...metav1.UpdateOptions) error {
 	call := c.recorder.r.VerifyCall("UpdateAPIServerSource", apiServerSource)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -109,7 +111,7 @@ func (sr *APIServerSourcesRecorder) ListAPIServerSource(apiJobSourceList *v1alph
 }
 
 // ListAPIServerSource performs a previously recorded action, failing if non has been registered
-func (c *MockKnAPIServerSourceClient) ListAPIServerSource() (*v1alpha2.ApiServerSourceList, error) {
+func (c *MockKnAPIServerSourceClient) ListAPIServerSource(opts ...util.ListConfig) (*v1alpha2.ApiServerSourceList, error) {
 	call := c.recorder.r.VerifyCall("ListAPIServerSource")
 	return call.Result[0].(*v1alpha2.ApiServerSourceList), mock.ErrorOrNil(call.Result[1])
 }
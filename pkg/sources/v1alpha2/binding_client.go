@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
 	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
 	clientv1alpha2 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2"
@@ -36,36 +37,49 @@ import (
 type KnSinkBindingClient interface {
 	// Namespace in which this client is operating for
 	Namespace() string
-	// CreateSinkBinding is used to create an instance of binding
-	CreateSinkBinding(binding *v1alpha2.SinkBinding) error
+	// CreateSinkBinding is used to create an instance of binding. If create options are given
+	// (e.g. for a server side dry-run), the given binding is updated with the binding returned by the server.
+	CreateSinkBinding(binding *v1alpha2.SinkBinding, opts ...apisv1.CreateOptions) error
 	// DeleteSinkBinding is used to delete an instance of binding
 	DeleteSinkBinding(name string) error
 	// GetSinkBinding is used to get an instance of binding
 	GetSinkBinding(name string) (*v1alpha2.SinkBinding, error)
 	// ListSinkBinding returns list of binding CRDs
-	ListSinkBindings() (*v1alpha2.SinkBindingList, error)
-	// UpdateSinkBinding is used to update an instance of binding
-	UpdateSinkBinding(binding *v1alpha2.SinkBinding) error
+	ListSinkBindings(opts ...util.ListConfig) (*v1alpha2.SinkBindingList, error)
+	// UpdateSinkBinding is used to update an instance of binding. If update options are given,
+	// the given binding is updated with the binding returned by the server.
+	UpdateSinkBinding(binding *v1alpha2.SinkBinding, opts ...apisv1.UpdateOptions) error
 }
 
 // KnSinkBindingClient is a combination of Sources client interface and namespace
 // Temporarily help to add sources dependencies
 // May be changed when adding real sources features
 type knBindingClient struct {
-	client    clientv1alpha2.SinkBindingInterface
-	namespace string
+	client     clientv1alpha2.SinkBindingInterface
+	restClient rest.Interface
+	namespace  string
 }
 
 // NewKnSourcesClient is to invoke Eventing Sources Client API to create object
-func newKnSinkBindingClient(client clientv1alpha2.SinkBindingInterface, namespace string) KnSinkBindingClient {
+func newKnSinkBindingClient(client clientv1alpha2.SinkBindingInterface, restClient rest.Interface, namespace string) KnSinkBindingClient {
 	return &knBindingClient{
-		client:    client,
-		namespace: namespace,
+		client:     client,
+		restClient: restClient,
+		namespace:  namespace,
 	}
 }
 
 //CreateSinkBinding is used to create an instance of binding
-func (c *knBindingClient) CreateSinkBinding(binding *v1alpha2.SinkBinding) error {
+func (c *knBindingClient) CreateSinkBinding(binding *v1alpha2.SinkBinding, opts ...apisv1.CreateOptions) error {
+	if len(opts) > 0 {
+		result := &v1alpha2.SinkBinding{}
+		err := util.CreateWithOptions(c.restClient, c.namespace, "sinkbindings", binding, opts[0], result)
+		if err != nil {
+			return knerrors.GetError(err)
+		}
+		*binding = *result
+		return updateSinkBindingGvk(binding)
+	}
 	_, err := c.client.Create(binding)
 	if err != nil {
 		return knerrors.GetError(err)
@@ -91,13 +105,23 @@ func (c *knBindingClient) GetSinkBinding(name string) (*v1alpha2.SinkBinding, er
 	return binding, nil
 }
 
-func (c *knBindingClient) ListSinkBindings() (*v1alpha2.SinkBindingList, error) {
-	bindingList, err := c.client.List(apisv1.ListOptions{})
+func (c *knBindingClient) ListSinkBindings(config ...util.ListConfig) (*v1alpha2.SinkBindingList, error) {
+	bindingList, err := util.ListConfigs(config).ListAll(func(opts metav1.ListOptions) (runtime.Object, error) {
+		bindingList, err := c.client.List(opts)
+		if err != nil {
+			return nil, knerrors.GetError(err)
+		}
+		return updateSinkBindingListGvk(bindingList)
+	})
 	if err != nil {
-		return nil, knerrors.GetError(err)
+		return nil, err
 	}
+	return bindingList.(*v1alpha2.SinkBindingList), nil
+}
+
+func updateSinkBindingListGvk(bindingList *v1alpha2.SinkBindingList) (*v1alpha2.SinkBindingList, error) {
 	bindingListNew := bindingList.DeepCopy()
-	err = updateSinkBindingGvk(bindingListNew)
+	err := updateSinkBindingGvk(bindingListNew)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +139,16 @@ func (c *knBindingClient) ListSinkBindings() (*v1alpha2.SinkBindingList, error)
 }
 
 //CreateSinkBinding is used to create an instance of binding
-func (c *knBindingClient) UpdateSinkBinding(binding *v1alpha2.SinkBinding) error {
+func (c *knBindingClient) UpdateSinkBinding(binding *v1alpha2.SinkBinding, opts ...apisv1.UpdateOptions) error {
+	if len(opts) > 0 {
+		result := &v1alpha2.SinkBinding{}
+		err := util.UpdateWithOptions(c.restClient, c.namespace, "sinkbindings", binding.Name, binding, opts[0], result)
+		if err != nil {
+			return knerrors.GetError(err)
+		}
+		*binding = *result
+		return updateSinkBindingGvk(binding)
+	}
 	_, err := c.client.Update(binding)
 	if err != nil {
 		return knerrors.GetError(err)
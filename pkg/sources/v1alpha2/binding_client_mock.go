@@ -17,8 +17,10 @@ package v1alpha2
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
 
+	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
 )
 
@@ -65,7 +67,7 @@ func (sr *EventingRecorder) CreateSinkBinding(binding interface{}, err error) {
 }
 
 // CreateSinkBinding performs a previously recorded action
-func (c *MockKnSinkBindingClient) CreateSinkBinding(binding *v1alpha2.SinkBinding) error {
+func (c *MockKnSinkBindingClient) CreateSinkBinding(binding *v1alpha2.SinkBinding, opts ...metav1.CreateOptions) error {
 	call := c.recorder.r.VerifyCall("CreateSinkBinding", binding)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -98,7 +100,7 @@ func (sr *EventingRecorder) ListSinkBindings(bindingList *v1alpha2.SinkBindingLi
 }
 
 // ListSinkBindings performs a previously recorded action
-func (c *MockKnSinkBindingClient) ListSinkBindings() (*v1alpha2.SinkBindingList, error) {
+func (c *MockKnSinkBindingClient) ListSinkBindings(opts ...util.ListConfig) (*v1alpha2.SinkBindingList, error) {
 	call := c.recorder.r.VerifyCall("ListSinkBindings")
 	return call.Result[0].(*v1alpha2.SinkBindingList), mock.ErrorOrNil(call.Result[1])
 }
@@ -109,7 +111,7 @@ func (sr *EventingRecorder) UpdateSinkBinding(binding interface{}, err error) {
 }
 
 // UpdateSinkBinding performs a previously recorded action
-func (c *MockKnSinkBindingClient) UpdateSinkBinding(binding *v1alpha2.SinkBinding) error {
+func (c *MockKnSinkBindingClient) UpdateSinkBinding(binding *v1alpha2.SinkBinding, opts ...metav1.UpdateOptions) error {
 	call := c.recorder.r.VerifyCall("UpdateSinkBinding")
 	return mock.ErrorOrNil(call.Result[0])
 }
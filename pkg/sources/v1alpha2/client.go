@@ -51,17 +51,17 @@ func NewKnSourcesClient(client clientv1alpha2.SourcesV1alpha2Interface, namespac
 
 // Get the client for dealing with Ping sources
 func (c *sourcesClient) PingSourcesClient() KnPingSourcesClient {
-	return newKnPingSourcesClient(c.client.PingSources(c.namespace), c.namespace)
+	return newKnPingSourcesClient(c.client.PingSources(c.namespace), c.client.RESTClient(), c.namespace)
 }
 
 // ApiServerSourcesClient for dealing with ApiServer sources
 func (c *sourcesClient) SinkBindingClient() KnSinkBindingClient {
-	return newKnSinkBindingClient(c.client.SinkBindings(c.namespace), c.namespace)
+	return newKnSinkBindingClient(c.client.SinkBindings(c.namespace), c.client.RESTClient(), c.namespace)
 }
 
 // ApiServerSourcesClient for dealing with ApiServer sources
 func (c *sourcesClient) APIServerSourcesClient() KnAPIServerSourcesClient {
-	return newKnAPIServerSourcesClient(c.client.ApiServerSources(c.namespace), c.namespace)
+	return newKnAPIServerSourcesClient(c.client.ApiServerSources(c.namespace), c.client.RESTClient(), c.namespace)
 }
 
 // BuiltInSourcesGVKs returns the GVKs for built in sources
@@ -15,11 +15,17 @@
 package v1alpha2
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
 
 	sourcesv1alpha2 "knative.dev/eventing/pkg/apis/sources/v1alpha2"
+	clientv1alpha2 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2"
 )
 
 func TestBuiltInSourcesGVks(t *testing.T) {
@@ -29,3 +35,67 @@ func TestBuiltInSourcesGVks(t *testing.T) {
 	}
 	assert.Equal(t, len(gvks), 4)
 }
+
+func TestDryRunSources(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		// Echo the request body back as the server's response
+		w.Header().Set("Content-Type", "application/json")
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		body["metadata"].(map[string]interface{})["uid"] = "1234"
+		json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	sources, err := clientv1alpha2.NewForConfig(&rest.Config{Host: server.URL})
+	assert.NilError(t, err)
+	client := NewKnSourcesClient(sources, "test-ns")
+	createOptions := metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}
+	updateOptions := metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}}
+
+	checkRequest := func(t *testing.T, method, path string) {
+		request := requests[len(requests)-1]
+		assert.Equal(t, request.Method, method)
+		assert.Equal(t, request.URL.Path, "/apis/sources.knative.dev/v1alpha2/namespaces/test-ns/"+path)
+		assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+	}
+
+	t.Run("ping source", func(t *testing.T) {
+		pingSource := newPingSource("testsource", "mysvc")
+		assert.NilError(t, client.PingSourcesClient().CreatePingSource(pingSource, createOptions))
+		checkRequest(t, "POST", "pingsources")
+		assert.Equal(t, string(pingSource.UID), "1234")
+		assert.Equal(t, pingSource.Kind, "PingSource")
+
+		pingSource = newPingSource("testsource", "mysvc")
+		assert.NilError(t, client.PingSourcesClient().UpdatePingSource(pingSource, updateOptions))
+		checkRequest(t, "PUT", "pingsources/testsource")
+		assert.Equal(t, string(pingSource.UID), "1234")
+	})
+
+	t.Run("api server source", func(t *testing.T) {
+		apiSource := newAPIServerSource("testsource", "Event")
+		assert.NilError(t, client.APIServerSourcesClient().CreateAPIServerSource(apiSource, createOptions))
+		checkRequest(t, "POST", "apiserversources")
+		assert.Equal(t, string(apiSource.UID), "1234")
+
+		apiSource = newAPIServerSource("testsource", "Event")
+		assert.NilError(t, client.APIServerSourcesClient().UpdateAPIServerSource(apiSource, updateOptions))
+		checkRequest(t, "PUT", "apiserversources/testsource")
+		assert.Equal(t, string(apiSource.UID), "1234")
+	})
+
+	t.Run("sink binding", func(t *testing.T) {
+		binding := newSinkBinding("testbinding", "mysvc", "mping")
+		assert.NilError(t, client.SinkBindingClient().CreateSinkBinding(binding, createOptions))
+		checkRequest(t, "POST", "sinkbindings")
+		assert.Equal(t, string(binding.UID), "1234")
+
+		binding = newSinkBinding("testbinding", "mysvc", "mping")
+		assert.NilError(t, client.SinkBindingClient().UpdateSinkBinding(binding, updateOptions))
+		checkRequest(t, "PUT", "sinkbindings/testbinding")
+		assert.Equal(t, string(binding.UID), "1234")
+	})
+}
@@ -17,11 +17,16 @@ package v1alpha2
 import (
 	"fmt"
 
+	"k8s.io/client-go/rest"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/eventing/pkg/apis/sources/v1alpha2"
 
 	clientv1alpha2 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/client/pkg/util"
 )
 
 // Interface for interacting with a Ping source
@@ -30,18 +35,19 @@ type KnPingSourcesClient interface {
 	// GetPingSource fetches a Ping source by its name
 	GetPingSource(name string) (*v1alpha2.PingSource, error)
 
-	// CreatePingSource creates a Ping source
-	CreatePingSource(pingSource *v1alpha2.PingSource) error
+	// CreatePingSource creates a Ping source. If create options are given (e.g. for a server side
+	// dry-run), the given source is updated with the source returned by the server.
+	CreatePingSource(pingSource *v1alpha2.PingSource, opts ...metav1.CreateOptions) error
 
-	// UpdatePingSource updates a Ping source
-	UpdatePingSource(pingSource *v1alpha2.PingSource) error
+	// UpdatePingSource updates a Ping source. If update options are given, the given source is
+	// updated with the source returned by the server.
+	UpdatePingSource(pingSource *v1alpha2.PingSource, opts ...metav1.UpdateOptions) error
 
 	// DeletePingSource deletes a Ping source
 	DeletePingSource(name string) error
 
 	// ListPingSource lists all Ping sources
-	// TODO: Support list configs like in service list
-	ListPingSource() (*v1alpha2.PingSourceList, error)
+	ListPingSource(opts ...util.ListConfig) (*v1alpha2.PingSourceList, error)
 
 	// Get namespace for this source
 	Namespace() string
@@ -51,15 +57,17 @@ type KnPingSourcesClient interface {
 // Temporarily help to add sources dependencies
 // May be changed when adding real sources features
 type pingSourcesClient struct {
-	client    clientv1alpha2.PingSourceInterface
-	namespace string
+	client     clientv1alpha2.PingSourceInterface
+	restClient rest.Interface
+	namespace  string
 }
 
 // NewKnSourcesClient is to invoke Eventing Sources Client API to create object
-func newKnPingSourcesClient(client clientv1alpha2.PingSourceInterface, namespace string) KnPingSourcesClient {
+func newKnPingSourcesClient(client clientv1alpha2.PingSourceInterface, restClient rest.Interface, namespace string) KnPingSourcesClient {
 	return &pingSourcesClient{
-		client:    client,
-		namespace: namespace,
+		client:     client,
+		restClient: restClient,
+		namespace:  namespace,
 	}
 }
 
@@ -68,15 +76,33 @@ func (c *pingSourcesClient) Namespace() string {
 	return c.namespace
 }
 
-func (c *pingSourcesClient) CreatePingSource(pingsource *v1alpha2.PingSource) error {
+func (c *pingSourcesClient) CreatePingSource(pingsource *v1alpha2.PingSource, opts ...metav1.CreateOptions) error {
 	if pingsource.Spec.Sink.Ref == nil && pingsource.Spec.Sink.URI == nil {
 		return fmt.Errorf("a sink is required for creating a source")
 	}
+	if len(opts) > 0 {
+		result := &v1alpha2.PingSource{}
+		err := util.CreateWithOptions(c.restClient, c.namespace, "pingsources", pingsource, opts[0], result)
+		if err != nil {
+			return err
+		}
+		*pingsource = *result
+		return updateSourceGVK(pingsource)
+	}
 	_, err := c.client.Create(pingsource)
 	return err
 }
 
-func (c *pingSourcesClient) UpdatePingSource(pingSource *v1alpha2.PingSource) error {
+func (c *pingSourcesClient) UpdatePingSource(pingSource *v1alpha2.PingSource, opts ...metav1.UpdateOptions) error {
+	if len(opts) > 0 {
+		result := &v1alpha2.PingSource{}
+		err := util.UpdateWithOptions(c.restClient, c.namespace, "pingsources", pingSource.Name, pingSource, opts[0], result)
+		if err != nil {
+			return err
+		}
+		*pingSource = *result
+		return updateSourceGVK(pingSource)
+	}
 	_, err := c.client.Update(pingSource)
 	return err
 }
@@ -90,13 +116,18 @@ func (c *pingSourcesClient) GetPingSource(name string) (*v1alpha2.PingSource, er
 }
 
 // ListPingSource returns the available Ping sources
-func (c *pingSourcesClient) ListPingSource() (*v1alpha2.PingSourceList, error) {
-	sourceList, err := c.client.List(metav1.ListOptions{})
+func (c *pingSourcesClient) ListPingSource(config ...util.ListConfig) (*v1alpha2.PingSourceList, error) {
+	sourceList, err := util.ListConfigs(config).ListAll(func(opts metav1.ListOptions) (runtime.Object, error) {
+		sourceList, err := c.client.List(opts)
+		if err != nil {
+			return nil, err
+		}
+		return updatePingSourceListGVK(sourceList)
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	return updatePingSourceListGVK(sourceList)
+	return sourceList.(*v1alpha2.PingSourceList), nil
 }
 
 func updatePingSourceListGVK(sourceList *v1alpha2.PingSourceList) (*v1alpha2.PingSourceList, error) {
@@ -136,6 +167,12 @@ func NewPingSourceBuilderFromExisting(pingsource *v1alpha2.PingSource) *PingSour
 	return &PingSourceBuilder{pingSource: pingsource.DeepCopy()}
 }
 
+// Namespace for this source
+func (b *PingSourceBuilder) Namespace(ns string) *PingSourceBuilder {
+	b.pingSource.Namespace = ns
+	return b
+}
+
 func (b *PingSourceBuilder) Schedule(schedule string) *PingSourceBuilder {
 	b.pingSource.Spec.Schedule = schedule
 	return b
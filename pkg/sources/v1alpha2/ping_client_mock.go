@@ -17,8 +17,10 @@ package v1alpha2
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/eventing/pkg/apis/sources/v1alpha2"
 
+	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
 )
 
@@ -64,7 +66,7 @@ func (sr *PingSourcesRecorder) CreatePingSource(pingSource interface{}, err erro
 }
 
 // CreatePingSource performs a previously recorded action, failing if non has been registered
-func (c *MockKnPingSourceClient) CreatePingSource(pingSource *v1alpha2.PingSource) error {
+func (c *MockKnPingSourceClient) CreatePingSource(pingSource *v1alpha2.PingSource, opts ...metav1.CreateOptions) error {
 	call := c.recorder.r.VerifyCall("CreatePingSource", pingSource)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -86,7 +88,7 @@ func (sr *PingSourcesRecorder) UpdatePingSource(pingSource interface{}, err erro
 }
 
 // UpdatePingSource performs a previously recorded action, failing if non has been registered
-func (c *MockKnPingSourceClient) UpdatePingSource(pingSource *v1alpha2.PingSource) error {
+func (c *MockKnPingSourceClient) UpdatePingSource(pingSource *v1alpha2.PingSource, opts ...metav1.UpdateOptions) error {
 	call := c.recorder.r.VerifyCall("UpdatePingSource", pingSource)
 	return mock.ErrorOrNil(call.Result[0])
 }
@@ -108,7 +110,7 @@ func (sr *PingSourcesRecorder) ListPingSource(pingSourceList *v1alpha2.PingSourc
 }
 
 // ListPingSource performs a previously recorded action, failing if non has been registered
-func (c *MockKnPingSourceClient) ListPingSource() (*v1alpha2.PingSourceList, error) {
+func (c *MockKnPingSourceClient) ListPingSource(opts ...util.ListConfig) (*v1alpha2.PingSourceList, error) {
 	call := c.recorder.r.VerifyCall("ListPingSource")
 	return call.Result[0].(*v1alpha2.PingSourceList), mock.ErrorOrNil(call.Result[1])
 }
@@ -25,6 +25,9 @@ import (
 	"knative.dev/eventing/pkg/apis/sources/v1alpha2"
 	"knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1alpha2/fake"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+
+	"knative.dev/client/pkg/util"
 )
 
 func setupPingSourcesClient(t *testing.T) (sources fake.FakeSourcesV1alpha2, client KnPingSourcesClient) {
@@ -132,6 +135,53 @@ func TestListPingSource(t *testing.T) {
 	assert.Equal(t, len(sourceList.Items), 1)
 }
 
+func TestListPingSourceWithLabelSelector(t *testing.T) {
+	sourcesServer, client := setupPingSourcesClient(t)
+
+	sourcesServer.AddReactor("list", "pingsources",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			restrictions := a.(clienttesting.ListAction).GetListRestrictions()
+			assert.Equal(t, restrictions.Labels.String(), "team=payments")
+			matching := newPingSource("testsource", "mysvc")
+			matching.Labels = map[string]string{"team": "payments"}
+			other := newPingSource("othersource", "mysvc")
+			return true, &v1alpha2.PingSourceList{Items: []v1alpha2.PingSource{*matching, *other}}, nil
+		})
+
+	sourceList, err := client.ListPingSource(util.WithLabelSelector("team=payments"))
+	assert.NilError(t, err)
+	assert.Equal(t, len(sourceList.Items), 1)
+	assert.Equal(t, sourceList.Items[0].Name, "testsource")
+}
+
+func TestListPingSourceWithChunkSize(t *testing.T) {
+	sourcesServer, client := setupPingSourcesClient(t)
+
+	pages := []*v1alpha2.PingSourceList{
+		{ListMeta: metav1.ListMeta{Continue: "page-2"}, Items: []v1alpha2.PingSource{*newPingSource("source-1", "mysvc")}},
+		{Items: []v1alpha2.PingSource{*newPingSource("source-2", "mysvc")}},
+	}
+	nrCalls := 0
+	sourcesServer.AddReactor("list", "pingsources",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			page := pages[nrCalls]
+			nrCalls++
+			return true, page, nil
+		})
+
+	nrPages := 0
+	sourceList, err := client.ListPingSource(util.WithChunkSize(1), util.WithPageHandler(func(page runtime.Object) error {
+		assert.Equal(t, len(page.(*v1alpha2.PingSourceList).Items), 1)
+		nrPages++
+		return nil
+	}))
+	assert.NilError(t, err)
+	assert.Equal(t, nrPages, 2)
+	assert.Equal(t, len(sourceList.Items), 2)
+	assert.Equal(t, sourceList.Items[1].Name, "source-2")
+	assert.Equal(t, sourceList.Kind, "PingSourceList")
+}
+
 func newPingSource(name string, sink string) *v1alpha2.PingSource {
 	b := NewPingSourceBuilder(name).
 		Schedule("* * * * *").
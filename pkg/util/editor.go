@@ -0,0 +1,73 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// EditorEnvs are the environment variables which are checked in this order
+// for the editor to use
+var EditorEnvs = []string{"KUBE_EDITOR", "EDITOR"}
+
+// EditorCommand returns the command line of the editor configured in the
+// environment, falling back to vi (notepad on Windows)
+func EditorCommand() []string {
+	for _, env := range EditorEnvs {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return strings.Fields(editor)
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return []string{"notepad"}
+	}
+	return []string{"vi"}
+}
+
+// Edit writes the given content to a temporary file, opens it in the editor
+// and returns the content of the file after the editor has been closed. The
+// suffix is appended to the name of the temporary file, so that editors can
+// choose the syntax highlighting (e.g. ".yaml").
+func Edit(content []byte, suffix string, in io.Reader, out io.Writer, errOut io.Writer) ([]byte, error) {
+	file, err := ioutil.TempFile("", "kn-edit-*"+suffix)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(EditorCommand(), file.Name())
+	editor := exec.Command(args[0], args[1:]...)
+	editor.Stdin = in
+	editor.Stdout = out
+	editor.Stderr = errOut
+	if err := editor.Run(); err != nil {
+		return nil, fmt.Errorf("cannot run editor '%s': %v", strings.Join(args[:len(args)-1], " "), err)
+	}
+	return ioutil.ReadFile(file.Name())
+}
@@ -0,0 +1,84 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestEditorCommand(t *testing.T) {
+	defer setEnv("KUBE_EDITOR", "")()
+	defer setEnv("EDITOR", "")()
+
+	if runtime.GOOS != "windows" {
+		assert.DeepEqual(t, EditorCommand(), []string{"vi"})
+	}
+
+	os.Setenv("EDITOR", "nano")
+	assert.DeepEqual(t, EditorCommand(), []string{"nano"})
+
+	os.Setenv("KUBE_EDITOR", "code --wait")
+	assert.DeepEqual(t, EditorCommand(), []string{"code", "--wait"})
+}
+
+func TestEdit(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("editor script requires a shell")
+	}
+	dir, err := ioutil.TempDir("", "kn-editor")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+
+	script := filepath.Join(dir, "editor.sh")
+	assert.NilError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\necho \"edited: $1\" >> \"$1\"\n"), 0700))
+	defer setEnv("KUBE_EDITOR", script)()
+
+	edited, err := Edit([]byte("name: foo\n"), ".yaml", nil, &bytes.Buffer{}, &bytes.Buffer{})
+	assert.NilError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(edited)), "\n")
+	assert.Equal(t, len(lines), 2)
+	assert.Equal(t, lines[0], "name: foo")
+	assert.Assert(t, strings.HasPrefix(lines[1], "edited: "))
+	assert.Assert(t, strings.HasSuffix(lines[1], ".yaml"))
+
+	// The temporary file is removed afterwards
+	_, err = os.Stat(strings.TrimPrefix(lines[1], "edited: "))
+	assert.Assert(t, os.IsNotExist(err))
+
+	os.Setenv("KUBE_EDITOR", filepath.Join(dir, "missing"))
+	_, err = Edit([]byte("name: foo\n"), ".yaml", nil, &bytes.Buffer{}, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "cannot run editor")
+}
+
+// setEnv sets an environment variable and returns a function restoring its original value
+func setEnv(key, value string) func() {
+	original, found := os.LookupEnv(key)
+	os.Setenv(key, value)
+	return func() {
+		if found {
+			os.Setenv(key, original)
+		} else {
+			os.Unsetenv(key)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ReadEnvFile reads environment variables from a file in dotenv format
+func ReadEnvFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ParseEnvFile(file)
+}
+
+// ParseEnvFile parses environment variables in dotenv format. Each line holds a NAME=value pair,
+// optionally prefixed with 'export'. Empty lines and lines starting with '#' are ignored.
+// Values can be enclosed in single quotes (taken literally) or double quotes (with escape sequences
+// like \n being interpreted). Unquoted values end at a ' #' comment.
+func ParseEnvFile(reader io.Reader) (map[string]string, error) {
+	result := map[string]string{}
+	scanner := bufio.NewScanner(reader)
+	lineNr := 0
+	for scanner.Scan() {
+		lineNr++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+		pair := strings.SplitN(line, "=", 2)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("line %d: expected NAME=value, got %q", lineNr, line)
+		}
+		name := strings.TrimSpace(pair[0])
+		if name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", lineNr, name)
+		}
+		value, err := parseEnvFileValue(strings.TrimSpace(pair[1]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNr, err)
+		}
+		result[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseEnvFileValue(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	switch value[0] {
+	case '\'':
+		end := strings.Index(value[1:], "'")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated quoted value %s", value)
+		}
+		return value[1 : end+1], nil
+	case '"':
+		for i := 1; i < len(value); i++ {
+			if value[i] == '\\' {
+				i++
+				continue
+			}
+			if value[i] == '"' {
+				return strconv.Unquote(value[:i+1])
+			}
+		}
+		return "", fmt.Errorf("unterminated quoted value %s", value)
+	}
+	if idx := strings.Index(value, " #"); idx >= 0 {
+		value = value[:idx]
+	}
+	return strings.TrimSpace(value), nil
+}
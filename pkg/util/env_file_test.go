@@ -0,0 +1,80 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	content := `
+# database settings
+DB_HOST=localhost
+export DB_PORT = 5432
+GREETING="Hello\nWorld" # comment
+RAW='a \n b # no comment'
+EMPTY=
+URL=http://example.com/#anchor # trailing
+PASSWORD=secret:db:password
+`
+	env, err := ParseEnvFile(strings.NewReader(content))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, env, map[string]string{
+		"DB_HOST":  "localhost",
+		"DB_PORT":  "5432",
+		"GREETING": "Hello\nWorld",
+		"RAW":      `a \n b # no comment`,
+		"EMPTY":    "",
+		"URL":      "http://example.com/#anchor",
+		"PASSWORD": "secret:db:password",
+	})
+}
+
+func TestParseEnvFileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		content string
+		msg     string
+	}{
+		{"FOO", "line 1: expected NAME=value"},
+		{"\nA=1\n=bar", "line 3: invalid variable name"},
+		{"FOO BAR=1", "invalid variable name"},
+		{`FOO="bar`, "unterminated quoted value"},
+		{`FOO='bar`, "unterminated quoted value"},
+	} {
+		_, err := ParseEnvFile(strings.NewReader(tc.content))
+		assert.ErrorContains(t, err, tc.msg)
+	}
+}
+
+func TestReadEnvFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "env-file")
+	assert.NilError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, ".env")
+	assert.NilError(t, ioutil.WriteFile(path, []byte("FOO=bar\n"), 0600))
+	env, err := ReadEnvFile(path)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, env, map[string]string{"FOO": "bar"})
+
+	_, err = ReadEnvFile(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "no such file")
+}
@@ -0,0 +1,104 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ListConfigCollector collects the options for listing objects
+type ListConfigCollector struct {
+	// Labels to filter on
+	Labels labels.Set
+
+	// Fields to filter on
+	Fields fields.Set
+
+	// Label selector expression to filter on
+	LabelSelector string
+
+	// Maximum number of objects to fetch per request
+	ChunkSize int64
+
+	// Called for every page of objects received
+	PageHandler PageHandler
+}
+
+// ListConfig is a config function for listing objects in a builder pattern
+type ListConfig func(config *ListConfigCollector)
+
+// ListConfigs is a list of ListConfig functions
+type ListConfigs []ListConfig
+
+// Collect applies all config functions and returns the collected options
+func (opts ListConfigs) Collect() ListConfigCollector {
+	listConfig := ListConfigCollector{Labels: labels.Set{}, Fields: fields.Set{}}
+	for _, f := range opts {
+		f(&listConfig)
+	}
+	return listConfig
+}
+
+// ListOptions returns the list options with the label and field selectors of the configs
+func (opts ListConfigs) ListOptions() metav1.ListOptions {
+	listConfig := opts.Collect()
+	options := metav1.ListOptions{}
+	if len(listConfig.Fields) > 0 {
+		options.FieldSelector = listConfig.Fields.String()
+	}
+	var selectors []string
+	if len(listConfig.Labels) > 0 {
+		selectors = append(selectors, listConfig.Labels.String())
+	}
+	if listConfig.LabelSelector != "" {
+		selectors = append(selectors, listConfig.LabelSelector)
+	}
+	options.LabelSelector = strings.Join(selectors, ",")
+	return options
+}
+
+// WithLabelSelector filters on labels with a label selector expression
+func WithLabelSelector(selector string) ListConfig {
+	return func(lo *ListConfigCollector) {
+		lo.LabelSelector = selector
+	}
+}
+
+// WithChunkSize lists in pages of the given size, with 0 listing everything at once
+func WithChunkSize(size int64) ListConfig {
+	return func(lo *ListConfigCollector) {
+		lo.ChunkSize = size
+	}
+}
+
+// WithPageHandler handles every page of objects when it's received, before the complete list is returned
+func WithPageHandler(handler PageHandler) ListConfig {
+	return func(lo *ListConfigCollector) {
+		lo.PageHandler = handler
+	}
+}
+
+// ListAll lists all objects with the given list function, page by page if a chunk size is configured
+func (opts ListConfigs) ListAll(listFunc ListPageFunc) (runtime.Object, error) {
+	listConfig := opts.Collect()
+	options := opts.ListOptions()
+	options.Limit = listConfig.ChunkSize
+	return ListAll(listFunc, options, listConfig.PageHandler)
+}
@@ -0,0 +1,53 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListConfigsListOptions(t *testing.T) {
+	options := ListConfigs{}.ListOptions()
+	assert.Equal(t, options.LabelSelector, "")
+	assert.Equal(t, options.FieldSelector, "")
+
+	byName := func(lo *ListConfigCollector) {
+		lo.Fields["metadata.name"] = "foo"
+		lo.Labels["app"] = "foo"
+	}
+	options = ListConfigs{byName, WithLabelSelector("team!=payments"), WithChunkSize(10)}.ListOptions()
+	assert.Equal(t, options.FieldSelector, "metadata.name=foo")
+	assert.Equal(t, options.LabelSelector, "app=foo,team!=payments")
+	assert.Equal(t, options.Limit, int64(0))
+}
+
+func TestListConfigsListAll(t *testing.T) {
+	var pageSizes []int
+	list, err := ListConfigs{WithChunkSize(2), WithPageHandler(func(page runtime.Object) error {
+		pageSizes = append(pageSizes, len(page.(*corev1.PodList).Items))
+		return nil
+	})}.ListAll(pagedPodList(5))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pageSizes, []int{2, 2, 1})
+	assert.Equal(t, len(list.(*corev1.PodList).Items), 5)
+
+	list, err = ListConfigs{}.ListAll(pagedPodList(5))
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.(*corev1.PodList).Items), 5)
+}
@@ -0,0 +1,30 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestMaxMin(t *testing.T) {
+	assert.Equal(t, Max(1, 2), 2)
+	assert.Equal(t, Max(2, 1), 2)
+	assert.Equal(t, Max(-1, -1), -1)
+	assert.Equal(t, Min(1, 2), 1)
+	assert.Equal(t, Min(2, 1), 1)
+	assert.Equal(t, Min(-1, -1), -1)
+}
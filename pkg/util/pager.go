@@ -0,0 +1,88 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ListPageFunc lists a single page of objects with the given options
+type ListPageFunc func(options metav1.ListOptions) (runtime.Object, error)
+
+// PageHandler is called for every page of objects received while listing
+type PageHandler func(page runtime.Object) error
+
+// ListPages lists objects page by page, using the limit of the given options as
+// page size, and calls pageHandler for every page received. Without a limit
+// all objects are listed with a single request.
+func ListPages(listFunc ListPageFunc, options metav1.ListOptions, pageHandler PageHandler) error {
+	for {
+		page, err := listFunc(options)
+		if err != nil {
+			return err
+		}
+		if err := pageHandler(page); err != nil {
+			return err
+		}
+		listMeta, err := meta.ListAccessor(page)
+		if err != nil {
+			return err
+		}
+		if options.Limit == 0 || listMeta.GetContinue() == "" {
+			return nil
+		}
+		options.Continue = listMeta.GetContinue()
+	}
+}
+
+// ListAll lists all objects page by page like ListPages and returns the first
+// page holding the items of all pages. If a pageHandler is given, it is called
+// for every page before the page gets merged.
+func ListAll(listFunc ListPageFunc, options metav1.ListOptions, pageHandler PageHandler) (runtime.Object, error) {
+	var (
+		result runtime.Object
+		items  []runtime.Object
+	)
+	err := ListPages(listFunc, options, func(page runtime.Object) error {
+		if pageHandler != nil {
+			if err := pageHandler(page); err != nil {
+				return err
+			}
+		}
+		pageItems, err := meta.ExtractList(page)
+		if err != nil {
+			return err
+		}
+		items = append(items, pageItems...)
+		if result == nil {
+			result = page
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := meta.SetList(result, items); err != nil {
+		return nil, err
+	}
+	listMeta, err := meta.ListAccessor(result)
+	if err != nil {
+		return nil, err
+	}
+	listMeta.SetContinue("")
+	return result, nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// pagedPodList returns a list function serving the given number of pods in pages
+func pagedPodList(nrPods int) ListPageFunc {
+	return func(options metav1.ListOptions) (runtime.Object, error) {
+		start := 0
+		if options.Continue != "" {
+			start, _ = strconv.Atoi(options.Continue)
+		}
+		end := nrPods
+		if options.Limit > 0 && start+int(options.Limit) < nrPods {
+			end = start + int(options.Limit)
+		}
+		list := &corev1.PodList{}
+		for i := start; i < end; i++ {
+			list.Items = append(list.Items, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-" + strconv.Itoa(i)}})
+		}
+		if end < nrPods {
+			list.Continue = strconv.Itoa(end)
+		}
+		return list, nil
+	}
+}
+
+func TestListPages(t *testing.T) {
+	var pageSizes []int
+	err := ListPages(pagedPodList(5), metav1.ListOptions{Limit: 2}, func(page runtime.Object) error {
+		pageSizes = append(pageSizes, len(page.(*corev1.PodList).Items))
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pageSizes, []int{2, 2, 1})
+
+	pageSizes = nil
+	err = ListPages(pagedPodList(5), metav1.ListOptions{}, func(page runtime.Object) error {
+		pageSizes = append(pageSizes, len(page.(*corev1.PodList).Items))
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, pageSizes, []int{5})
+
+	err = ListPages(pagedPodList(5), metav1.ListOptions{Limit: 2}, func(page runtime.Object) error {
+		return errors.New("stop")
+	})
+	assert.Error(t, err, "stop")
+}
+
+func TestListAll(t *testing.T) {
+	nrPages := 0
+	result, err := ListAll(pagedPodList(5), metav1.ListOptions{Limit: 2}, func(page runtime.Object) error {
+		nrPages++
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, nrPages, 3)
+	list := result.(*corev1.PodList)
+	assert.Equal(t, list.Continue, "")
+	assert.Equal(t, len(list.Items), 5)
+	for i, pod := range list.Items {
+		assert.Equal(t, pod.Name, "pod-"+strconv.Itoa(i))
+	}
+
+	result, err = ListAll(pagedPodList(0), metav1.ListOptions{Limit: 2}, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, len(result.(*corev1.PodList).Items), 0)
+}
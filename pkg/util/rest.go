@@ -0,0 +1,46 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+// CreateWithOptions creates an object with the given create options (e.g. for a server side dry-run)
+// and decodes the object returned by the server into result
+func CreateWithOptions(restClient rest.Interface, namespace, resource string, obj runtime.Object, opts metav1.CreateOptions, result runtime.Object) error {
+	return restClient.Post().
+		Namespace(namespace).
+		Resource(resource).
+		VersionedParams(&opts, metav1.ParameterCodec).
+		Body(obj).
+		Do().
+		Into(result)
+}
+
+// UpdateWithOptions updates an object with the given update options (e.g. for a server side dry-run)
+// and decodes the object returned by the server into result
+func UpdateWithOptions(restClient rest.Interface, namespace, resource, name string, obj runtime.Object, opts metav1.UpdateOptions, result runtime.Object) error {
+	return restClient.Put().
+		Namespace(namespace).
+		Resource(resource).
+		Name(name).
+		VersionedParams(&opts, metav1.ParameterCodec).
+		Body(obj).
+		Do().
+		Into(result)
+}
@@ -0,0 +1,66 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+func TestCreateAndUpdateWithOptions(t *testing.T) {
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", ResourceVersion: "1"}}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(configMap)
+	}))
+	defer server.Close()
+
+	restClient, err := rest.RESTClientFor(&rest.Config{
+		Host: server.URL,
+		ContentConfig: rest.ContentConfig{
+			GroupVersion:         &corev1.SchemeGroupVersion,
+			NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		},
+		APIPath: "/api",
+	})
+	assert.NilError(t, err)
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+
+	result := &corev1.ConfigMap{}
+	err = CreateWithOptions(restClient, "test-ns", "configmaps", configMap, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}, result)
+	assert.NilError(t, err)
+	assert.Equal(t, request.Method, "POST")
+	assert.Equal(t, request.URL.Path, "/api/v1/namespaces/test-ns/configmaps")
+	assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+	assert.Equal(t, result.ResourceVersion, "1")
+
+	result = &corev1.ConfigMap{}
+	err = UpdateWithOptions(restClient, "test-ns", "configmaps", "foo", configMap, metav1.UpdateOptions{DryRun: []string{metav1.DryRunAll}}, result)
+	assert.NilError(t, err)
+	assert.Equal(t, request.Method, "PUT")
+	assert.Equal(t, request.URL.Path, "/api/v1/namespaces/test-ns/configmaps/foo")
+	assert.Equal(t, request.URL.Query().Get("dryRun"), "All")
+	assert.Equal(t, result.ResourceVersion, "1")
+}
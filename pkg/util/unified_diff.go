@@ -0,0 +1,169 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	colorReset = "\x1b[0m"
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorCyan  = "\x1b[36m"
+)
+
+// Number of unchanged lines shown around each change
+const diffContextLines = 3
+
+type diffOp int
+
+const (
+	diffEqual diffOp = iota
+	diffDelete
+	diffInsert
+)
+
+type diffLine struct {
+	op   diffOp
+	text string
+	// 1-based line numbers in the old and new text (0 if not applicable)
+	oldLine, newLine int
+}
+
+// UnifiedDiff returns a unified diff between the old and new text with the
+// given names used in the header. An empty string is returned if both texts
+// are equal. If colored is true, ANSI escape sequences are used for highlighting
+// removed and added lines.
+func UnifiedDiff(oldName, newName, oldText, newText string, colored bool) string {
+	if oldText == newText {
+		return ""
+	}
+	lines := diffLines(splitLines(oldText), splitLines(newText))
+
+	var out strings.Builder
+	writeColored(&out, colorRed, colored, fmt.Sprintf("--- %s\n", oldName))
+	writeColored(&out, colorGreen, colored, fmt.Sprintf("+++ %s\n", newName))
+	for _, hunk := range diffHunks(lines) {
+		oldStart, oldCount, newStart, newCount := hunkRange(hunk)
+		writeColored(&out, colorCyan, colored, fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount))
+		for _, line := range hunk {
+			switch line.op {
+			case diffDelete:
+				writeColored(&out, colorRed, colored, "-"+line.text+"\n")
+			case diffInsert:
+				writeColored(&out, colorGreen, colored, "+"+line.text+"\n")
+			default:
+				out.WriteString(" " + line.text + "\n")
+			}
+		}
+	}
+	return out.String()
+}
+
+func writeColored(out *strings.Builder, color string, colored bool, text string) {
+	if !colored {
+		out.WriteString(text)
+		return
+	}
+	out.WriteString(color + strings.TrimSuffix(text, "\n") + colorReset + "\n")
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines calculates the line based difference via the longest common subsequence
+func diffLines(a, b []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{diffEqual, a[i], i + 1, j + 1})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{diffDelete, a[i], i + 1, 0})
+			i++
+		default:
+			lines = append(lines, diffLine{diffInsert, b[j], 0, j + 1})
+			j++
+		}
+	}
+	return lines
+}
+
+// diffHunks groups the changes with their surrounding context
+func diffHunks(lines []diffLine) [][]diffLine {
+	var hunks [][]diffLine
+	start, end := -1, -1
+	for idx, line := range lines {
+		if line.op == diffEqual {
+			continue
+		}
+		from := Max(idx-diffContextLines, 0)
+		if start >= 0 && from > end {
+			hunks = append(hunks, lines[start:end])
+			start = -1
+		}
+		if start < 0 {
+			start = from
+		}
+		end = Min(idx+diffContextLines+1, len(lines))
+	}
+	if start >= 0 {
+		hunks = append(hunks, lines[start:end])
+	}
+	return hunks
+}
+
+func hunkRange(hunk []diffLine) (oldStart, oldCount, newStart, newCount int) {
+	for _, line := range hunk {
+		if line.op != diffInsert {
+			if oldStart == 0 {
+				oldStart = line.oldLine
+			}
+			oldCount++
+		}
+		if line.op != diffDelete {
+			if newStart == 0 {
+				newStart = line.newLine
+			}
+			newCount++
+		}
+	}
+	return oldStart, oldCount, newStart, newCount
+}
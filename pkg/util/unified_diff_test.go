@@ -0,0 +1,54 @@
+// Copyright © 2020 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestUnifiedDiffEqual(t *testing.T) {
+	assert.Equal(t, UnifiedDiff("a", "b", "foo\nbar\n", "foo\nbar\n", false), "")
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	oldText := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n"
+	newText := "a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n"
+	expected := `--- old
++++ new
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -10,3 +10,4 @@
+ j
+ k
+ l
++m
+`
+	assert.Equal(t, UnifiedDiff("old", "new", oldText, newText, false), expected)
+}
+
+func TestUnifiedDiffColored(t *testing.T) {
+	diff := UnifiedDiff("old", "new", "a\n", "b\n", true)
+	assert.Assert(t, strings.Contains(diff, colorRed+"-a"+colorReset))
+	assert.Assert(t, strings.Contains(diff, colorGreen+"+b"+colorReset))
+	assert.Assert(t, strings.Contains(diff, colorCyan+"@@ -1,1 +1,1 @@"+colorReset))
+}
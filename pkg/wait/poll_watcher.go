@@ -15,13 +15,16 @@
 package wait
 
 import (
+	"sort"
 	"sync"
 	"time"
 
 	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/rest"
 )
@@ -50,6 +53,30 @@ type pollingWatcher struct {
 
 type watchF func(v1.ListOptions) (watch.Interface, error)
 
+type listF func(v1.ListOptions) (runtime.Object, error)
+
+// maxTransientPollFailures is the number of consecutive transient errors which are retried
+// before the error is reported
+const maxTransientPollFailures = 3
+
+type pollingListWatcher struct {
+	done   chan bool
+	result chan watch.Event
+	wg     *sync.WaitGroup
+	// we can mock the interface for testing.
+	pollInterval PollInterval
+	// mock hook for testing.
+	poll func() (runtime.Object, error)
+}
+
+type multiWatcher struct {
+	watchers []watch.Interface
+	done     chan bool
+	result   chan watch.Event
+	wg       *sync.WaitGroup
+	stopOnce sync.Once
+}
+
 type tickerPollInterval struct {
 	t *time.Ticker
 }
@@ -162,6 +189,202 @@ func (w *pollingWatcher) Stop() {
 	close(w.done)
 }
 
+// NewListWatcher makes a watch.Interface on all resources matching the given list options,
+// falling back to polling the list if the server does not support Watch.
+func NewListWatcher(watchFunc watchF, listFunc listF, opts v1.ListOptions) (watch.Interface, error) {
+	watchOpts := opts
+	watchOpts.Watch = true
+	native, err := watchFunc(watchOpts)
+	if err == nil {
+		return native, nil
+	}
+	polling := &pollingListWatcher{
+		make(chan bool), make(chan watch.Event), &sync.WaitGroup{},
+		newTickerPollInterval(time.Second), func() (runtime.Object, error) { return listFunc(opts) }}
+	err = polling.start()
+	if err != nil {
+		return nil, err
+	}
+	return polling, nil
+}
+
+func (w *pollingListWatcher) start() error {
+	w.wg.Add(1)
+
+	go func() {
+		defer w.wg.Done()
+		defer w.pollInterval.Stop()
+		known := map[string]runtime.Object{}
+		failures := 0
+		for {
+			select {
+			case <-w.pollInterval.PollChan():
+				var events []watch.Event
+				list, err := w.poll()
+				if err != nil && isTransientError(err) && failures < maxTransientPollFailures {
+					// Try again with the next poll
+					failures++
+					continue
+				}
+				failures = 0
+				if err == nil {
+					events, err = diffList(known, list)
+				}
+				if err != nil {
+					events = []watch.Event{{Type: watch.Error, Object: errorStatus(err)}}
+				}
+				for _, event := range events {
+					if !w.send(event) {
+						return
+					}
+				}
+			case <-w.done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// isTransientError returns true for errors which are likely to go away when polling again
+func isTransientError(err error) bool {
+	return api_errors.IsServerTimeout(err) || api_errors.IsTimeout(err) || api_errors.IsTooManyRequests(err) ||
+		api_errors.IsServiceUnavailable(err) || api_errors.IsInternalError(err) ||
+		utilnet.IsConnectionReset(err) || utilnet.IsConnectionRefused(err) || utilnet.IsProbableEOF(err)
+}
+
+// errorStatus returns the status to send with an error event, like the API server does for watches
+func errorStatus(err error) *v1.Status {
+	if apiStatus, ok := err.(api_errors.APIStatus); ok {
+		status := apiStatus.Status()
+		return &status
+	}
+	return &v1.Status{Status: v1.StatusFailure, Message: err.Error()}
+}
+
+// send delivers the event unless the watcher has been stopped in the meantime
+func (w *pollingListWatcher) send(event watch.Event) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.done:
+		return false
+	}
+}
+
+func (w *pollingListWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *pollingListWatcher) Stop() {
+	w.done <- true
+	w.wg.Wait()
+	close(w.result)
+	close(w.done)
+}
+
+// diffList compares the polled list with the objects known from the previous poll
+// and returns the events for the changes. The known objects get updated.
+func diffList(known map[string]runtime.Object, list runtime.Object) ([]watch.Event, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	var events []watch.Event
+	current := make(map[string]runtime.Object, len(items))
+	for _, item := range items {
+		newObj, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+		key := newObj.GetNamespace() + "/" + newObj.GetName()
+		current[key] = item
+		old, found := known[key]
+		if !found {
+			events = append(events, watch.Event{Type: watch.Added, Object: item})
+			continue
+		}
+		oldObj, err := meta.Accessor(old)
+		if err != nil {
+			return nil, err
+		}
+		if newObj.GetUID() != oldObj.GetUID() {
+			// Deleted and readded.
+			events = append(events,
+				watch.Event{Type: watch.Deleted, Object: old},
+				watch.Event{Type: watch.Added, Object: item})
+		} else if newObj.GetResourceVersion() != oldObj.GetResourceVersion() {
+			events = append(events, watch.Event{Type: watch.Modified, Object: item})
+		}
+	}
+
+	var deleted []string
+	for key := range known {
+		if _, found := current[key]; !found {
+			deleted = append(deleted, key)
+		}
+	}
+	sort.Strings(deleted)
+	for _, key := range deleted {
+		events = append(events, watch.Event{Type: watch.Deleted, Object: known[key]})
+	}
+
+	for key := range known {
+		delete(known, key)
+	}
+	for key, obj := range current {
+		known[key] = obj
+	}
+	return events, nil
+}
+
+// NewMultiWatcher merges the events of all given watchers into a single watch.Interface.
+// The result channel is closed when all watchers have been closed.
+func NewMultiWatcher(watchers ...watch.Interface) watch.Interface {
+	w := &multiWatcher{watchers, make(chan bool), make(chan watch.Event), &sync.WaitGroup{}, sync.Once{}}
+	w.wg.Add(len(watchers))
+	for _, watcher := range watchers {
+		go w.forward(watcher)
+	}
+	go func() {
+		w.wg.Wait()
+		close(w.result)
+	}()
+	return w
+}
+
+func (w *multiWatcher) forward(watcher watch.Interface) {
+	defer w.wg.Done()
+	for {
+		select {
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			select {
+			case w.result <- event:
+			case <-w.done:
+				return
+			}
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *multiWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *multiWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.done)
+		for _, watcher := range w.watchers {
+			watcher.Stop()
+		}
+	})
+}
+
 func nativeWatch(watchFunc watchF, name string, timeout time.Duration) (watch.Interface, error) {
 	opts := v1.ListOptions{
 		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
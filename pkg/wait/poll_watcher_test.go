@@ -110,3 +110,133 @@ func TestPollWatcher(t *testing.T) {
 		w.Stop()
 	}
 }
+
+func newListWatcherForTest(pollResults []runtime.Object) watch.Interface {
+	i := 0
+	poll := func() (runtime.Object, error) {
+		defer func() { i += 1 }()
+		if pollResults[i] == nil {
+			return nil, fmt.Errorf("list failed")
+		}
+		return pollResults[i], nil
+	}
+	ret := &pollingListWatcher{make(chan bool), make(chan watch.Event), &sync.WaitGroup{},
+		newFakePollInterval(len(pollResults)), poll}
+	ret.start()
+	return ret
+}
+
+func serviceList(services ...runtime.Object) runtime.Object {
+	list := &servingv1.ServiceList{}
+	for _, s := range services {
+		list.Items = append(list.Items, *s.(*servingv1.Service))
+	}
+	return list
+}
+
+func TestPollListWatcher(t *testing.T) {
+	bar := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "bar", ResourceVersion: "x", UID: "three"}}
+	cases := []testCase{
+		// Appears, changes and gets deleted.
+		{[]runtime.Object{serviceList(), serviceList(a), serviceList(aa), serviceList(b), serviceList()},
+			[]watch.Event{{watch.Added, a}, {watch.Modified, b}, {watch.Deleted, b}}},
+		// Multiple items, one deleted and recreated between polls.
+		{[]runtime.Object{serviceList(bar, a), serviceList(bar, z), serviceList(z)},
+			[]watch.Event{{watch.Added, bar}, {watch.Added, a}, {watch.Deleted, a}, {watch.Added, z}, {watch.Deleted, bar}}},
+		// List errors are reported.
+		{[]runtime.Object{serviceList(a), nil}, []watch.Event{{watch.Added, a}, {Type: watch.Error}}},
+	}
+	for _, c := range cases {
+		w := newListWatcherForTest(c.pollResults)
+		for _, expected := range c.watchResults {
+			actual := <-w.ResultChan()
+			assert.Equal(t, actual.Type, expected.Type)
+			if actual.Type == watch.Error {
+				assert.Equal(t, actual.Object.(*metav1.Status).Message, "list failed")
+			} else {
+				assert.Equal(t, actual.Object.(metav1.Object).GetName(), expected.Object.(metav1.Object).GetName())
+				assert.Equal(t, actual.Object.(metav1.Object).GetResourceVersion(), expected.Object.(metav1.Object).GetResourceVersion())
+				assert.Equal(t, actual.Object.(metav1.Object).GetUID(), expected.Object.(metav1.Object).GetUID())
+			}
+		}
+		w.Stop()
+	}
+}
+
+func TestPollListWatcherRetriesTransientErrors(t *testing.T) {
+	results := []error{
+		api_errors.NewServiceUnavailable("unavailable"),
+		api_errors.NewServiceUnavailable("unavailable"),
+		nil,
+	}
+	i := 0
+	poll := func() (runtime.Object, error) {
+		defer func() { i += 1 }()
+		if results[i] != nil {
+			return nil, results[i]
+		}
+		return serviceList(a), nil
+	}
+	w := &pollingListWatcher{make(chan bool), make(chan watch.Event), &sync.WaitGroup{},
+		newFakePollInterval(len(results)), poll}
+	w.start()
+	actual := <-w.ResultChan()
+	assert.Equal(t, actual.Type, watch.Added)
+	w.Stop()
+
+	results = []error{
+		api_errors.NewServiceUnavailable("unavailable"),
+		api_errors.NewServiceUnavailable("unavailable"),
+		api_errors.NewServiceUnavailable("unavailable"),
+		api_errors.NewServiceUnavailable("still unavailable"),
+	}
+	i = 0
+	w = &pollingListWatcher{make(chan bool), make(chan watch.Event), &sync.WaitGroup{},
+		newFakePollInterval(len(results)), poll}
+	w.start()
+	actual = <-w.ResultChan()
+	assert.Equal(t, actual.Type, watch.Error)
+	assert.Equal(t, actual.Object.(*metav1.Status).Message, "still unavailable")
+	w.Stop()
+}
+
+func TestNewListWatcherNative(t *testing.T) {
+	fakeWatch := NewFakeWatch(nil)
+	watchFunc := func(opts metav1.ListOptions) (watch.Interface, error) {
+		assert.Assert(t, opts.Watch)
+		assert.Equal(t, opts.LabelSelector, "app=foo")
+		return fakeWatch, nil
+	}
+	listFunc := func(opts metav1.ListOptions) (runtime.Object, error) {
+		t.Fatal("list should not be called when watch is supported")
+		return nil, nil
+	}
+	w, err := NewListWatcher(watchFunc, listFunc, metav1.ListOptions{LabelSelector: "app=foo"})
+	assert.NilError(t, err)
+	assert.Equal(t, w, watch.Interface(fakeWatch))
+}
+
+func TestMultiWatcher(t *testing.T) {
+	w1 := watch.NewFakeWithChanSize(1, false)
+	w2 := watch.NewFakeWithChanSize(1, false)
+	w1.Add(a)
+	w2.Modify(b)
+	w1.Stop()
+	w2.Stop()
+
+	w := NewMultiWatcher(w1, w2)
+	var types []watch.EventType
+	for event := range w.ResultChan() {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, len(types), 2)
+	assert.Assert(t, types[0] != types[1])
+
+	fakeWatch := NewFakeWatch(nil)
+	w = NewMultiWatcher(fakeWatch)
+	w.Stop()
+	w.Stop()
+	assert.Equal(t, fakeWatch.StopCalled, 1)
+	_, ok := <-w.ResultChan()
+	assert.Assert(t, !ok)
+}
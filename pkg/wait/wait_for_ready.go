@@ -30,6 +30,23 @@ type waitForReadyConfig struct {
 	watchMaker          WatchMaker
 	conditionsExtractor ConditionsExtractor
 	kind                string
+	condition           Condition
+	// Whether the state reported by the initial "ADDED" event is taken into account
+	checkInitialState bool
+}
+
+// Condition is the type and the status of a condition to wait for
+type Condition struct {
+	Type   apis.ConditionType
+	Status corev1.ConditionStatus
+}
+
+// ReadyCondition is the condition used for waiting on a resource to become ready
+var ReadyCondition = Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue}
+
+// String returns the condition as TYPE=STATUS
+func (c Condition) String() string {
+	return fmt.Sprintf("%s=%s", c.Type, c.Status)
 }
 
 // Callbacks and configuration used while waiting for event
@@ -76,6 +93,20 @@ func NewWaitForReady(kind string, watchMaker WatchMaker, extractor ConditionsExt
 		kind:                kind,
 		watchMaker:          watchMaker,
 		conditionsExtractor: extractor,
+		condition:           ReadyCondition,
+	}
+}
+
+// NewWaitForCondition waits until the condition of the given type reaches the given status.
+// In contrast to NewWaitForReady the initial state of the resource is checked, too, so that
+// waiting on a resource which already has reached the condition returns immediately.
+func NewWaitForCondition(kind string, condition Condition, watchMaker WatchMaker, extractor ConditionsExtractor) Wait {
+	return &waitForReadyConfig{
+		kind:                kind,
+		watchMaker:          watchMaker,
+		conditionsExtractor: extractor,
+		condition:           condition,
+		checkInitialState:   true,
 	}
 }
 
@@ -124,7 +155,7 @@ func (w *waitForReadyConfig) Wait(name string, options Options, msgCallback Mess
 		}
 		floatingTimeout = floatingTimeout - time.Since(start)
 		if timeoutReached || floatingTimeout < 0 {
-			return fmt.Errorf("timeout: %s '%s' not ready after %d seconds", w.kind, name, int(timeout/time.Second)), time.Since(start)
+			return w.timeoutError(name, timeout), time.Since(start)
 		}
 
 		if retry {
@@ -176,7 +207,8 @@ func (w *waitForReadyConfig) waitForReadyCondition(start time.Time, name string,
 			//  the watch begins with synthetic “Added” events of all resources instances that exist at the starting
 			//  resource version. All following watch events are for all changes that occurred after the resource
 			//  version the watch started at."
-			if event.Type != watch.Modified {
+			// Only when explicitly requested the initial state is considered, too.
+			if event.Type != watch.Modified && !(w.checkInitialState && event.Type == watch.Added) {
 				continue
 			}
 
@@ -194,11 +226,12 @@ func (w *waitForReadyConfig) waitForReadyCondition(start time.Time, name string,
 				return false, false, err
 			}
 			for _, cond := range conditions {
-				if cond.Type == apis.ConditionReady {
-					switch cond.Status {
-					case corev1.ConditionTrue:
+				if cond.Type == w.condition.Type {
+					if cond.Status == w.condition.Status {
 						return false, false, nil
-					case corev1.ConditionFalse:
+					}
+					// Only a condition which is expected to become true fails when being false
+					if cond.Status == corev1.ConditionFalse && w.condition.Status == corev1.ConditionTrue {
 						// Fire up a timer waiting for the error window duration to still allow to reconcile
 						// to a true condition even after the condition went to false. If this is not the case within
 						// this window, then an error is returned.
@@ -219,6 +252,13 @@ func (w *waitForReadyConfig) waitForReadyCondition(start time.Time, name string,
 	}
 }
 
+func (w *waitForReadyConfig) timeoutError(name string, timeout time.Duration) error {
+	if w.condition == ReadyCondition {
+		return fmt.Errorf("timeout: %s '%s' not ready after %d seconds", w.kind, name, int(timeout/time.Second))
+	}
+	return fmt.Errorf("timeout: %s '%s' has not reached condition %s after %d seconds", w.kind, name, w.condition, int(timeout/time.Second))
+}
+
 // Wait until the expected EventDone is satisfied
 func (w *waitForEvent) Wait(name string, options Options, msgCallback MessageCallback) (error, time.Duration) {
 	timeout := options.timeoutWithDefault()
@@ -257,7 +297,8 @@ func generationCheck(object runtime.Object) (bool, error) {
 	}
 	status, ok := unstructured["status"].(map[string]interface{})
 	if !ok {
-		return false, fmt.Errorf("cannot extract status from %v", object)
+		// Freshly created objects have no status yet
+		return false, nil
 	}
 	observedGeneration, ok := status["observedGeneration"]
 	if !ok {
@@ -76,6 +76,52 @@ func TestAddWaitForReady(t *testing.T) {
 	}
 }
 
+func TestAddWaitForCondition(t *testing.T) {
+	for i, tc := range []struct {
+		condition Condition
+		events    []watch.Event
+		errorText string
+	}{
+		// Ready already reached with the initial state
+		{ReadyCondition, []watch.Event{
+			{watch.Added, CreateTestServiceWithConditions("foobar", corev1.ConditionTrue, corev1.ConditionTrue, "", "")},
+		}, ""},
+		// Other condition than ready
+		{Condition{Type: "RoutesReady", Status: corev1.ConditionTrue}, []watch.Event{
+			{watch.Added, CreateTestServiceWithConditions("foobar", corev1.ConditionUnknown, corev1.ConditionUnknown, "", "")},
+			{watch.Modified, CreateTestServiceWithConditions("foobar", corev1.ConditionUnknown, corev1.ConditionTrue, "", "")},
+		}, ""},
+		// Waiting for false does not fail when being false
+		{Condition{Type: apis.ConditionReady, Status: corev1.ConditionFalse}, []watch.Event{
+			{watch.Modified, CreateTestServiceWithConditions("foobar", corev1.ConditionFalse, corev1.ConditionTrue, "Failed", "")},
+		}, ""},
+		{Condition{Type: "RoutesReady", Status: corev1.ConditionFalse}, []watch.Event{
+			{watch.Modified, CreateTestServiceWithConditions("foobar", corev1.ConditionTrue, corev1.ConditionTrue, "", "")},
+		}, "has not reached condition RoutesReady=False"},
+	} {
+		fakeWatchApi := NewFakeWatch(tc.events)
+		waitForCondition := NewWaitForCondition(
+			"blub",
+			tc.condition,
+			func(name string, timeout time.Duration) (watch.Interface, error) {
+				return fakeWatchApi, nil
+			},
+			func(obj runtime.Object) (apis.Conditions, error) {
+				return apis.Conditions(obj.(*servingv1.Service).Status.Conditions), nil
+			})
+		fakeWatchApi.Start()
+		timeout := time.Second
+		err, _ := waitForCondition.Wait("foobar", Options{Timeout: &timeout}, NoopMessageCallback())
+		close(fakeWatchApi.eventChan)
+
+		if tc.errorText == "" {
+			assert.NilError(t, err, "%d", i)
+		} else {
+			assert.ErrorContains(t, err, tc.errorText, "%d", i)
+		}
+	}
+}
+
 func TestAddWaitForDelete(t *testing.T) {
 	for i, tc := range prepareDeleteTestCases("test-service") {
 		fakeWatchAPI := NewFakeWatch(tc.events)